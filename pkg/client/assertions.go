@@ -0,0 +1,309 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+// defaultAssertionRunLimit is the number of runs retrieved per assertion when no limit is given.
+const defaultAssertionRunLimit = 10
+
+// assertionPageSize is the number of assertions requested per page.
+const assertionPageSize = 200
+
+// assertionParameters mirrors AssertionStdParameters in the GraphQL schema.
+type assertionParameters struct {
+	Value *struct {
+		Value string `json:"value"`
+	} `json:"value"`
+	MinValue *struct {
+		Value string `json:"value"`
+	} `json:"minValue"`
+	MaxValue *struct {
+		Value string `json:"value"`
+	} `json:"maxValue"`
+}
+
+// toMap returns the parameters keyed by name, or nil if none are set.
+func (p *assertionParameters) toMap() map[string]string {
+	if p == nil {
+		return nil
+	}
+	params := make(map[string]string)
+	if p.Value != nil {
+		params["value"] = p.Value.Value
+	}
+	if p.MinValue != nil {
+		params["min_value"] = p.MinValue.Value
+	}
+	if p.MaxValue != nil {
+		params["max_value"] = p.MaxValue.Value
+	}
+	if len(params) == 0 {
+		return nil
+	}
+	return params
+}
+
+// assertionFieldPath mirrors SchemaFieldSpec in the GraphQL schema.
+type assertionFieldPath struct {
+	Path string `json:"path"`
+}
+
+// assertionInfo mirrors AssertionInfo in the GraphQL schema.
+type assertionInfo struct {
+	Type        string `json:"type"`
+	Description string `json:"description"`
+	ExternalURL string `json:"externalUrl"`
+	Source      *struct {
+		Type string `json:"type"`
+	} `json:"source"`
+	DatasetAssertion *struct {
+		Scope       string               `json:"scope"`
+		Fields      []assertionFieldPath `json:"fields"`
+		Aggregation string               `json:"aggregation"`
+		Operator    string               `json:"operator"`
+		NativeType  string               `json:"nativeType"`
+		Parameters  *assertionParameters `json:"parameters"`
+	} `json:"datasetAssertion"`
+	FreshnessAssertion *struct {
+		Type     string `json:"type"`
+		Schedule *struct {
+			Type string `json:"type"`
+			Cron *struct {
+				Cron     string `json:"cron"`
+				Timezone string `json:"timezone"`
+			} `json:"cron"`
+			FixedInterval *struct {
+				Unit     string `json:"unit"`
+				Multiple int    `json:"multiple"`
+			} `json:"fixedInterval"`
+		} `json:"schedule"`
+	} `json:"freshnessAssertion"`
+	VolumeAssertion *struct {
+		Type          string `json:"type"`
+		RowCountTotal *struct {
+			Operator   string               `json:"operator"`
+			Parameters *assertionParameters `json:"parameters"`
+		} `json:"rowCountTotal"`
+	} `json:"volumeAssertion"`
+	SQLAssertion *struct {
+		Type       string               `json:"type"`
+		Statement  string               `json:"statement"`
+		Operator   string               `json:"operator"`
+		Parameters *assertionParameters `json:"parameters"`
+	} `json:"sqlAssertion"`
+	FieldAssertion *struct {
+		Type                 string `json:"type"`
+		FieldValuesAssertion *struct {
+			Field      assertionFieldPath   `json:"field"`
+			Operator   string               `json:"operator"`
+			Parameters *assertionParameters `json:"parameters"`
+		} `json:"fieldValuesAssertion"`
+		FieldMetricAssertion *struct {
+			Field      assertionFieldPath   `json:"field"`
+			Metric     string               `json:"metric"`
+			Operator   string               `json:"operator"`
+			Parameters *assertionParameters `json:"parameters"`
+		} `json:"fieldMetricAssertion"`
+	} `json:"fieldAssertion"`
+	SchemaAssertion *struct {
+		Compatibility string `json:"compatibility"`
+	} `json:"schemaAssertion"`
+}
+
+// definition flattens the type-specific assertion details.
+func (info assertionInfo) definition() types.AssertionDefinition {
+	var def types.AssertionDefinition
+
+	if a := info.DatasetAssertion; a != nil {
+		def.Scope = a.Scope
+		for _, f := range a.Fields {
+			def.Fields = append(def.Fields, f.Path)
+		}
+		def.Aggregation = a.Aggregation
+		def.Operator = a.Operator
+		def.NativeType = a.NativeType
+		def.Parameters = a.Parameters.toMap()
+	}
+	if a := info.FreshnessAssertion; a != nil {
+		def.SubType = a.Type
+		if s := a.Schedule; s != nil {
+			switch {
+			case s.Cron != nil:
+				def.Schedule = s.Cron.Cron
+				if s.Cron.Timezone != "" {
+					def.Schedule += " (" + s.Cron.Timezone + ")"
+				}
+			case s.FixedInterval != nil:
+				def.Schedule = "every " + strconv.Itoa(s.FixedInterval.Multiple) + " " + s.FixedInterval.Unit
+			}
+		}
+	}
+	if a := info.VolumeAssertion; a != nil {
+		def.SubType = a.Type
+		if a.RowCountTotal != nil {
+			def.Operator = a.RowCountTotal.Operator
+			def.Parameters = a.RowCountTotal.Parameters.toMap()
+		}
+	}
+	if a := info.SQLAssertion; a != nil {
+		def.SubType = a.Type
+		def.Statement = a.Statement
+		def.Operator = a.Operator
+		def.Parameters = a.Parameters.toMap()
+	}
+	if a := info.FieldAssertion; a != nil {
+		def.SubType = a.Type
+		if v := a.FieldValuesAssertion; v != nil {
+			def.Fields = []string{v.Field.Path}
+			def.Operator = v.Operator
+			def.Parameters = v.Parameters.toMap()
+		}
+		if m := a.FieldMetricAssertion; m != nil {
+			def.Fields = []string{m.Field.Path}
+			def.Aggregation = m.Metric
+			def.Operator = m.Operator
+			def.Parameters = m.Parameters.toMap()
+		}
+	}
+	if a := info.SchemaAssertion; a != nil {
+		def.Compatibility = a.Compatibility
+	}
+
+	return def
+}
+
+// assertionRaw mirrors the assertionDetails fragment.
+type assertionRaw struct {
+	URN       string        `json:"urn"`
+	Info      assertionInfo `json:"info"`
+	RunEvents struct {
+		Total     int `json:"total"`
+		Failed    int `json:"failed"`
+		Succeeded int `json:"succeeded"`
+		RunEvents []struct {
+			TimestampMillis int64  `json:"timestampMillis"`
+			RunID           string `json:"runId"`
+			Result          *struct {
+				Type            string   `json:"type"`
+				ActualAggValue  *float64 `json:"actualAggValue"`
+				RowCount        *int64   `json:"rowCount"`
+				UnexpectedCount *int64   `json:"unexpectedCount"`
+				ExternalURL     string   `json:"externalUrl"`
+				NativeResults   []struct {
+					Key   string `json:"key"`
+					Value string `json:"value"`
+				} `json:"nativeResults"`
+			} `json:"result"`
+		} `json:"runEvents"`
+	} `json:"runEvents"`
+}
+
+// toAssertion converts the raw assertion, ordering runs newest first.
+func (a assertionRaw) toAssertion() types.Assertion {
+	assertion := types.Assertion{
+		URN:           a.URN,
+		Type:          a.Info.Type,
+		Description:   a.Info.Description,
+		ExternalURL:   a.Info.ExternalURL,
+		Definition:    a.Info.definition(),
+		TotalRuns:     a.RunEvents.Total,
+		FailedRuns:    a.RunEvents.Failed,
+		SucceededRuns: a.RunEvents.Succeeded,
+	}
+	if a.Info.Source != nil {
+		assertion.Source = a.Info.Source.Type
+	}
+
+	for _, e := range a.RunEvents.RunEvents {
+		run := types.AssertionRun{
+			Timestamp: e.TimestampMillis,
+			RunID:     e.RunID,
+		}
+		if r := e.Result; r != nil {
+			run.Result = r.Type
+			run.ActualValue = r.ActualAggValue
+			run.RowCount = r.RowCount
+			run.UnexpectedCount = r.UnexpectedCount
+			run.ExternalURL = r.ExternalURL
+			for _, nr := range r.NativeResults {
+				if run.NativeResults == nil {
+					run.NativeResults = make(map[string]string)
+				}
+				run.NativeResults[nr.Key] = nr.Value
+			}
+		}
+		assertion.Runs = append(assertion.Runs, run)
+	}
+	sort.SliceStable(assertion.Runs, func(i, j int) bool {
+		return assertion.Runs[i].Timestamp > assertion.Runs[j].Timestamp
+	})
+	if len(assertion.Runs) > 0 {
+		latest := assertion.Runs[0]
+		assertion.LatestRun = &latest
+	}
+
+	return assertion
+}
+
+// GetAssertions retrieves the data quality assertions on a dataset, each with its
+// definition and recent completed runs (newest first).
+func (c *Client) GetAssertions(ctx context.Context, urn string, opts ...AssertionOption) ([]types.Assertion, error) {
+	options := &assertionOptions{runLimit: defaultAssertionRunLimit}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.runLimit <= 0 {
+		options.runLimit = defaultAssertionRunLimit
+	}
+
+	// Page through the assertions until the reported total has been read
+	assertions := []types.Assertion{}
+	for start := 0; ; {
+		page, total, err := c.getAssertionsPage(ctx, urn, start, options.runLimit)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range page {
+			assertions = append(assertions, a.toAssertion())
+		}
+		start += len(page)
+		if len(page) == 0 || start >= total {
+			break
+		}
+	}
+	return assertions, nil
+}
+
+// getAssertionsPage retrieves one page of a dataset's assertions and the total count.
+func (c *Client) getAssertionsPage(ctx context.Context, urn string, start, runLimit int) ([]assertionRaw, int, error) {
+	variables := map[string]any{
+		"urn":      urn,
+		"start":    start,
+		"count":    assertionPageSize,
+		"runLimit": runLimit,
+	}
+
+	var response struct {
+		Dataset struct {
+			URN        string `json:"urn"`
+			Assertions struct {
+				Total      int            `json:"total"`
+				Assertions []assertionRaw `json:"assertions"`
+			} `json:"assertions"`
+		} `json:"dataset"`
+	}
+
+	if err := c.Execute(ctx, GetAssertionsQuery, variables, &response); err != nil {
+		return nil, 0, fmt.Errorf("GetAssertions(%s): %w", urn, err)
+	}
+	if response.Dataset.URN == "" {
+		return nil, 0, fmt.Errorf("GetAssertions(%s): %w", urn, ErrNotFound)
+	}
+	return response.Dataset.Assertions.Assertions, response.Dataset.Assertions.Total, nil
+}
@@ -0,0 +1,212 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+func TestClientGetAssertions(t *testing.T) {
+	var vars map[string]any
+	c := newGraphQLTestClient(t, map[string]any{
+		"dataset": map[string]any{
+			"urn": "urn:li:dataset:orders",
+			"assertions": map[string]any{
+				"total": 4,
+				"assertions": []map[string]any{
+					{
+						"urn": "urn:li:assertion:fresh",
+						"info": map[string]any{
+							"type":   "FRESHNESS",
+							"source": map[string]any{"type": "NATIVE"},
+							"freshnessAssertion": map[string]any{
+								"type": "DATASET_CHANGE",
+								"schedule": map[string]any{
+									"type": "FIXED_INTERVAL",
+									"fixedInterval": map[string]any{
+										"unit":     "HOUR",
+										"multiple": 6,
+									},
+								},
+							},
+						},
+						"runEvents": map[string]any{
+							"total": 2, "failed": 1, "succeeded": 1,
+							"runEvents": []map[string]any{
+								{"timestampMillis": 100, "runId": "r1", "result": map[string]any{"type": "SUCCESS"}},
+								{"timestampMillis": 200, "runId": "r2", "result": map[string]any{
+									"type":          "FAILURE",
+									"nativeResults": []map[string]any{{"key": "lag", "value": "9h"}},
+								}},
+							},
+						},
+					},
+					{
+						"urn": "urn:li:assertion:volume",
+						"info": map[string]any{
+							"type": "VOLUME",
+							"volumeAssertion": map[string]any{
+								"type": "ROW_COUNT_TOTAL",
+								"rowCountTotal": map[string]any{
+									"operator": "BETWEEN",
+									"parameters": map[string]any{
+										"minValue": map[string]any{"value": "1000"},
+										"maxValue": map[string]any{"value": "5000"},
+									},
+								},
+							},
+						},
+						"runEvents": map[string]any{"total": 0},
+					},
+					{
+						"urn": "urn:li:assertion:sql",
+						"info": map[string]any{
+							"type": "SQL",
+							"sqlAssertion": map[string]any{
+								"type":       "METRIC",
+								"statement":  "SELECT COUNT(*) FROM orders WHERE amount < 0",
+								"operator":   "EQUAL_TO",
+								"parameters": map[string]any{"value": map[string]any{"value": "0"}},
+							},
+						},
+					},
+					{
+						"urn": "urn:li:assertion:ge",
+						"info": map[string]any{
+							"type": "DATASET",
+							"datasetAssertion": map[string]any{
+								"scope":      "DATASET_COLUMN",
+								"fields":     []map[string]any{{"path": "order_id"}},
+								"operator":   "NOT_NULL",
+								"nativeType": "expect_column_values_to_not_be_null",
+							},
+						},
+						"runEvents": map[string]any{
+							"total": 1, "succeeded": 1,
+							"runEvents": []map[string]any{
+								{"timestampMillis": 50, "result": map[string]any{"type": "SUCCESS", "unexpectedCount": 0}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, &vars)
+
+	assertions, err := c.GetAssertions(context.Background(), "urn:li:dataset:orders", WithAssertionRunLimit(3))
+	if err != nil {
+		t.Fatalf("GetAssertions() unexpected error: %v", err)
+	}
+	if vars["runLimit"] != float64(3) {
+		t.Errorf("runLimit = %v, want 3", vars["runLimit"])
+	}
+	if len(assertions) != 4 {
+		t.Fatalf("expected 4 assertions, got %d", len(assertions))
+	}
+
+	fresh := assertions[0]
+	if fresh.Source != "NATIVE" || fresh.Definition.SubType != "DATASET_CHANGE" || fresh.Definition.Schedule != "every 6 HOUR" {
+		t.Errorf("freshness assertion not parsed: %+v", fresh)
+	}
+	if fresh.LatestRun == nil || fresh.LatestRun.Result != types.AssertionResultFailure || fresh.LatestRun.NativeResults["lag"] != "9h" {
+		t.Errorf("LatestRun = %+v, want newest failing run", fresh.LatestRun)
+	}
+	if fresh.FailedRuns != 1 || fresh.SucceededRuns != 1 || len(fresh.Runs) != 2 {
+		t.Errorf("run counts not parsed: %+v", fresh)
+	}
+
+	volume := assertions[1]
+	if volume.Definition.Operator != "BETWEEN" || volume.Definition.Parameters["min_value"] != "1000" ||
+		volume.Definition.Parameters["max_value"] != "5000" {
+		t.Errorf("volume definition = %+v", volume.Definition)
+	}
+	if volume.LatestRun != nil {
+		t.Error("assertion without runs should have no LatestRun")
+	}
+
+	sql := assertions[2]
+	if sql.Definition.Statement == "" || sql.Definition.Parameters["value"] != "0" {
+		t.Errorf("sql definition = %+v", sql.Definition)
+	}
+
+	ge := assertions[3]
+	if ge.Definition.Scope != "DATASET_COLUMN" || len(ge.Definition.Fields) != 1 || ge.Definition.Fields[0] != "order_id" {
+		t.Errorf("dataset definition = %+v", ge.Definition)
+	}
+	if ge.LatestRun == nil || ge.LatestRun.UnexpectedCount == nil || *ge.LatestRun.UnexpectedCount != 0 {
+		t.Errorf("LatestRun = %+v, want unexpected count 0", ge.LatestRun)
+	}
+}
+
+func TestClientGetAssertionsNotFound(t *testing.T) {
+	c := newGraphQLTestClient(t, map[string]any{"dataset": map[string]any{"urn": ""}}, nil)
+
+	_, err := c.GetAssertions(context.Background(), "urn:li:dataset:missing")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetAssertions() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestClientGetAssertionsNone(t *testing.T) {
+	var vars map[string]any
+	c := newGraphQLTestClient(t, map[string]any{
+		"dataset": map[string]any{"urn": "urn:li:dataset:orders", "assertions": map[string]any{"total": 0}},
+	}, &vars)
+
+	assertions, err := c.GetAssertions(context.Background(), "urn:li:dataset:orders")
+	if err != nil {
+		t.Fatalf("GetAssertions() unexpected error: %v", err)
+	}
+	if assertions == nil || len(assertions) != 0 {
+		t.Errorf("expected empty non-nil slice, got %v", assertions)
+	}
+	if vars["runLimit"] != float64(defaultAssertionRunLimit) {
+		t.Errorf("runLimit = %v, want default %d", vars["runLimit"], defaultAssertionRunLimit)
+	}
+}
+
+func TestClientGetAssertionsPages(t *testing.T) {
+	const total = assertionPageSize + 50
+	var starts []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Variables map[string]any `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+		start, count := int(req.Variables["start"].(float64)), int(req.Variables["count"].(float64))
+		starts = append(starts, start)
+
+		page := []map[string]any{}
+		for i := start; i < min(start+count, total); i++ {
+			page = append(page, map[string]any{"urn": fmt.Sprintf("urn:li:assertion:a%d", i)})
+		}
+		writeJSON(t, w, map[string]any{"data": map[string]any{"dataset": map[string]any{
+			"urn":        "urn:li:dataset:orders",
+			"assertions": map[string]any{"total": total, "assertions": page},
+		}}})
+	}))
+	t.Cleanup(server.Close)
+	c, err := New(Config{URL: server.URL, Token: "test-token", RetryMax: 0})
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+
+	assertions, err := c.GetAssertions(context.Background(), "urn:li:dataset:orders")
+	if err != nil {
+		t.Fatalf("GetAssertions() unexpected error: %v", err)
+	}
+	if len(assertions) != total || assertions[total-1].URN != fmt.Sprintf("urn:li:assertion:a%d", total-1) {
+		t.Errorf("GetAssertions() returned %d assertions, want all %d", len(assertions), total)
+	}
+	if len(starts) != 2 || starts[1] != assertionPageSize {
+		t.Errorf("page starts = %v, want [0 %d]", starts, assertionPageSize)
+	}
+}
@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/txn2/mcp-datahub/pkg/types"
@@ -18,6 +20,7 @@ import (
 type Client struct {
 	endpoint   string
 	token      string
+	tokens     TokenProvider // Optional; takes precedence over token
 	httpClient *http.Client
 	config     Config
 	logger     Logger
@@ -63,14 +66,31 @@ func New(cfg Config) (*Client, error) {
 		}
 	}
 
+	httpClient := &http.Client{
+		Timeout: cfg.Timeout,
+	}
+
+	// Select the token source: explicit provider, OAuth2, or a ${env:VAR} / ${file:/path} reference
+	tokens := cfg.TokenProvider
+	switch {
+	case tokens != nil:
+		// An explicit provider always wins
+	case cfg.AuthMode == AuthModeOAuth2:
+		tokens = NewOAuth2TokenProvider(cfg.OAuth2, httpClient)
+	case IsTokenRef(cfg.Token):
+		var err error
+		if tokens, err = TokenProviderFromRef(cfg.Token); err != nil {
+			return nil, fmt.Errorf("invalid configuration: %w", err)
+		}
+	}
+
 	return &Client{
-		endpoint: endpoint,
-		token:    cfg.Token,
-		httpClient: &http.Client{
-			Timeout: cfg.Timeout,
-		},
-		config: cfg,
-		logger: logger,
+		endpoint:   endpoint,
+		token:      cfg.Token,
+		tokens:     tokens,
+		httpClient: httpClient,
+		config:     cfg,
+		logger:     logger,
 	}, nil
 }
 
@@ -122,6 +142,7 @@ func (c *Client) Execute(ctx context.Context, query string, variables map[string
 
 	start := time.Now()
 	var lastErr error
+	refreshed := false
 	for attempt := 0; attempt <= c.config.RetryMax; attempt++ {
 		if attempt > 0 {
 			// Exponential backoff
@@ -134,6 +155,13 @@ func (c *Client) Execute(ctx context.Context, query string, variables map[string
 		}
 
 		lastErr = c.doRequest(ctx, jsonBody, result)
+		if errors.Is(lastErr, ErrUnauthorized) && !refreshed {
+			// The token may have rotated; reload it once before giving up
+			refreshed = true
+			if c.refreshToken(ctx) {
+				lastErr = c.doRequest(ctx, jsonBody, result)
+			}
+		}
 		if lastErr == nil {
 			c.logger.Debug("request completed",
 				"operation", opName,
@@ -166,14 +194,46 @@ func (c *Client) Execute(ctx context.Context, query string, variables map[string
 	return lastErr
 }
 
+// executeOnce sends a non-idempotent mutation at most once. Unlike Execute it does
+// not retry timeouts, rate limits or server errors, because the server may already
+// have applied the mutation. A 401 is the only exception: DataHub rejects the
+// request before running it, so it is re-sent once after the token is refreshed.
+func (c *Client) executeOnce(ctx context.Context, query string, variables map[string]any, result any) error {
+	jsonBody, err := json.Marshal(graphQLRequest{Query: query, Variables: variables})
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	opName := extractOperationName(query)
+	c.logger.Debug("executing GraphQL mutation without retries",
+		"operation", opName,
+		"endpoint", c.endpoint,
+		"request_size", len(jsonBody))
+
+	err = c.doRequest(ctx, jsonBody, result)
+	if errors.Is(err, ErrUnauthorized) && c.refreshToken(ctx) {
+		err = c.doRequest(ctx, jsonBody, result)
+	}
+	if err != nil {
+		c.logger.Debug("mutation failed (not retrying)",
+			"operation", opName,
+			"error", err.Error())
+	}
+	return err
+}
+
 func (c *Client) doRequest(ctx context.Context, jsonBody []byte, result any) error {
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(jsonBody))
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
 
+	token, err := c.currentToken(ctx)
+	if err != nil {
+		return err
+	}
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Authorization", "Bearer "+token)
 
 	resp, err := c.httpClient.Do(req) //#nosec G704 -- URL is constructed from configured endpoint, not arbitrary user input
 	if err != nil {
@@ -200,6 +260,33 @@ func (c *Client) doRequest(ctx context.Context, jsonBody []byte, result any) err
 	return c.parseGraphQLResponse(body, result)
 }
 
+// currentToken returns the bearer token for the next request.
+func (c *Client) currentToken(ctx context.Context) (string, error) {
+	if c.tokens == nil {
+		return c.token, nil
+	}
+	token, err := c.tokens.Token(ctx)
+	if err != nil {
+		c.logger.Error("failed to obtain token", "error", err.Error())
+		return "", fmt.Errorf("obtaining token: %w", err)
+	}
+	return token, nil
+}
+
+// refreshToken asks the token provider for a fresh token after a 401.
+// Returns true if a retry with the refreshed token is worthwhile.
+func (c *Client) refreshToken(ctx context.Context) bool {
+	if c.tokens == nil {
+		return false
+	}
+	if _, err := c.tokens.Refresh(ctx); err != nil {
+		c.logger.Warn("token refresh failed", "error", err.Error())
+		return false
+	}
+	c.logger.Debug("token refreshed after unauthorized response")
+	return true
+}
+
 // handleRequestError handles HTTP request errors, distinguishing timeouts from other failures.
 func (c *Client) handleRequestError(ctx context.Context, err error) error {
 	if ctx.Err() != nil {
@@ -379,6 +466,10 @@ func (c *Client) Search(ctx context.Context, query string, opts ...SearchOption)
 		"count": options.limit,
 	}
 
+	if len(options.filters) > 0 {
+		input["orFilters"] = searchFilters(options.filters)
+	}
+
 	variables := map[string]any{
 		"input": input,
 	}
@@ -512,6 +603,25 @@ func (c *Client) Search(ctx context.Context, query string, opts ...SearchOption)
 	return result, nil
 }
 
+// searchFilters converts field filters into a single GraphQL AND filter group.
+// Fields are sorted so the request is deterministic.
+func searchFilters(filters map[string][]string) []map[string]any {
+	fields := make([]string, 0, len(filters))
+	for field := range filters {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	and := make([]map[string]any, 0, len(fields))
+	for _, field := range fields {
+		and = append(and, map[string]any{
+			"field":  field,
+			"values": filters[field],
+		})
+	}
+	return []map[string]any{{"and": and}}
+}
+
 // GetEntity retrieves a single entity by URN.
 func (c *Client) GetEntity(ctx context.Context, urn string) (*types.Entity, error) {
 	variables := map[string]any{
@@ -706,7 +816,8 @@ func (c *Client) GetSchema(ctx context.Context, urn string) (*types.SchemaMetada
 	return schema, nil
 }
 
-// GetLineage retrieves lineage for an entity.
+// GetLineage retrieves lineage for an entity. With LineageDirectionBoth the upstream
+// and downstream traversals run concurrently and are merged into one graph.
 func (c *Client) GetLineage(ctx context.Context, urn string, opts ...LineageOption) (*types.LineageResult, error) {
 	options := &lineageOptions{
 		direction: LineageDirectionDownstream,
@@ -720,13 +831,101 @@ func (c *Client) GetLineage(ctx context.Context, urn string, opts ...LineageOpti
 		options.depth = c.config.MaxLineageDepth
 	}
 
-	variables := map[string]any{
-		"urn":       urn,
-		"direction": options.direction,
+	if options.limit <= 0 || options.limit > c.config.MaxLimit {
+		options.limit = c.config.MaxLimit
+	}
+
+	if options.asOfMillis > 0 {
+		options.startTimeMillis = options.asOfMillis
+		options.endTimeMillis = options.asOfMillis
+	}
+
+	if options.direction != LineageDirectionBoth {
+		return c.traverseLineage(ctx, urn, options.direction, options)
+	}
+
+	var (
+		wg                   sync.WaitGroup
+		upstream, downstream *types.LineageResult
+		upErr, downErr       error
+	)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		upstream, upErr = c.traverseLineage(ctx, urn, LineageDirectionUpstream, options)
+	}()
+	go func() {
+		defer wg.Done()
+		downstream, downErr = c.traverseLineage(ctx, urn, LineageDirectionDownstream, options)
+	}()
+	wg.Wait()
+
+	if upErr != nil {
+		return nil, upErr
 	}
+	if downErr != nil {
+		return nil, downErr
+	}
+	return mergeLineage(upstream, downstream), nil
+}
+
+// mergeLineage combines an upstream and a downstream traversal of the same entity.
+// Upstream levels are negated so that the sign of a node's level tells the two sides
+// apart. A node reached in both directions (a cycle) is kept once, at its upstream level.
+func mergeLineage(upstream, downstream *types.LineageResult) *types.LineageResult {
+	result := &types.LineageResult{
+		Start:     upstream.Start,
+		Direction: LineageDirectionBoth,
+		Depth:     upstream.Depth,
+		AsOf:      upstream.AsOf,
+		Total:     upstream.Total + downstream.Total,
+		Fetched:   upstream.Fetched + downstream.Fetched,
+		Truncated: upstream.Truncated || downstream.Truncated,
+	}
+
+	seenNodes := make(map[string]bool)
+	for _, node := range upstream.Nodes {
+		if seenNodes[node.URN] {
+			continue
+		}
+		seenNodes[node.URN] = true
+		node.Level = -node.Level
+		result.Nodes = append(result.Nodes, node)
+	}
+	for _, node := range downstream.Nodes {
+		if seenNodes[node.URN] {
+			continue
+		}
+		seenNodes[node.URN] = true
+		result.Nodes = append(result.Nodes, node)
+	}
+
+	seenEdges := make(map[string]bool)
+	for _, edges := range [][]types.LineageEdge{upstream.Edges, downstream.Edges} {
+		for _, edge := range edges {
+			key := edge.Source + "->" + edge.Target
+			if seenEdges[key] {
+				continue
+			}
+			seenEdges[key] = true
+			result.Edges = append(result.Edges, edge)
+		}
+	}
+
+	return result
+}
+
+// traverseLineage runs a single-direction lineage search. Edges always point in the
+// direction data flows, so upstream paths (which DataHub returns starting from the
+// queried entity) are reversed.
+func (c *Client) traverseLineage(ctx context.Context, urn, direction string, options *lineageOptions) (*types.LineageResult, error) {
+	depth := options.depth
+	variables := lineageVariables(urn, direction, options)
 
 	var response struct {
 		SearchAcrossLineage struct {
+			Start         int `json:"start"`
+			Total         int `json:"total"`
 			SearchResults []struct {
 				Entity struct {
 					URN         string `json:"urn"`
@@ -753,17 +952,22 @@ func (c *Client) GetLineage(ctx context.Context, urn string, opts ...LineageOpti
 
 	result := &types.LineageResult{
 		Start:     urn,
-		Direction: options.direction,
-		Depth:     options.depth,
+		Direction: direction,
+		Depth:     depth,
+		AsOf:      options.asOfMillis,
+		Total:     response.SearchAcrossLineage.Total,
+		Fetched:   len(response.SearchAcrossLineage.SearchResults),
 	}
+	returned := response.SearchAcrossLineage.Start + len(response.SearchAcrossLineage.SearchResults)
+	result.Truncated = result.Total > returned
 
-	// Build nodes and edges (filter by depth client-side since maxHops is not supported)
+	// Build nodes and edges. The server's degree filter stops at "3+", so deeper
+	// degrees are still filtered client-side.
 	nodesByDegree := make(map[int][]string)
 	edgeSet := make(map[string]bool) // Track unique edges
 
 	for _, sr := range response.SearchAcrossLineage.SearchResults {
-		// Filter by depth client-side
-		if sr.Degree > options.depth {
+		if sr.Degree > depth {
 			continue
 		}
 		result.Nodes = append(result.Nodes, types.LineageNode{
@@ -780,17 +984,21 @@ func (c *Client) GetLineage(ctx context.Context, urn string, opts ...LineageOpti
 		for _, pathGroup := range sr.Paths {
 			if len(pathGroup.Path) > 1 {
 				// Only include edges where both nodes are within depth
-				maxPathIdx := options.depth
+				maxPathIdx := depth
 				if maxPathIdx > len(pathGroup.Path)-1 {
 					maxPathIdx = len(pathGroup.Path) - 1
 				}
 				for i := 0; i < maxPathIdx; i++ {
-					edgeKey := pathGroup.Path[i].URN + "->" + pathGroup.Path[i+1].URN
+					source, target := pathGroup.Path[i].URN, pathGroup.Path[i+1].URN
+					if direction == LineageDirectionUpstream {
+						source, target = target, source
+					}
+					edgeKey := source + "->" + target
 					if !edgeSet[edgeKey] {
 						edgeSet[edgeKey] = true
 						result.Edges = append(result.Edges, types.LineageEdge{
-							Source: pathGroup.Path[i].URN,
-							Target: pathGroup.Path[i+1].URN,
+							Source: source,
+							Target: target,
 						})
 					}
 				}
@@ -798,27 +1006,117 @@ func (c *Client) GetLineage(ctx context.Context, urn string, opts ...LineageOpti
 		}
 	}
 
-	// If no paths were provided, infer edges from degree
-	// For upstream: nodes at degree N connect to nodes at degree N-1
-	// For downstream: nodes at degree N connect to nodes at degree N+1
-	if len(result.Edges) == 0 && len(result.Nodes) > 0 {
-		// Add edge from start node to degree 1 nodes
-		for _, nodeURN := range nodesByDegree[1] {
-			if options.direction == LineageDirectionUpstream {
-				result.Edges = append(result.Edges, types.LineageEdge{
-					Source: nodeURN,
-					Target: urn,
-				})
-			} else {
-				result.Edges = append(result.Edges, types.LineageEdge{
-					Source: urn,
-					Target: nodeURN,
-				})
-			}
+	c.completeLineageEdges(ctx, result, nodesByDegree[1], options)
+	return result, nil
+}
+
+// completeLineageEdges fills in what the search paths leave out. Without paths, the
+// edges are rebuilt hop by hop; should that fail or find nothing, the start node is
+// connected to the degree-1 nodes. Queries limited to a time window always rebuild
+// the edges, since only the hop query reports when each edge was created and last
+// updated, and then flag the edges added or removed in the window. Removals are
+// found by comparing against the edges DataHub reports from the end of the window
+// to now, so they are only flagged for windows that have ended.
+func (c *Client) completeLineageEdges(
+	ctx context.Context, result *types.LineageResult, degreeOne []string, options *lineageOptions,
+) {
+	windowed := options.startTimeMillis > 0 || options.endTimeMillis > 0
+	if (len(result.Edges) == 0 || windowed) && len(result.Nodes) > 0 {
+		edges, err := c.reconstructLineageEdges(ctx, result.Start, result.Direction, result.Nodes, options)
+		if err != nil {
+			c.logger.Warn("lineage edge reconstruction failed",
+				"urn", result.Start,
+				"direction", result.Direction,
+				"error", err.Error())
+		}
+		if len(edges) > 0 {
+			result.Edges = edges
 		}
 	}
+	if len(result.Edges) == 0 {
+		result.Edges = inferLineageEdges(result.Start, result.Direction, degreeOne)
+	}
+	if windowed && options.asOfMillis == 0 {
+		c.flagWindowChanges(ctx, result, options)
+	}
+}
 
-	return result, nil
+// flagWindowChanges flags the edges of result added or removed in the query window.
+// Should the query for later edges fail, only additions are flagged.
+func (c *Client) flagWindowChanges(ctx context.Context, result *types.LineageResult, options *lineageOptions) {
+	var later map[string]bool
+	if end, now := options.endTimeMillis, time.Now().UnixMilli(); end > 0 && end <= now && len(result.Edges) > 0 {
+		edges, err := c.laterLineageEdges(ctx, result.Edges, result.Direction, options.limit, end, now)
+		if err != nil {
+			c.logger.Warn("lineage removal check failed",
+				"urn", result.Start,
+				"direction", result.Direction,
+				"error", err.Error())
+		}
+		later = edges
+	}
+	flagLineageChanges(result.Edges, options.startTimeMillis, options.endTimeMillis, later)
+}
+
+// inferLineageEdges connects the start node to the degree-1 nodes, in the direction
+// data flows.
+func inferLineageEdges(urn, direction string, neighbors []string) []types.LineageEdge {
+	var edges []types.LineageEdge
+	for _, nodeURN := range neighbors {
+		if direction == LineageDirectionUpstream {
+			edges = append(edges, types.LineageEdge{
+				Source: nodeURN,
+				Target: urn,
+			})
+		} else {
+			edges = append(edges, types.LineageEdge{
+				Source: urn,
+				Target: nodeURN,
+			})
+		}
+	}
+	return edges
+}
+
+// lineageVariables builds the searchAcrossLineage variables for one direction.
+// Unset filters are omitted so that DataHub applies its defaults.
+func lineageVariables(urn, direction string, options *lineageOptions) map[string]any {
+	variables := map[string]any{
+		"urn":       urn,
+		"direction": direction,
+		"start":     options.offset,
+		"count":     options.limit,
+	}
+
+	filters := map[string][]string{"degree": lineageDegrees(options.depth)}
+	if len(options.platforms) > 0 {
+		filters["platform"] = options.platforms
+	}
+	variables["orFilters"] = searchFilters(filters)
+
+	if len(options.entityTypes) > 0 {
+		variables["types"] = options.entityTypes
+	}
+	if options.startTimeMillis > 0 {
+		variables["startTimeMillis"] = options.startTimeMillis
+	}
+	if options.endTimeMillis > 0 {
+		variables["endTimeMillis"] = options.endTimeMillis
+	}
+	return variables
+}
+
+// lineageDegrees returns the values of DataHub's lineage "degree" filter that cover
+// depth hops. DataHub buckets everything beyond two hops as "3+".
+func lineageDegrees(depth int) []string {
+	switch {
+	case depth <= 1:
+		return []string{"1"}
+	case depth == 2:
+		return []string{"1", "2"}
+	default:
+		return []string{"1", "2", "3+"}
+	}
 }
 
 // GetQueries retrieves saved Query entities associated with a dataset.
@@ -958,7 +1256,12 @@ func (c *Client) GetGlossaryTerm(ctx context.Context, urn string) (*types.Glossa
 					Type string `json:"type"`
 				} `json:"owners"`
 			} `json:"ownership"`
+			RelatedTo   *glossaryRelationsRaw `json:"relatedTo"`
+			RelatedFrom *glossaryRelationsRaw `json:"relatedFrom"`
 		} `json:"glossaryTerm"`
+		Usage *struct {
+			Total int `json:"total"`
+		} `json:"usage"`
 	}
 
 	if err := c.Execute(ctx, GetGlossaryTermQuery, variables, &response); err != nil {
@@ -979,9 +1282,25 @@ func (c *Client) GetGlossaryTerm(ctx context.Context, urn string) (*types.Glossa
 		term.Name = response.GlossaryTerm.Properties.Name
 	}
 
-	// Parse parent node (take the first one if multiple)
-	if len(response.GlossaryTerm.ParentNodes.Nodes) > 0 {
-		term.ParentNode = response.GlossaryTerm.ParentNodes.Nodes[0].URN
+	// Parent nodes are returned nearest first; the first is the direct parent.
+	parents := response.GlossaryTerm.ParentNodes.Nodes
+	if len(parents) > 0 {
+		term.ParentNode = parents[0].URN
+	}
+	for i := len(parents) - 1; i >= 0; i-- {
+		term.ParentNodes = append(term.ParentNodes, types.EntityRef{
+			URN:  parents[i].URN,
+			Type: types.GlossaryEntryNode,
+			Name: parents[i].Properties.Name,
+		})
+	}
+
+	term.RelatedTerms = append(
+		response.GlossaryTerm.RelatedTo.toRelations("OUTGOING"),
+		response.GlossaryTerm.RelatedFrom.toRelations("INCOMING")...)
+
+	if response.Usage != nil {
+		term.EntityCount = response.Usage.Total
 	}
 
 	// Parse ownership
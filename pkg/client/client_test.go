@@ -7,7 +7,9 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"sort"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -387,6 +389,30 @@ func TestClientSearchOptions(t *testing.T) {
 	if receivedInput["start"] != float64(10) {
 		t.Errorf("Search() start = %v, want 10", receivedInput["start"])
 	}
+	if _, ok := receivedInput["orFilters"]; ok {
+		t.Error("Search() should not send orFilters without filters")
+	}
+
+	_, err = client.Search(context.Background(), "orders",
+		WithFilters(map[string][]string{"platform": {"urn:li:dataPlatform:snowflake"}}),
+		WithContainer("urn:li:container:sales"),
+	)
+	if err != nil {
+		t.Fatalf("Search() unexpected error: %v", err)
+	}
+
+	orFilters, ok := receivedInput["orFilters"].([]interface{})
+	if !ok || len(orFilters) != 1 {
+		t.Fatalf("Search() orFilters = %v, want one group", receivedInput["orFilters"])
+	}
+	and := orFilters[0].(map[string]interface{})["and"].([]interface{})
+	if len(and) != 2 {
+		t.Fatalf("Search() and filters = %v, want 2", and)
+	}
+	first := and[0].(map[string]interface{})
+	if first["field"] != "container" || first["values"].([]interface{})[0] != "urn:li:container:sales" {
+		t.Errorf("Search() first filter = %v, want container filter", first)
+	}
 }
 
 func TestClientGetEntity(t *testing.T) {
@@ -1098,7 +1124,7 @@ func TestClientGetLineageOptions(t *testing.T) {
 		return
 	}
 
-	// Verify URN and direction were sent to server (depth is handled client-side)
+	// Verify URN and direction were sent to server
 	if receivedVars["urn"] != "urn:li:dataset:test" {
 		t.Errorf("GetLineage() urn = %v, want urn:li:dataset:test", receivedVars["urn"])
 	}
@@ -1107,6 +1133,272 @@ func TestClientGetLineageOptions(t *testing.T) {
 	}
 }
 
+// lineageResult builds a searchAcrossLineage result for the lineage tests.
+func lineageResult(urn string, degree int, path ...string) map[string]interface{} {
+	sr := map[string]interface{}{
+		"entity": map[string]interface{}{
+			"urn":      urn,
+			"type":     "DATASET",
+			"name":     urn,
+			"platform": map[string]interface{}{"name": "snowflake"},
+		},
+		"degree": degree,
+	}
+	if len(path) > 0 {
+		var hops []map[string]interface{}
+		for _, p := range path {
+			hops = append(hops, map[string]interface{}{"urn": p})
+		}
+		sr["paths"] = []map[string]interface{}{{"path": hops}}
+	}
+	return sr
+}
+
+func TestClientGetLineageBoth(t *testing.T) {
+	const start = "urn:li:dataset:start"
+	var mu sync.Mutex
+	var directions []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+		direction, _ := req.Variables["direction"].(string)
+		mu.Lock()
+		directions = append(directions, direction)
+		mu.Unlock()
+
+		var results []map[string]interface{}
+		switch direction {
+		case LineageDirectionUpstream:
+			results = []map[string]interface{}{
+				lineageResult("urn:li:dataset:raw", 1, start, "urn:li:dataset:raw"),
+				lineageResult("urn:li:dataset:source", 2, start, "urn:li:dataset:raw", "urn:li:dataset:source"),
+				lineageResult("urn:li:dataset:cycle", 1, start, "urn:li:dataset:cycle"),
+			}
+		case LineageDirectionDownstream:
+			results = []map[string]interface{}{
+				lineageResult("urn:li:dataset:report", 1, start, "urn:li:dataset:report"),
+				lineageResult("urn:li:dataset:cycle", 1, start, "urn:li:dataset:cycle"),
+			}
+		}
+		writeJSON(t, w, map[string]interface{}{
+			"data": map[string]interface{}{
+				"searchAcrossLineage": map[string]interface{}{"searchResults": results},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := New(Config{URL: server.URL, Token: "test-token", RetryMax: 0})
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+
+	result, err := client.GetLineage(context.Background(), start, WithDirection("both"), WithDepth(2))
+	if err != nil {
+		t.Fatalf("GetLineage() unexpected error: %v", err)
+	}
+
+	sort.Strings(directions)
+	if strings.Join(directions, ",") != "DOWNSTREAM,UPSTREAM" {
+		t.Errorf("GetLineage() directions queried = %v, want both", directions)
+	}
+	if result.Direction != LineageDirectionBoth || result.Start != start || result.Depth != 2 {
+		t.Errorf("GetLineage() result header = %s/%s/%d", result.Start, result.Direction, result.Depth)
+	}
+
+	levels := make(map[string]int)
+	for _, node := range result.Nodes {
+		if _, dup := levels[node.URN]; dup {
+			t.Errorf("GetLineage() duplicate node %s", node.URN)
+		}
+		levels[node.URN] = node.Level
+	}
+	want := map[string]int{
+		"urn:li:dataset:raw":    -1,
+		"urn:li:dataset:source": -2,
+		"urn:li:dataset:cycle":  -1,
+		"urn:li:dataset:report": 1,
+	}
+	for urn, level := range want {
+		if got, ok := levels[urn]; !ok || got != level {
+			t.Errorf("GetLineage() level of %s = %d (present %v), want %d", urn, got, ok, level)
+		}
+	}
+	if len(levels) != len(want) {
+		t.Errorf("GetLineage() Nodes count = %d, want %d", len(levels), len(want))
+	}
+
+	edges := make(map[string]bool)
+	for _, edge := range result.Edges {
+		edges[edge.Source+"->"+edge.Target] = true
+	}
+	for _, key := range []string{
+		"urn:li:dataset:raw->" + start,
+		"urn:li:dataset:source->urn:li:dataset:raw",
+		"urn:li:dataset:cycle->" + start,
+		start + "->urn:li:dataset:report",
+		start + "->urn:li:dataset:cycle",
+	} {
+		if !edges[key] {
+			t.Errorf("GetLineage() missing edge %s; got %v", key, edges)
+		}
+	}
+	if len(result.Edges) != 5 {
+		t.Errorf("GetLineage() Edges count = %d, want 5", len(result.Edges))
+	}
+}
+
+func TestClientGetLineageBothError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		if req.Variables["direction"] == LineageDirectionUpstream {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		writeJSON(t, w, map[string]interface{}{
+			"data": map[string]interface{}{
+				"searchAcrossLineage": map[string]interface{}{"searchResults": []interface{}{}},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := New(Config{URL: server.URL, Token: "test-token", RetryMax: 0})
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+
+	if _, err := client.GetLineage(context.Background(), "urn:li:dataset:start",
+		WithDirection(LineageDirectionBoth)); err == nil {
+		t.Error("GetLineage() expected error when one direction fails")
+	}
+}
+
+func TestClientGetLineageUpstreamEdgeOrientation(t *testing.T) {
+	const start = "urn:li:dataset:start"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(t, w, map[string]interface{}{
+			"data": map[string]interface{}{
+				"searchAcrossLineage": map[string]interface{}{
+					"searchResults": []map[string]interface{}{
+						lineageResult("urn:li:dataset:raw", 1, start, "urn:li:dataset:raw"),
+					},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := New(Config{URL: server.URL, Token: "test-token", RetryMax: 0})
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+
+	result, err := client.GetLineage(context.Background(), start, WithDirection(LineageDirectionUpstream))
+	if err != nil {
+		t.Fatalf("GetLineage() unexpected error: %v", err)
+	}
+	if len(result.Edges) != 1 || result.Edges[0].Source != "urn:li:dataset:raw" || result.Edges[0].Target != start {
+		t.Errorf("GetLineage() Edges = %+v, want raw -> start", result.Edges)
+	}
+	if result.Nodes[0].Level != 1 {
+		t.Errorf("GetLineage() Level = %d, want 1 for a single-direction query", result.Nodes[0].Level)
+	}
+}
+
+func TestClientGetLineageServerFilters(t *testing.T) {
+	var vars map[string]any
+	client := newGraphQLTestClient(t, map[string]any{
+		"searchAcrossLineage": map[string]any{
+			"start": 0,
+			"count": 1,
+			"total": 40,
+			"searchResults": []map[string]interface{}{
+				lineageResult("urn:li:dataset:a", 1, "urn:li:dataset:start", "urn:li:dataset:a"),
+			},
+		},
+	}, &vars)
+
+	result, err := client.GetLineage(context.Background(), "urn:li:dataset:start",
+		WithDepth(2),
+		WithLineageLimit(1),
+		WithLineageOffset(0),
+		WithLineageEntityTypes("dataset"),
+		WithLineagePlatforms("snowflake"),
+	)
+	if err != nil {
+		t.Fatalf("GetLineage() unexpected error: %v", err)
+	}
+
+	if vars["count"] != float64(1) || vars["start"] != float64(0) {
+		t.Errorf("GetLineage() count/start = %v/%v, want 1/0", vars["count"], vars["start"])
+	}
+	if types, _ := vars["types"].([]any); len(types) != 1 || types[0] != "DATASET" {
+		t.Errorf("GetLineage() types = %v, want [DATASET]", vars["types"])
+	}
+	filters, _ := json.Marshal(vars["orFilters"])
+	want := `[{"and":[{"field":"degree","values":["1","2"]},{"field":"platform","values":["urn:li:dataPlatform:snowflake"]}]}]`
+	if string(filters) != want {
+		t.Errorf("GetLineage() orFilters = %s, want %s", filters, want)
+	}
+
+	if result.Total != 40 || result.Fetched != 1 || !result.Truncated {
+		t.Errorf("GetLineage() Total/Fetched/Truncated = %d/%d/%v, want 40/1/true", result.Total, result.Fetched, result.Truncated)
+	}
+}
+
+func TestClientGetLineageDefaults(t *testing.T) {
+	var vars map[string]any
+	client := newGraphQLTestClient(t, map[string]any{
+		"searchAcrossLineage": map[string]any{
+			"total":         1,
+			"searchResults": []map[string]interface{}{lineageResult("urn:li:dataset:a", 1, "urn:li:dataset:start", "urn:li:dataset:a")},
+		},
+	}, &vars)
+
+	result, err := client.GetLineage(context.Background(), "urn:li:dataset:start", WithLineageLimit(1000))
+	if err != nil {
+		t.Fatalf("GetLineage() unexpected error: %v", err)
+	}
+	if vars["count"] != float64(DefaultConfig().MaxLimit) {
+		t.Errorf("GetLineage() count = %v, want clamped to MaxLimit", vars["count"])
+	}
+	for _, key := range []string{"types", "startTimeMillis", "endTimeMillis"} {
+		if _, ok := vars[key]; ok {
+			t.Errorf("GetLineage() sent unset variable %s", key)
+		}
+	}
+	if result.Truncated {
+		t.Error("GetLineage() Truncated = true, want false when all results returned")
+	}
+}
+
+func TestLineageDegrees(t *testing.T) {
+	tests := []struct {
+		depth int
+		want  string
+	}{
+		{0, "1"},
+		{1, "1"},
+		{2, "1,2"},
+		{3, "1,2,3+"},
+		{5, "1,2,3+"},
+	}
+	for _, tt := range tests {
+		if got := strings.Join(lineageDegrees(tt.depth), ","); got != tt.want {
+			t.Errorf("lineageDegrees(%d) = %s, want %s", tt.depth, got, tt.want)
+		}
+	}
+}
+
 func TestClientGetLineageEdgeInference(t *testing.T) {
 	// When no paths are provided, edges should be inferred from degree
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
@@ -0,0 +1,294 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+// maxColumnTraceHops bounds the number of hops a single column trace returns.
+const maxColumnTraceHops = 500
+
+// columnTracer walks column-level lineage one hop at a time, caching the per-dataset
+// lookups so that columns sharing a dataset cost a single request.
+type columnTracer struct {
+	c             *Client
+	columnLineage map[string]*types.ColumnLineage
+	downstream    map[string][]types.LineageNode
+	producers     map[string]string
+}
+
+// TraceColumn follows a column's fine-grained lineage across hops. UPSTREAM (the
+// default) walks back to the columns it is ultimately derived from; DOWNSTREAM walks
+// forward to every dependent dataset column and chart or dashboard field. Depth
+// defaults to, and is capped at, MaxLineageDepth. Each hop carries the transform,
+// query SQL and producing job where DataHub knows them.
+func (c *Client) TraceColumn(ctx context.Context, datasetURN, column string, opts ...LineageOption) (*types.ColumnTrace, error) {
+	options, err := c.columnTraceOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	tracer := &columnTracer{
+		c:             c,
+		columnLineage: make(map[string]*types.ColumnLineage),
+		downstream:    make(map[string][]types.LineageNode),
+		producers:     make(map[string]string),
+	}
+	trace := &types.ColumnTrace{
+		DatasetURN: datasetURN,
+		Column:     column,
+		Direction:  options.direction,
+		Depth:      options.depth,
+		Hops:       []types.ColumnHop{},
+		Endpoints:  []types.ColumnRef{},
+	}
+
+	start := types.ColumnRef{URN: datasetURN, Type: "DATASET", Field: column}
+	visited := map[types.ColumnRef]bool{start: true}
+	frontier := []types.ColumnRef{start}
+
+	// Columns one level past the depth are still looked up, so that ends of the
+	// chain can be told apart from columns whose lineage was cut off.
+	for level := 1; len(frontier) > 0; level++ {
+		var next []types.ColumnRef
+		for _, ref := range frontier {
+			hops, err := tracer.step(ctx, ref, options.direction)
+			if err != nil {
+				return nil, fmt.Errorf("TraceColumn(%s): %w", datasetURN, err)
+			}
+			if len(hops) == 0 {
+				if level > 1 {
+					trace.Endpoints = append(trace.Endpoints, ref)
+				}
+				continue
+			}
+			if level > options.depth {
+				trace.Truncated = true
+				continue
+			}
+			for _, hop := range hops {
+				if len(trace.Hops) >= maxColumnTraceHops {
+					trace.Truncated = true
+					break
+				}
+				hop.Level = level
+				trace.Hops = append(trace.Hops, hop)
+
+				far := hop.Downstream
+				if options.direction == LineageDirectionUpstream {
+					far = hop.Upstream
+				}
+				if !visited[far] {
+					visited[far] = true
+					next = append(next, far)
+				}
+			}
+		}
+		frontier = next
+	}
+
+	tracer.attachSQL(ctx, trace.Hops)
+	return trace, nil
+}
+
+// columnTraceOptions applies opts over the trace defaults and validates the direction.
+func (c *Client) columnTraceOptions(opts []LineageOption) (*lineageOptions, error) {
+	options := &lineageOptions{
+		direction: LineageDirectionUpstream,
+		depth:     c.config.MaxLineageDepth,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.depth <= 0 || options.depth > c.config.MaxLineageDepth {
+		options.depth = c.config.MaxLineageDepth
+	}
+	if options.direction != LineageDirectionUpstream && options.direction != LineageDirectionDownstream {
+		return nil, fmt.Errorf("TraceColumn: direction must be UPSTREAM or DOWNSTREAM, got %q", options.direction)
+	}
+	return options, nil
+}
+
+// step returns the hops one level away from ref. Only dataset columns have further
+// lineage; chart and dashboard fields end a downstream trace.
+func (t *columnTracer) step(ctx context.Context, ref types.ColumnRef, direction string) ([]types.ColumnHop, error) {
+	if ref.Type != "DATASET" {
+		return nil, nil
+	}
+	if direction == LineageDirectionUpstream {
+		return t.upstreamHops(ctx, ref)
+	}
+	return t.downstreamHops(ctx, ref)
+}
+
+// upstreamHops returns the columns that ref is derived from.
+func (t *columnTracer) upstreamHops(ctx context.Context, ref types.ColumnRef) ([]types.ColumnHop, error) {
+	lineage, err := t.lineageOf(ctx, ref.URN)
+	if err != nil {
+		return nil, err
+	}
+
+	var hops []types.ColumnHop
+	for _, m := range lineage.Mappings {
+		if m.DownstreamColumn != ref.Field {
+			continue
+		}
+		hops = append(hops, types.ColumnHop{
+			Upstream:   types.ColumnRef{URN: m.UpstreamDataset, Type: "DATASET", Field: m.UpstreamColumn},
+			Downstream: ref,
+			Transform:  m.Transform,
+			Query:      m.Query,
+			Job:        t.producerOf(ctx, ref.URN),
+		})
+	}
+	return hops, nil
+}
+
+// downstreamHops returns the dataset columns and chart or dashboard fields derived
+// directly from ref.
+func (t *columnTracer) downstreamHops(ctx context.Context, ref types.ColumnRef) ([]types.ColumnHop, error) {
+	nodes, err := t.downstreamOf(ctx, ref.URN)
+	if err != nil {
+		return nil, err
+	}
+
+	var hops []types.ColumnHop
+	for _, node := range nodes {
+		switch node.Type {
+		case "DATASET":
+			lineage, err := t.lineageOf(ctx, node.URN)
+			if err != nil {
+				return nil, err
+			}
+			for _, m := range lineage.Mappings {
+				if m.UpstreamDataset != ref.URN || m.UpstreamColumn != ref.Field {
+					continue
+				}
+				hops = append(hops, types.ColumnHop{
+					Upstream:   ref,
+					Downstream: types.ColumnRef{URN: node.URN, Type: "DATASET", Field: m.DownstreamColumn},
+					Transform:  m.Transform,
+					Query:      m.Query,
+					Job:        t.producerOf(ctx, node.URN),
+				})
+			}
+		case "CHART":
+			chart, err := t.c.GetChart(ctx, node.URN)
+			if err != nil {
+				t.c.logger.Warn("column trace chart lookup failed", "urn", node.URN, "error", err.Error())
+				continue
+			}
+			hops = append(hops, fieldHops(ref, node, chart.InputFields)...)
+		case "DASHBOARD":
+			dashboard, err := t.c.GetDashboard(ctx, node.URN)
+			if err != nil {
+				t.c.logger.Warn("column trace dashboard lookup failed", "urn", node.URN, "error", err.Error())
+				continue
+			}
+			hops = append(hops, fieldHops(ref, node, dashboard.InputFields)...)
+		}
+	}
+	return hops, nil
+}
+
+// fieldHops returns a hop for each of a chart's or dashboard's input fields that reads ref.
+func fieldHops(ref types.ColumnRef, node types.LineageNode, fields []types.InputField) []types.ColumnHop {
+	var hops []types.ColumnHop
+	for _, f := range fields {
+		if f.DatasetURN == ref.URN && f.FieldPath == ref.Field {
+			hops = append(hops, types.ColumnHop{
+				Upstream:   ref,
+				Downstream: types.ColumnRef{URN: node.URN, Type: node.Type, Field: f.FieldPath},
+			})
+		}
+	}
+	return hops
+}
+
+// lineageOf returns the fine-grained lineage of a dataset.
+func (t *columnTracer) lineageOf(ctx context.Context, urn string) (*types.ColumnLineage, error) {
+	if lineage, ok := t.columnLineage[urn]; ok {
+		return lineage, nil
+	}
+	lineage, err := t.c.GetColumnLineage(ctx, urn)
+	if err != nil {
+		return nil, err
+	}
+	t.columnLineage[urn] = lineage
+	return lineage, nil
+}
+
+// downstreamOf returns the entities directly downstream of a dataset.
+func (t *columnTracer) downstreamOf(ctx context.Context, urn string) ([]types.LineageNode, error) {
+	if nodes, ok := t.downstream[urn]; ok {
+		return nodes, nil
+	}
+	result, err := t.c.GetLineage(ctx, urn, WithDirection(LineageDirectionDownstream), WithDepth(1))
+	if err != nil {
+		return nil, err
+	}
+	t.downstream[urn] = result.Nodes
+	return result.Nodes, nil
+}
+
+// producerOf returns the data job that writes a dataset when there is exactly one.
+// The lookup is best effort: errors leave the job unknown.
+func (t *columnTracer) producerOf(ctx context.Context, urn string) string {
+	if job, ok := t.producers[urn]; ok {
+		return job
+	}
+
+	var job string
+	result, err := t.c.GetLineage(ctx, urn, WithDirection(LineageDirectionUpstream), WithDepth(1),
+		WithLineageEntityTypes("DATA_JOB"))
+	if err != nil {
+		t.c.logger.Debug("column trace producer lookup failed", "urn", urn, "error", err.Error())
+	} else if len(result.Nodes) == 1 {
+		job = result.Nodes[0].URN
+	}
+	t.producers[urn] = job
+	return job
+}
+
+// attachSQL fills in the statement of each hop's query. Queries DataHub does not
+// store, or a failed lookup, leave SQL empty.
+func (t *columnTracer) attachSQL(ctx context.Context, hops []types.ColumnHop) {
+	seen := make(map[string]bool)
+	var urns []string
+	for _, hop := range hops {
+		if hop.Query != "" && !seen[hop.Query] {
+			seen[hop.Query] = true
+			urns = append(urns, hop.Query)
+		}
+	}
+	if len(urns) == 0 {
+		return
+	}
+
+	var response struct {
+		Entities []*struct {
+			URN        string `json:"urn"`
+			Properties *struct {
+				Statement *struct {
+					Value string `json:"value"`
+				} `json:"statement"`
+			} `json:"properties"`
+		} `json:"entities"`
+	}
+	if err := t.c.Execute(ctx, GetQueryStatementsQuery, map[string]any{"urns": urns}, &response); err != nil {
+		t.c.logger.Warn("column trace query lookup failed", "error", err.Error())
+		return
+	}
+
+	statements := make(map[string]string)
+	for _, e := range response.Entities {
+		if e != nil && e.Properties != nil && e.Properties.Statement != nil {
+			statements[e.URN] = e.Properties.Statement.Value
+		}
+	}
+	for i := range hops {
+		hops[i].SQL = statements[hops[i].Query]
+	}
+}
@@ -0,0 +1,212 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+const (
+	traceRawURN    = "urn:li:dataset:raw_orders"
+	traceCleanURN  = "urn:li:dataset:orders_clean"
+	traceReportURN = "urn:li:dataset:revenue_report"
+	traceChartURN  = "urn:li:chart:(looker,revenue)"
+	traceJobURN    = "urn:li:dataJob:clean"
+	traceQueryURN  = "urn:li:query:q1"
+)
+
+// fineGrained builds a fineGrainedLineages entry mapping an upstream column to a downstream one.
+func fineGrained(upURN, upField, downURN, downField, transform, query string) map[string]any {
+	return map[string]any{
+		"upstreams":          []map[string]any{{"urn": "urn:li:schemaField:(" + upURN + "," + upField + ")", "path": upField}},
+		"downstreams":        []map[string]any{{"urn": "urn:li:schemaField:(" + downURN + "," + downField + ")", "path": downField}},
+		"transformOperation": transform,
+		"query":              query,
+	}
+}
+
+// columnTraceServer fakes raw_orders.amount_cents -> orders_clean.amount (written by
+// a job) -> revenue_report.revenue_usd (via a stored query) and a chart reading
+// orders_clean.amount. Lookups of the failing charts, also downstream of orders_clean,
+// return an error.
+func columnTraceServer(t *testing.T, failing ...string) *Client {
+	t.Helper()
+	columnLineage := map[string][]map[string]any{
+		traceCleanURN:  {fineGrained(traceRawURN, "amount_cents", traceCleanURN, "amount", "IDENTITY", "")},
+		traceReportURN: {fineGrained(traceCleanURN, "amount", traceReportURN, "revenue_usd", "TRANSFORM", traceQueryURN)},
+	}
+	downstream := map[string][]map[string]any{
+		traceRawURN: {lineageResult(traceCleanURN, 1, traceRawURN, traceCleanURN)},
+		traceCleanURN: {
+			lineageResult(traceReportURN, 1, traceCleanURN, traceReportURN),
+			lineageResult(traceChartURN, 1, traceCleanURN, traceChartURN),
+		},
+	}
+	for _, urn := range failing {
+		downstream[traceCleanURN] = append(downstream[traceCleanURN], lineageResult(urn, 1, traceCleanURN, urn))
+	}
+	for _, result := range downstream[traceCleanURN][1:] {
+		result["entity"].(map[string]any)["type"] = "CHART"
+	}
+	producers := map[string][]map[string]any{
+		traceCleanURN: {lineageResult(traceJobURN, 1, traceCleanURN, traceJobURN)},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Query     string         `json:"query"`
+			Variables map[string]any `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+		urn, _ := req.Variables["urn"].(string)
+		if slices.Contains(failing, urn) {
+			writeJSON(t, w, map[string]any{"errors": []map[string]any{{"message": "chart unavailable"}}})
+			return
+		}
+
+		var data map[string]any
+		switch {
+		case strings.Contains(req.Query, "getColumnLineage"):
+			data = map[string]any{"dataset": map[string]any{"fineGrainedLineages": columnLineage[urn]}}
+		case strings.Contains(req.Query, "getLineage("):
+			results := downstream[urn]
+			if req.Variables["direction"] == LineageDirectionUpstream {
+				results = producers[urn]
+			}
+			data = map[string]any{"searchAcrossLineage": map[string]any{"searchResults": results}}
+		case strings.Contains(req.Query, "getChart"):
+			data = map[string]any{"chart": map[string]any{
+				"urn":  urn,
+				"type": "CHART",
+				"inputFields": map[string]any{"fields": []map[string]any{
+					{"schemaFieldUrn": "urn:li:schemaField:(" + traceCleanURN + ",amount)", "schemaField": map[string]any{"fieldPath": "amount"}},
+					{"schemaFieldUrn": "urn:li:schemaField:(" + traceCleanURN + ",region)", "schemaField": map[string]any{"fieldPath": "region"}},
+				}},
+			}}
+		case strings.Contains(req.Query, "getQueryStatements"):
+			data = map[string]any{"entities": []map[string]any{{
+				"urn":        traceQueryURN,
+				"properties": map[string]any{"statement": map[string]any{"value": "SELECT amount / 100 AS revenue_usd FROM orders_clean"}},
+			}}}
+		default:
+			t.Errorf("unexpected query: %s", req.Query)
+		}
+		writeJSON(t, w, map[string]any{"data": data})
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := New(Config{URL: server.URL, Token: "test-token", RetryMax: 0})
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+	return c
+}
+
+func TestClientTraceColumnUpstream(t *testing.T) {
+	c := columnTraceServer(t)
+
+	trace, err := c.TraceColumn(context.Background(), traceReportURN, "revenue_usd")
+	if err != nil {
+		t.Fatalf("TraceColumn() unexpected error: %v", err)
+	}
+	if trace.Direction != LineageDirectionUpstream || trace.Depth != DefaultConfig().MaxLineageDepth {
+		t.Errorf("TraceColumn() direction/depth = %s/%d", trace.Direction, trace.Depth)
+	}
+	if len(trace.Hops) != 2 {
+		t.Fatalf("TraceColumn() Hops = %+v, want 2", trace.Hops)
+	}
+
+	first := trace.Hops[0]
+	if first.Level != 1 || first.Upstream.URN != traceCleanURN || first.Upstream.Field != "amount" || first.Downstream.Field != "revenue_usd" {
+		t.Errorf("TraceColumn() first hop = %+v", first)
+	}
+	if first.Transform != "TRANSFORM" || first.Query != traceQueryURN || !strings.Contains(first.SQL, "amount / 100") {
+		t.Errorf("TraceColumn() first hop transform/query/sql = %s/%s/%s", first.Transform, first.Query, first.SQL)
+	}
+	if first.Job != "" {
+		t.Errorf("TraceColumn() first hop job = %s, want none", first.Job)
+	}
+
+	second := trace.Hops[1]
+	if second.Level != 2 || second.Upstream.URN != traceRawURN || second.Upstream.Field != "amount_cents" || second.Job != traceJobURN {
+		t.Errorf("TraceColumn() second hop = %+v", second)
+	}
+
+	want := types.ColumnRef{URN: traceRawURN, Type: "DATASET", Field: "amount_cents"}
+	if len(trace.Endpoints) != 1 || trace.Endpoints[0] != want {
+		t.Errorf("TraceColumn() Endpoints = %+v, want %+v", trace.Endpoints, want)
+	}
+	if trace.Truncated {
+		t.Error("TraceColumn() Truncated = true, want false")
+	}
+}
+
+func TestClientTraceColumnDownstream(t *testing.T) {
+	c := columnTraceServer(t)
+
+	trace, err := c.TraceColumn(context.Background(), traceRawURN, "amount_cents", WithDirection("downstream"))
+	if err != nil {
+		t.Fatalf("TraceColumn() unexpected error: %v", err)
+	}
+	if len(trace.Hops) != 3 {
+		t.Fatalf("TraceColumn() Hops = %+v, want 3", trace.Hops)
+	}
+
+	reached := make(map[types.ColumnRef]int)
+	for _, hop := range trace.Hops {
+		reached[hop.Downstream] = hop.Level
+	}
+	for ref, level := range map[types.ColumnRef]int{
+		{URN: traceCleanURN, Type: "DATASET", Field: "amount"}:       1,
+		{URN: traceReportURN, Type: "DATASET", Field: "revenue_usd"}: 2,
+		{URN: traceChartURN, Type: "CHART", Field: "amount"}:         2,
+	} {
+		if got, ok := reached[ref]; !ok || got != level {
+			t.Errorf("TraceColumn() %+v reached at level %d (present %v), want %d", ref, got, ok, level)
+		}
+	}
+	if len(trace.Endpoints) != 2 {
+		t.Errorf("TraceColumn() Endpoints = %+v, want the report column and the chart field", trace.Endpoints)
+	}
+}
+
+func TestClientTraceColumnSkipsFailedLookups(t *testing.T) {
+	c := columnTraceServer(t, "urn:li:chart:(looker,broken)")
+
+	trace, err := c.TraceColumn(context.Background(), traceRawURN, "amount_cents", WithDirection("downstream"))
+	if err != nil {
+		t.Fatalf("TraceColumn() unexpected error: %v", err)
+	}
+	if len(trace.Hops) != 3 || len(trace.Endpoints) != 2 {
+		t.Errorf("TraceColumn() hops/endpoints = %d/%d, want 3/2 without the failed chart", len(trace.Hops), len(trace.Endpoints))
+	}
+}
+
+func TestClientTraceColumnDepthTruncates(t *testing.T) {
+	c := columnTraceServer(t)
+
+	trace, err := c.TraceColumn(context.Background(), traceReportURN, "revenue_usd", WithDepth(1))
+	if err != nil {
+		t.Fatalf("TraceColumn() unexpected error: %v", err)
+	}
+	if len(trace.Hops) != 1 || !trace.Truncated || len(trace.Endpoints) != 0 {
+		t.Errorf("TraceColumn() hops/truncated/endpoints = %d/%v/%d, want 1/true/0",
+			len(trace.Hops), trace.Truncated, len(trace.Endpoints))
+	}
+}
+
+func TestClientTraceColumnRejectsBoth(t *testing.T) {
+	c := columnTraceServer(t)
+
+	if _, err := c.TraceColumn(context.Background(), traceReportURN, "revenue_usd", WithDirection(LineageDirectionBoth)); err == nil {
+		t.Error("TraceColumn() expected error for BOTH")
+	}
+}
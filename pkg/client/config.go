@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -12,9 +13,21 @@ type Config struct {
 	// URL is the DataHub GMS URL (required).
 	URL string
 
-	// Token is the personal access token (required).
+	// Token is the personal access token. Required unless TokenProvider is set.
+	// May be a ${env:VAR} or ${file:/path} reference, resolved per request.
 	Token string
 
+	// TokenProvider supplies the token dynamically (file, command, etc.).
+	// When set, it takes precedence over Token and AuthMode.
+	TokenProvider TokenProvider
+
+	// AuthMode selects how requests are authenticated: AuthModeToken (default)
+	// or AuthModeOAuth2.
+	AuthMode string
+
+	// OAuth2 configures the client-credentials grant when AuthMode is AuthModeOAuth2.
+	OAuth2 OAuth2Config
+
 	// Timeout is the request timeout. Default: 30s.
 	Timeout time.Duration
 
@@ -56,6 +69,21 @@ func FromEnv() (Config, error) {
 	cfg.URL = os.Getenv("DATAHUB_URL")
 	cfg.Token = os.Getenv("DATAHUB_TOKEN")
 
+	if tokenFile := os.Getenv("DATAHUB_TOKEN_FILE"); tokenFile != "" {
+		cfg.TokenProvider = NewFileTokenProvider(tokenFile)
+	}
+
+	if tokenCommand := os.Getenv("DATAHUB_TOKEN_COMMAND"); tokenCommand != "" {
+		command, err := splitCommandLine(tokenCommand)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid DATAHUB_TOKEN_COMMAND: %w", err)
+		}
+		cfg.TokenProvider = NewCommandTokenProvider(command, 0)
+	}
+
+	cfg.AuthMode = strings.ToLower(os.Getenv("DATAHUB_AUTH_MODE"))
+	cfg.OAuth2 = oauth2FromEnv()
+
 	if timeout := os.Getenv("DATAHUB_TIMEOUT"); timeout != "" {
 		secs, err := strconv.Atoi(timeout)
 		if err != nil {
@@ -103,13 +131,40 @@ func FromEnv() (Config, error) {
 	return cfg, nil
 }
 
+// oauth2FromEnv reads the client-credentials settings from DATAHUB_OAUTH2_* variables.
+func oauth2FromEnv() OAuth2Config {
+	cfg := OAuth2Config{
+		ClientID:     os.Getenv("DATAHUB_OAUTH2_CLIENT_ID"),
+		ClientSecret: os.Getenv("DATAHUB_OAUTH2_CLIENT_SECRET"),
+		TokenURL:     os.Getenv("DATAHUB_OAUTH2_TOKEN_URL"),
+	}
+	if scopes := os.Getenv("DATAHUB_OAUTH2_SCOPES"); scopes != "" {
+		cfg.Scopes = strings.FieldsFunc(scopes, func(r rune) bool {
+			return r == ',' || r == ' '
+		})
+	}
+	if audience := os.Getenv("DATAHUB_OAUTH2_AUDIENCE"); audience != "" {
+		cfg.EndpointParams = map[string][]string{"audience": {audience}}
+	}
+	return cfg
+}
+
 // Validate checks if the configuration is valid.
 func (c Config) Validate() error {
 	if c.URL == "" {
 		return fmt.Errorf("DATAHUB_URL is required")
 	}
-	if c.Token == "" {
-		return fmt.Errorf("DATAHUB_TOKEN is required")
+	switch c.AuthMode {
+	case "", AuthModeToken:
+		if c.Token == "" && c.TokenProvider == nil {
+			return fmt.Errorf("DATAHUB_TOKEN is required")
+		}
+	case AuthModeOAuth2:
+		if c.TokenProvider == nil {
+			return c.OAuth2.Validate()
+		}
+	default:
+		return fmt.Errorf("unsupported DATAHUB_AUTH_MODE %q (expected %q or %q)", c.AuthMode, AuthModeToken, AuthModeOAuth2)
 	}
 	return nil
 }
@@ -0,0 +1,277 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+// browsePathRaw mirrors the browsePathEntries fragment.
+type browsePathRaw struct {
+	Path []struct {
+		Name   string `json:"name"`
+		Entity *struct {
+			URN        string `json:"urn"`
+			Type       string `json:"type"`
+			Properties *struct {
+				Name string `json:"name"`
+			} `json:"properties"`
+			SubTypes *struct {
+				TypeNames []string `json:"typeNames"`
+			} `json:"subTypes"`
+		} `json:"entity"`
+	} `json:"path"`
+}
+
+// toPath converts the raw browse path, preferring entity display names over path segments.
+func (b *browsePathRaw) toPath() []types.PathEntry {
+	if b == nil {
+		return nil
+	}
+	path := make([]types.PathEntry, 0, len(b.Path))
+	for _, p := range b.Path {
+		entry := types.PathEntry{Name: p.Name}
+		if e := p.Entity; e != nil {
+			entry.URN = e.URN
+			entry.Type = e.Type
+			if e.Properties != nil && e.Properties.Name != "" {
+				entry.Name = e.Properties.Name
+			}
+			if e.SubTypes != nil {
+				entry.SubTypes = e.SubTypes.TypeNames
+			}
+		}
+		path = append(path, entry)
+	}
+	return path
+}
+
+// GetContainer retrieves a container with its ancestors and the number of direct children.
+func (c *Client) GetContainer(ctx context.Context, urn string) (*types.Container, error) {
+	variables := map[string]any{"urn": urn}
+
+	var response struct {
+		Container struct {
+			URN        string `json:"urn"`
+			Properties *struct {
+				Name        string `json:"name"`
+				Description string `json:"description"`
+				ExternalURL string `json:"externalUrl"`
+			} `json:"properties"`
+			EditableProperties *struct {
+				Description string `json:"description"`
+			} `json:"editableProperties"`
+			Platform *struct {
+				Name string `json:"name"`
+			} `json:"platform"`
+			DataPlatformInstance *struct {
+				URN string `json:"urn"`
+			} `json:"dataPlatformInstance"`
+			SubTypes *struct {
+				TypeNames []string `json:"typeNames"`
+			} `json:"subTypes"`
+			Entities *struct {
+				Total int `json:"total"`
+			} `json:"entities"`
+			BrowsePathV2 *browsePathRaw `json:"browsePathV2"`
+		} `json:"container"`
+	}
+
+	if err := c.Execute(ctx, GetContainerQuery, variables, &response); err != nil {
+		return nil, fmt.Errorf("GetContainer(%s): %w", urn, err)
+	}
+
+	raw := response.Container
+	if raw.URN == "" {
+		return nil, fmt.Errorf("GetContainer(%s): %w", urn, ErrNotFound)
+	}
+
+	container := &types.Container{
+		URN:  raw.URN,
+		Path: raw.BrowsePathV2.toPath(),
+	}
+	if p := raw.Properties; p != nil {
+		container.Name = p.Name
+		container.Description = p.Description
+		container.ExternalURL = p.ExternalURL
+	}
+	if raw.EditableProperties != nil && raw.EditableProperties.Description != "" {
+		container.Description = raw.EditableProperties.Description
+	}
+	if raw.Platform != nil {
+		container.Platform = raw.Platform.Name
+	}
+	if raw.DataPlatformInstance != nil {
+		container.PlatformInstance = raw.DataPlatformInstance.URN
+	}
+	if raw.SubTypes != nil {
+		container.SubTypes = raw.SubTypes.TypeNames
+	}
+	if raw.Entities != nil {
+		container.Children = raw.Entities.Total
+	}
+
+	return container, nil
+}
+
+// GetBrowsePath retrieves the browse path of an entity, root first. The path lists the
+// containers (and platform instance, if any) the entity lives in, excluding the entity itself.
+func (c *Client) GetBrowsePath(ctx context.Context, urn string) ([]types.PathEntry, error) {
+	variables := map[string]any{"urn": urn}
+
+	var response struct {
+		Entity struct {
+			URN          string         `json:"urn"`
+			BrowsePathV2 *browsePathRaw `json:"browsePathV2"`
+		} `json:"entity"`
+	}
+
+	if err := c.Execute(ctx, GetBrowsePathQuery, variables, &response); err != nil {
+		return nil, fmt.Errorf("GetBrowsePath(%s): %w", urn, err)
+	}
+	if response.Entity.URN == "" {
+		return nil, fmt.Errorf("GetBrowsePath(%s): %w", urn, ErrNotFound)
+	}
+
+	path := response.Entity.BrowsePathV2.toPath()
+	if path == nil {
+		path = []types.PathEntry{}
+	}
+	return path, nil
+}
+
+// Browse lists the direct children of a container, data platform or platform instance.
+// For platforms and platform instances only top-level entities (those not inside a
+// container) are returned.
+func (c *Client) Browse(ctx context.Context, urn string, opts ...BrowseOption) (*types.BrowseResult, error) {
+	options := c.browseOptions(opts)
+
+	filters, err := browseFilters(urn)
+	if err != nil {
+		return nil, fmt.Errorf("Browse(%s): %w", urn, err)
+	}
+
+	result, err := c.listEntities(ctx, urn, filters, options)
+	if err != nil {
+		return nil, fmt.Errorf("Browse(%s): %w", urn, err)
+	}
+	return result, nil
+}
+
+// listEntities runs BrowseQuery with the given filters and converts the page of
+// results. parent is recorded as the URN of the result.
+func (c *Client) listEntities(
+	ctx context.Context, parent string, filters []map[string]any, options *browseOptions,
+) (*types.BrowseResult, error) {
+	variables := map[string]any{
+		"input": map[string]any{
+			"query":     "*",
+			"start":     options.offset,
+			"count":     options.limit,
+			"orFilters": []map[string]any{{"and": filters}},
+		},
+	}
+
+	var response struct {
+		SearchAcrossEntities struct {
+			Start         int `json:"start"`
+			Count         int `json:"count"`
+			Total         int `json:"total"`
+			SearchResults []struct {
+				Entity struct {
+					URN         string `json:"urn"`
+					Type        string `json:"type"`
+					Name        string `json:"name"`
+					Description string `json:"description"`
+					Properties  *struct {
+						Name        string `json:"name"`
+						Description string `json:"description"`
+					} `json:"properties"`
+					Platform *struct {
+						Name string `json:"name"`
+					} `json:"platform"`
+					SubTypes *struct {
+						TypeNames []string `json:"typeNames"`
+					} `json:"subTypes"`
+				} `json:"entity"`
+			} `json:"searchResults"`
+		} `json:"searchAcrossEntities"`
+	}
+
+	if err := c.Execute(ctx, BrowseQuery, variables, &response); err != nil {
+		return nil, err
+	}
+
+	search := response.SearchAcrossEntities
+	result := &types.BrowseResult{
+		URN:     parent,
+		Total:   search.Total,
+		Offset:  search.Start,
+		Limit:   options.limit,
+		Entries: make([]types.BrowseEntry, 0, len(search.SearchResults)),
+	}
+	for _, sr := range search.SearchResults {
+		e := sr.Entity
+		entry := types.BrowseEntry{
+			URN:         e.URN,
+			Type:        e.Type,
+			Name:        e.Name,
+			Description: e.Description,
+		}
+		if e.Properties != nil {
+			if e.Properties.Name != "" {
+				entry.Name = e.Properties.Name
+			}
+			if e.Properties.Description != "" {
+				entry.Description = e.Properties.Description
+			}
+		}
+		if e.Platform != nil {
+			entry.Platform = e.Platform.Name
+		}
+		if e.SubTypes != nil {
+			entry.SubTypes = e.SubTypes.TypeNames
+		}
+		result.Entries = append(result.Entries, entry)
+	}
+
+	return result, nil
+}
+
+// browseOptions applies opts over the configured default and maximum limits.
+func (c *Client) browseOptions(opts []BrowseOption) *browseOptions {
+	options := &browseOptions{limit: c.config.DefaultLimit}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.limit <= 0 {
+		options.limit = c.config.DefaultLimit
+	}
+	if options.limit > c.config.MaxLimit {
+		options.limit = c.config.MaxLimit
+	}
+	return options
+}
+
+// browseFilters returns the search filters selecting the direct children of urn.
+func browseFilters(urn string) ([]map[string]any, error) {
+	parsed, err := ParseURN(urn)
+	if err != nil {
+		return nil, err
+	}
+
+	topLevel := map[string]any{"field": "container", "condition": "EXISTS", "negated": true}
+
+	switch parsed.EntityType {
+	case "container":
+		return []map[string]any{{"field": "container", "values": []string{urn}}}, nil
+	case "dataPlatform":
+		return []map[string]any{{"field": "platform", "values": []string{urn}}, topLevel}, nil
+	case "dataPlatformInstance":
+		return []map[string]any{{"field": "platformInstance", "values": []string{urn}}, topLevel}, nil
+	default:
+		return nil, fmt.Errorf("%w: cannot browse %s entities; use a container, dataPlatform or dataPlatformInstance URN",
+			ErrInvalidURN, parsed.EntityType)
+	}
+}
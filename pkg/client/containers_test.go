@@ -0,0 +1,193 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestClientGetContainer(t *testing.T) {
+	c := newGraphQLTestClient(t, map[string]any{
+		"container": map[string]any{
+			"urn": "urn:li:container:sales",
+			"properties": map[string]any{
+				"name":        "sales",
+				"description": "Sales schema",
+				"externalUrl": "https://snowflake.example.com/sales",
+			},
+			"editableProperties":   map[string]any{"description": "Curated sales data"},
+			"platform":             map[string]any{"name": "snowflake"},
+			"dataPlatformInstance": map[string]any{"urn": "urn:li:dataPlatformInstance:(urn:li:dataPlatform:snowflake,prod)"},
+			"subTypes":             map[string]any{"typeNames": []string{"Schema"}},
+			"entities":             map[string]any{"total": 42},
+			"browsePathV2": map[string]any{
+				"path": []map[string]any{
+					{"name": "prod", "entity": map[string]any{
+						"urn":  "urn:li:dataPlatformInstance:(urn:li:dataPlatform:snowflake,prod)",
+						"type": "DATA_PLATFORM_INSTANCE",
+					}},
+					{"name": "abc123", "entity": map[string]any{
+						"urn":        "urn:li:container:analytics",
+						"type":       "CONTAINER",
+						"properties": map[string]any{"name": "ANALYTICS"},
+						"subTypes":   map[string]any{"typeNames": []string{"Database"}},
+					}},
+				},
+			},
+		},
+	}, nil)
+
+	container, err := c.GetContainer(context.Background(), "urn:li:container:sales")
+	if err != nil {
+		t.Fatalf("GetContainer() unexpected error: %v", err)
+	}
+	if container.Name != "sales" || container.Description != "Curated sales data" || container.Platform != "snowflake" {
+		t.Errorf("unexpected container: %+v", container)
+	}
+	if container.Children != 42 || len(container.SubTypes) != 1 || container.SubTypes[0] != "Schema" {
+		t.Errorf("unexpected children or sub types: %+v", container)
+	}
+	if len(container.Path) != 2 || container.Path[1].Name != "ANALYTICS" || container.Path[1].SubTypes[0] != "Database" {
+		t.Errorf("Path = %+v, want instance then ANALYTICS database", container.Path)
+	}
+	if container.Path[0].Name != "prod" || container.Path[0].Type != "DATA_PLATFORM_INSTANCE" {
+		t.Errorf("Path[0] = %+v", container.Path[0])
+	}
+}
+
+func TestClientGetContainerNotFound(t *testing.T) {
+	c := newGraphQLTestClient(t, map[string]any{"container": nil}, nil)
+
+	_, err := c.GetContainer(context.Background(), "urn:li:container:missing")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetContainer() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestClientGetBrowsePath(t *testing.T) {
+	c := newGraphQLTestClient(t, map[string]any{
+		"entity": map[string]any{
+			"urn": "urn:li:dataset:orders",
+			"browsePathV2": map[string]any{
+				"path": []map[string]any{
+					{"name": "legacy-folder"},
+					{"name": "x", "entity": map[string]any{
+						"urn": "urn:li:container:sales", "type": "CONTAINER", "properties": map[string]any{"name": "sales"},
+					}},
+				},
+			},
+		},
+	}, nil)
+
+	path, err := c.GetBrowsePath(context.Background(), "urn:li:dataset:orders")
+	if err != nil {
+		t.Fatalf("GetBrowsePath() unexpected error: %v", err)
+	}
+	if len(path) != 2 || path[0].Name != "legacy-folder" || path[0].URN != "" || path[1].Name != "sales" {
+		t.Errorf("path = %+v", path)
+	}
+}
+
+func TestClientGetBrowsePathEmpty(t *testing.T) {
+	c := newGraphQLTestClient(t, map[string]any{"entity": map[string]any{"urn": "urn:li:tag:pii"}}, nil)
+
+	path, err := c.GetBrowsePath(context.Background(), "urn:li:tag:pii")
+	if err != nil {
+		t.Fatalf("GetBrowsePath() unexpected error: %v", err)
+	}
+	if path == nil || len(path) != 0 {
+		t.Errorf("expected empty non-nil path, got %v", path)
+	}
+
+	c = newGraphQLTestClient(t, map[string]any{"entity": nil}, nil)
+	if _, err := c.GetBrowsePath(context.Background(), "urn:li:dataset:missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetBrowsePath() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestClientBrowse(t *testing.T) {
+	var vars map[string]any
+	c := newGraphQLTestClient(t, map[string]any{
+		"searchAcrossEntities": map[string]any{
+			"start": 5,
+			"count": 2,
+			"total": 7,
+			"searchResults": []map[string]any{
+				{"entity": map[string]any{
+					"urn":        "urn:li:container:sales",
+					"type":       "CONTAINER",
+					"properties": map[string]any{"name": "sales", "description": "Sales schema"},
+					"platform":   map[string]any{"name": "snowflake"},
+					"subTypes":   map[string]any{"typeNames": []string{"Schema"}},
+				}},
+				{"entity": map[string]any{
+					"urn":      "urn:li:dataset:orders",
+					"type":     "DATASET",
+					"name":     "orders",
+					"platform": map[string]any{"name": "snowflake"},
+					"subTypes": map[string]any{"typeNames": []string{"Table"}},
+				}},
+			},
+		},
+	}, &vars)
+
+	result, err := c.Browse(context.Background(), "urn:li:container:analytics", WithBrowseLimit(2), WithBrowseOffset(5))
+	if err != nil {
+		t.Fatalf("Browse() unexpected error: %v", err)
+	}
+
+	input := vars["input"].(map[string]any)
+	if input["count"] != float64(2) || input["start"] != float64(5) {
+		t.Errorf("paging = %v/%v, want 2/5", input["count"], input["start"])
+	}
+	and := input["orFilters"].([]any)[0].(map[string]any)["and"].([]any)
+	filter := and[0].(map[string]any)
+	if len(and) != 1 || filter["field"] != "container" || filter["values"].([]any)[0] != "urn:li:container:analytics" {
+		t.Errorf("filters = %v, want container filter", and)
+	}
+
+	if result.Total != 7 || result.Offset != 5 || result.Limit != 2 || len(result.Entries) != 2 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if result.Entries[0].Name != "sales" || result.Entries[0].Description != "Sales schema" || result.Entries[0].SubTypes[0] != "Schema" {
+		t.Errorf("Entries[0] = %+v", result.Entries[0])
+	}
+	if result.Entries[1].Name != "orders" || result.Entries[1].Type != "DATASET" {
+		t.Errorf("Entries[1] = %+v", result.Entries[1])
+	}
+}
+
+func TestBrowseFilters(t *testing.T) {
+	tests := []struct {
+		urn       string
+		wantField string
+		wantCount int
+		wantErr   bool
+	}{
+		{"urn:li:container:abc", "container", 1, false},
+		{"urn:li:dataPlatform:snowflake", "platform", 2, false},
+		{"urn:li:dataPlatformInstance:(urn:li:dataPlatform:snowflake,prod)", "platformInstance", 2, false},
+		{"urn:li:dataset:(urn:li:dataPlatform:hive,db.t,PROD)", "", 0, true},
+		{"not-a-urn", "", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.urn, func(t *testing.T) {
+			filters, err := browseFilters(tt.urn)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidURN) {
+					t.Errorf("browseFilters() error = %v, want ErrInvalidURN", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("browseFilters() unexpected error: %v", err)
+			}
+			if len(filters) != tt.wantCount || filters[0]["field"] != tt.wantField {
+				t.Errorf("browseFilters() = %v", filters)
+			}
+			if tt.wantCount == 2 && filters[1]["negated"] != true {
+				t.Errorf("top-level filter missing: %v", filters)
+			}
+		})
+	}
+}
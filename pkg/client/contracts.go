@@ -0,0 +1,114 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+// contractAssertionRaw mirrors the FreshnessContract, SchemaContract and
+// DataQualityContract types in the GraphQL schema.
+type contractAssertionRaw struct {
+	Assertion *assertionRaw `json:"assertion"`
+}
+
+// toAssertions converts contract entries, skipping any without a resolvable assertion.
+func toAssertions(raw []contractAssertionRaw) []types.Assertion {
+	var assertions []types.Assertion
+	for _, r := range raw {
+		if r.Assertion != nil && r.Assertion.URN != "" {
+			assertions = append(assertions, r.Assertion.toAssertion())
+		}
+	}
+	return assertions
+}
+
+// GetDataContract retrieves the data contract on a dataset, with the definition and
+// recent runs of each freshness, schema and data quality assertion it references.
+// Returns ErrNotFound if the dataset does not exist or has no contract.
+func (c *Client) GetDataContract(ctx context.Context, urn string, opts ...AssertionOption) (*types.DataContract, error) {
+	options := &assertionOptions{runLimit: defaultAssertionRunLimit}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.runLimit <= 0 {
+		options.runLimit = defaultAssertionRunLimit
+	}
+
+	variables := map[string]any{
+		"urn":      urn,
+		"runLimit": options.runLimit,
+	}
+
+	var response struct {
+		Dataset struct {
+			URN      string `json:"urn"`
+			Contract *struct {
+				URN        string `json:"urn"`
+				Properties *struct {
+					EntityURN   string                 `json:"entityUrn"`
+					Freshness   []contractAssertionRaw `json:"freshness"`
+					Schema      []contractAssertionRaw `json:"schema"`
+					DataQuality []contractAssertionRaw `json:"dataQuality"`
+				} `json:"properties"`
+				Status *struct {
+					State string `json:"state"`
+				} `json:"status"`
+			} `json:"contract"`
+		} `json:"dataset"`
+	}
+
+	if err := c.Execute(ctx, GetDataContractQuery, variables, &response); err != nil {
+		return nil, fmt.Errorf("GetDataContract(%s): %w", urn, err)
+	}
+	if response.Dataset.URN == "" {
+		return nil, fmt.Errorf("GetDataContract(%s): %w", urn, ErrNotFound)
+	}
+	raw := response.Dataset.Contract
+	if raw == nil || raw.URN == "" {
+		return nil, fmt.Errorf("GetDataContract(%s): no data contract: %w", urn, ErrNotFound)
+	}
+
+	contract := &types.DataContract{
+		URN:       raw.URN,
+		EntityURN: response.Dataset.URN,
+	}
+	if raw.Status != nil {
+		contract.State = raw.Status.State
+	}
+	if p := raw.Properties; p != nil {
+		if p.EntityURN != "" {
+			contract.EntityURN = p.EntityURN
+		}
+		contract.Freshness = toAssertions(p.Freshness)
+		contract.Schema = toAssertions(p.Schema)
+		contract.DataQuality = toAssertions(p.DataQuality)
+	}
+	contract.Result = contractResult(contract)
+
+	return contract, nil
+}
+
+// contractResult derives the overall contract result from the latest run of each assertion.
+func contractResult(contract *types.DataContract) string {
+	result := types.DataContractResultPassing
+	total := 0
+	for _, group := range [][]types.Assertion{contract.Freshness, contract.Schema, contract.DataQuality} {
+		for _, a := range group {
+			total++
+			switch {
+			case a.LatestRun == nil:
+				result = types.DataContractResultUnknown
+			case a.LatestRun.Result == types.AssertionResultFailure, a.LatestRun.Result == types.AssertionResultError:
+				return types.DataContractResultFailing
+			case a.LatestRun.Result != types.AssertionResultSuccess:
+				result = types.DataContractResultUnknown
+			}
+		}
+	}
+	if total == 0 {
+		return types.DataContractResultUnknown
+	}
+	return result
+}
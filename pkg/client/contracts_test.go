@@ -0,0 +1,131 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+// contractAssertion builds a contract entry whose assertion's latest run has the given result.
+func contractAssertion(urn, assertionType, result string) map[string]any {
+	assertion := map[string]any{
+		"urn":  urn,
+		"info": map[string]any{"type": assertionType},
+	}
+	if result != "" {
+		assertion["runEvents"] = map[string]any{
+			"total": 1,
+			"runEvents": []map[string]any{
+				{"timestampMillis": 100, "result": map[string]any{"type": result}},
+			},
+		}
+	}
+	return map[string]any{"assertion": assertion}
+}
+
+func TestClientGetDataContract(t *testing.T) {
+	var vars map[string]any
+	c := newGraphQLTestClient(t, map[string]any{
+		"dataset": map[string]any{
+			"urn": "urn:li:dataset:orders",
+			"contract": map[string]any{
+				"urn": "urn:li:dataContract:orders",
+				"properties": map[string]any{
+					"entityUrn": "urn:li:dataset:orders",
+					"freshness": []map[string]any{contractAssertion("urn:li:assertion:fresh", "FRESHNESS", "SUCCESS")},
+					"schema":    []map[string]any{contractAssertion("urn:li:assertion:schema", "DATA_SCHEMA", "SUCCESS")},
+					"dataQuality": []map[string]any{
+						contractAssertion("urn:li:assertion:nulls", "FIELD", "FAILURE"),
+						{"assertion": nil},
+					},
+				},
+				"status": map[string]any{"state": "ACTIVE"},
+			},
+		},
+	}, &vars)
+
+	contract, err := c.GetDataContract(context.Background(), "urn:li:dataset:orders", WithAssertionRunLimit(1))
+	if err != nil {
+		t.Fatalf("GetDataContract() unexpected error: %v", err)
+	}
+	if vars["runLimit"] != float64(1) {
+		t.Errorf("runLimit = %v, want 1", vars["runLimit"])
+	}
+	if contract.URN != "urn:li:dataContract:orders" || contract.EntityURN != "urn:li:dataset:orders" || contract.State != "ACTIVE" {
+		t.Errorf("unexpected contract: %+v", contract)
+	}
+	if len(contract.Freshness) != 1 || len(contract.Schema) != 1 || len(contract.DataQuality) != 1 {
+		t.Errorf("unexpected assertion groups: %+v", contract)
+	}
+	if contract.DataQuality[0].LatestRun == nil || contract.DataQuality[0].LatestRun.Result != types.AssertionResultFailure {
+		t.Errorf("DataQuality[0].LatestRun = %+v", contract.DataQuality[0].LatestRun)
+	}
+	if contract.Result != types.DataContractResultFailing {
+		t.Errorf("Result = %s, want FAILING", contract.Result)
+	}
+}
+
+func TestClientGetDataContractNotFound(t *testing.T) {
+	tests := []struct {
+		name string
+		data map[string]any
+	}{
+		{"dataset missing", map[string]any{"dataset": map[string]any{"urn": ""}}},
+		{"no contract", map[string]any{"dataset": map[string]any{"urn": "urn:li:dataset:orders", "contract": nil}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newGraphQLTestClient(t, tt.data, nil)
+
+			_, err := c.GetDataContract(context.Background(), "urn:li:dataset:orders")
+			if !errors.Is(err, ErrNotFound) {
+				t.Errorf("GetDataContract() error = %v, want ErrNotFound", err)
+			}
+		})
+	}
+}
+
+func TestContractResult(t *testing.T) {
+	run := func(result string) *types.AssertionRun { return &types.AssertionRun{Result: result} }
+
+	tests := []struct {
+		name     string
+		contract types.DataContract
+		want     string
+	}{
+		{"no assertions", types.DataContract{}, types.DataContractResultUnknown},
+		{
+			"all passing",
+			types.DataContract{
+				Freshness: []types.Assertion{{LatestRun: run(types.AssertionResultSuccess)}},
+				Schema:    []types.Assertion{{LatestRun: run(types.AssertionResultSuccess)}},
+			},
+			types.DataContractResultPassing,
+		},
+		{
+			"never run",
+			types.DataContract{
+				Freshness:   []types.Assertion{{LatestRun: run(types.AssertionResultSuccess)}},
+				DataQuality: []types.Assertion{{}},
+			},
+			types.DataContractResultUnknown,
+		},
+		{
+			"erroring beats unknown",
+			types.DataContract{
+				Freshness: []types.Assertion{{}},
+				Schema:    []types.Assertion{{LatestRun: run(types.AssertionResultError)}},
+			},
+			types.DataContractResultFailing,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := contractResult(&tt.contract); got != tt.want {
+				t.Errorf("contractResult() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,241 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+// relatedEntitiesRaw mirrors the relatedEntities fragment.
+type relatedEntitiesRaw struct {
+	Total         int `json:"total"`
+	Relationships []struct {
+		Entity *struct {
+			URN        string `json:"urn"`
+			Type       string `json:"type"`
+			Name       string `json:"name"`
+			Properties *struct {
+				Name string `json:"name"`
+			} `json:"properties"`
+			Platform *struct {
+				Name string `json:"name"`
+			} `json:"platform"`
+		} `json:"entity"`
+	} `json:"relationships"`
+}
+
+// toRefs converts the related entities, skipping relationships whose entity is missing.
+func (r *relatedEntitiesRaw) toRefs() []types.EntityRef {
+	if r == nil {
+		return nil
+	}
+	var refs []types.EntityRef
+	for _, rel := range r.Relationships {
+		e := rel.Entity
+		if e == nil || e.URN == "" {
+			continue
+		}
+		ref := types.EntityRef{URN: e.URN, Type: e.Type, Name: e.Name}
+		if e.Properties != nil && e.Properties.Name != "" {
+			ref.Name = e.Properties.Name
+		}
+		if e.Platform != nil {
+			ref.Platform = e.Platform.Name
+		}
+		refs = append(refs, ref)
+	}
+	return refs
+}
+
+// inputFieldsRaw mirrors the inputFieldEntries fragment.
+type inputFieldsRaw struct {
+	Fields []struct {
+		SchemaFieldURN string `json:"schemaFieldUrn"`
+		SchemaField    *struct {
+			FieldPath      string `json:"fieldPath"`
+			Type           string `json:"type"`
+			NativeDataType string `json:"nativeDataType"`
+		} `json:"schemaField"`
+	} `json:"fields"`
+}
+
+// toInputFields converts the raw input fields, taking the dataset from the schema field URN.
+func (f *inputFieldsRaw) toInputFields() []types.InputField {
+	if f == nil {
+		return nil
+	}
+	var fields []types.InputField
+	for _, raw := range f.Fields {
+		field := types.InputField{DatasetURN: extractDatasetURNFromSchemaFieldURN(raw.SchemaFieldURN)}
+		if sf := raw.SchemaField; sf != nil {
+			field.FieldPath = sf.FieldPath
+			field.Type = sf.Type
+			field.NativeType = sf.NativeDataType
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// entityPropertiesRaw holds the properties shared by charts and dashboards.
+type entityPropertiesRaw struct {
+	Name          string         `json:"name"`
+	Description   string         `json:"description"`
+	ExternalURL   string         `json:"externalUrl"`
+	LastRefreshed int64          `json:"lastRefreshed"`
+	Created       *auditStampGQL `json:"created"`
+	LastModified  *auditStampGQL `json:"lastModified"`
+}
+
+// chartRaw mirrors the chartDetails fragment.
+type chartRaw struct {
+	URN      string `json:"urn"`
+	Type     string `json:"type"`
+	Platform *struct {
+		Name string `json:"name"`
+	} `json:"platform"`
+	Properties *struct {
+		entityPropertiesRaw
+		ChartURL string `json:"chartUrl"`
+		Type     string `json:"type"`
+	} `json:"properties"`
+	EditableProperties *struct {
+		Description string `json:"description"`
+	} `json:"editableProperties"`
+	Query *struct {
+		RawQuery string `json:"rawQuery"`
+		Type     string `json:"type"`
+	} `json:"query"`
+	InputFields *inputFieldsRaw     `json:"inputFields"`
+	Datasets    *relatedEntitiesRaw `json:"datasets"`
+	Dashboards  *relatedEntitiesRaw `json:"dashboards"`
+}
+
+// toChart converts the raw chart. Editable descriptions take precedence.
+func (r *chartRaw) toChart() types.Chart {
+	chart := types.Chart{
+		Entity:        types.Entity{URN: r.URN, Type: r.Type},
+		InputDatasets: r.Datasets.toRefs(),
+		InputFields:   r.InputFields.toInputFields(),
+		Dashboards:    r.Dashboards.toRefs(),
+	}
+	if r.Platform != nil {
+		chart.Platform = r.Platform.Name
+	}
+	if p := r.Properties; p != nil {
+		applyEntityProperties(&chart.Entity, &p.entityPropertiesRaw)
+		chart.ChartURL = p.ChartURL
+		chart.ExternalURL = p.ExternalURL
+		chart.ChartType = p.Type
+		chart.LastRefreshed = p.LastRefreshed
+	}
+	if r.EditableProperties != nil && r.EditableProperties.Description != "" {
+		chart.Description = r.EditableProperties.Description
+	}
+	if r.Query != nil && r.Query.RawQuery != "" {
+		chart.Query = &types.ChartQuery{Type: r.Query.Type, RawQuery: r.Query.RawQuery}
+	}
+	return chart
+}
+
+// applyEntityProperties copies the shared chart and dashboard properties onto entity.
+func applyEntityProperties(entity *types.Entity, p *entityPropertiesRaw) {
+	entity.Name = p.Name
+	entity.Description = p.Description
+	if p.Created != nil {
+		entity.Created = p.Created.Time
+	}
+	if p.LastModified != nil {
+		entity.LastModified = p.LastModified.Time
+	}
+}
+
+// GetDashboard retrieves a dashboard with its charts, including each chart's query and
+// the datasets and fields it reads, plus any datasets the dashboard reads directly.
+// At most 100 charts are returned; TotalCharts reports the full count.
+func (c *Client) GetDashboard(ctx context.Context, urn string) (*types.Dashboard, error) {
+	variables := map[string]any{"urn": urn}
+
+	var response struct {
+		Dashboard struct {
+			URN      string `json:"urn"`
+			Type     string `json:"type"`
+			Platform *struct {
+				Name string `json:"name"`
+			} `json:"platform"`
+			Properties *struct {
+				entityPropertiesRaw
+				DashboardURL string `json:"dashboardUrl"`
+			} `json:"properties"`
+			EditableProperties *struct {
+				Description string `json:"description"`
+			} `json:"editableProperties"`
+			InputFields *inputFieldsRaw     `json:"inputFields"`
+			Datasets    *relatedEntitiesRaw `json:"datasets"`
+			Charts      *struct {
+				Total         int `json:"total"`
+				Relationships []struct {
+					Entity *chartRaw `json:"entity"`
+				} `json:"relationships"`
+			} `json:"charts"`
+		} `json:"dashboard"`
+	}
+
+	if err := c.Execute(ctx, GetDashboardQuery, variables, &response); err != nil {
+		return nil, fmt.Errorf("GetDashboard(%s): %w", urn, err)
+	}
+
+	raw := response.Dashboard
+	if raw.URN == "" {
+		return nil, fmt.Errorf("GetDashboard(%s): %w", urn, ErrNotFound)
+	}
+
+	dashboard := &types.Dashboard{
+		Entity:        types.Entity{URN: raw.URN, Type: raw.Type},
+		InputDatasets: raw.Datasets.toRefs(),
+		InputFields:   raw.InputFields.toInputFields(),
+	}
+	if raw.Platform != nil {
+		dashboard.Platform = raw.Platform.Name
+	}
+	if p := raw.Properties; p != nil {
+		applyEntityProperties(&dashboard.Entity, &p.entityPropertiesRaw)
+		dashboard.DashboardURL = p.DashboardURL
+		dashboard.ExternalURL = p.ExternalURL
+		dashboard.LastRefreshed = p.LastRefreshed
+	}
+	if raw.EditableProperties != nil && raw.EditableProperties.Description != "" {
+		dashboard.Description = raw.EditableProperties.Description
+	}
+	if raw.Charts != nil {
+		dashboard.TotalCharts = raw.Charts.Total
+		for _, rel := range raw.Charts.Relationships {
+			if rel.Entity != nil && rel.Entity.URN != "" {
+				dashboard.Charts = append(dashboard.Charts, rel.Entity.toChart())
+			}
+		}
+	}
+
+	return dashboard, nil
+}
+
+// GetChart retrieves a chart with its query, the datasets and fields it reads and the
+// dashboards that contain it.
+func (c *Client) GetChart(ctx context.Context, urn string) (*types.Chart, error) {
+	variables := map[string]any{"urn": urn}
+
+	var response struct {
+		Chart chartRaw `json:"chart"`
+	}
+
+	if err := c.Execute(ctx, GetChartQuery, variables, &response); err != nil {
+		return nil, fmt.Errorf("GetChart(%s): %w", urn, err)
+	}
+	if response.Chart.URN == "" {
+		return nil, fmt.Errorf("GetChart(%s): %w", urn, ErrNotFound)
+	}
+
+	chart := response.Chart.toChart()
+	return &chart, nil
+}
@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+const ordersURN = "urn:li:dataset:(urn:li:dataPlatform:snowflake,prod.sales.orders,PROD)"
+
+func testChartData() map[string]any {
+	return map[string]any{
+		"urn":      "urn:li:chart:(looker,7)",
+		"type":     "CHART",
+		"platform": map[string]any{"name": "looker"},
+		"properties": map[string]any{
+			"name":          "Revenue by Region",
+			"description":   "Revenue",
+			"externalUrl":   "https://looker.example.com/7",
+			"chartUrl":      "https://looker.example.com/charts/7",
+			"type":          "BAR",
+			"lastRefreshed": 1700000000000,
+			"created":       map[string]any{"time": 1600000000000},
+			"lastModified":  map[string]any{"time": 1650000000000},
+		},
+		"editableProperties": map[string]any{"description": "Revenue by sales region"},
+		"query":              map[string]any{"rawQuery": "SELECT region, SUM(amount) FROM orders", "type": "SQL"},
+		"inputFields": map[string]any{
+			"fields": []map[string]any{{
+				"schemaFieldUrn": "urn:li:schemaField:(" + ordersURN + ",amount)",
+				"schemaField":    map[string]any{"fieldPath": "amount", "type": "NUMBER", "nativeDataType": "NUMBER(38,2)"},
+			}},
+		},
+		"datasets": map[string]any{
+			"total": 1,
+			"relationships": []map[string]any{
+				{"entity": map[string]any{
+					"urn": ordersURN, "type": "DATASET", "name": "prod.sales.orders",
+					"properties": map[string]any{"name": "orders"}, "platform": map[string]any{"name": "snowflake"},
+				}},
+				{"entity": nil},
+			},
+		},
+	}
+}
+
+func TestClientGetDashboard(t *testing.T) {
+	var vars map[string]any
+	c := newGraphQLTestClient(t, map[string]any{
+		"dashboard": map[string]any{
+			"urn":      "urn:li:dashboard:(looker,42)",
+			"type":     "DASHBOARD",
+			"platform": map[string]any{"name": "looker"},
+			"properties": map[string]any{
+				"name":          "Sales Overview",
+				"externalUrl":   "https://looker.example.com/dashboards/42",
+				"dashboardUrl":  "https://looker.example.com/d/42",
+				"lastRefreshed": 1700000000000,
+			},
+			"inputFields": map[string]any{
+				"fields": []map[string]any{{"schemaFieldUrn": "urn:li:schemaField:(" + ordersURN + ",region)"}},
+			},
+			"charts": map[string]any{
+				"total":         150,
+				"relationships": []map[string]any{{"entity": testChartData()}},
+			},
+		},
+	}, &vars)
+
+	dashboard, err := c.GetDashboard(context.Background(), "urn:li:dashboard:(looker,42)")
+	if err != nil {
+		t.Fatalf("GetDashboard() unexpected error: %v", err)
+	}
+	if vars["urn"] != "urn:li:dashboard:(looker,42)" {
+		t.Errorf("urn variable = %v", vars["urn"])
+	}
+	if dashboard.Name != "Sales Overview" || dashboard.Platform != "looker" ||
+		dashboard.DashboardURL == "" || dashboard.LastRefreshed != 1700000000000 {
+		t.Errorf("unexpected dashboard: %+v", dashboard)
+	}
+	if dashboard.TotalCharts != 150 || len(dashboard.Charts) != 1 {
+		t.Fatalf("charts = %d of %d, want 1 of 150", len(dashboard.Charts), dashboard.TotalCharts)
+	}
+	if len(dashboard.InputFields) != 1 || dashboard.InputFields[0].DatasetURN != ordersURN {
+		t.Errorf("InputFields = %+v", dashboard.InputFields)
+	}
+	if dashboard.InputDatasets != nil {
+		t.Errorf("InputDatasets = %+v, want none", dashboard.InputDatasets)
+	}
+}
+
+func TestClientGetChart(t *testing.T) {
+	data := testChartData()
+	data["dashboards"] = map[string]any{
+		"total": 1,
+		"relationships": []map[string]any{
+			{"entity": map[string]any{
+				"urn": "urn:li:dashboard:(looker,42)", "type": "DASHBOARD", "properties": map[string]any{"name": "Sales Overview"},
+			}},
+		},
+	}
+	c := newGraphQLTestClient(t, map[string]any{"chart": data}, nil)
+
+	chart, err := c.GetChart(context.Background(), "urn:li:chart:(looker,7)")
+	if err != nil {
+		t.Fatalf("GetChart() unexpected error: %v", err)
+	}
+	if chart.Name != "Revenue by Region" || chart.Description != "Revenue by sales region" || chart.ChartType != "BAR" {
+		t.Errorf("unexpected chart: %+v", chart)
+	}
+	if chart.Created != 1600000000000 || chart.LastModified != 1650000000000 || chart.LastRefreshed != 1700000000000 {
+		t.Errorf("unexpected timestamps: %+v", chart)
+	}
+	if chart.Query == nil || chart.Query.Type != "SQL" {
+		t.Errorf("Query = %+v", chart.Query)
+	}
+	if len(chart.InputDatasets) != 1 || chart.InputDatasets[0].Name != "orders" || chart.InputDatasets[0].Platform != "snowflake" {
+		t.Errorf("InputDatasets = %+v", chart.InputDatasets)
+	}
+	field := chart.InputFields[0]
+	if field.DatasetURN != ordersURN || field.FieldPath != "amount" || field.NativeType != "NUMBER(38,2)" {
+		t.Errorf("InputFields[0] = %+v", field)
+	}
+	if len(chart.Dashboards) != 1 || chart.Dashboards[0].Name != "Sales Overview" {
+		t.Errorf("Dashboards = %+v", chart.Dashboards)
+	}
+}
+
+func TestClientGetDashboardNotFound(t *testing.T) {
+	c := newGraphQLTestClient(t, map[string]any{"dashboard": nil, "chart": nil}, nil)
+
+	if _, err := c.GetDashboard(context.Background(), "urn:li:dashboard:(looker,missing)"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetDashboard() error = %v, want ErrNotFound", err)
+	}
+	if _, err := c.GetChart(context.Background(), "urn:li:chart:(looker,missing)"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetChart() error = %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+// freshnessOperationLimit is the number of recent operations inspected for the latest update.
+const freshnessOperationLimit = 5
+
+// GetDatasetFreshness reports when a dataset's data last changed: the latest operation
+// reported to DataHub (a load, insert or update), or else the last-modified time
+// reported by the source system. LastUpdated is zero when DataHub has neither.
+// Returns ErrNotFound if the dataset does not exist.
+func (c *Client) GetDatasetFreshness(ctx context.Context, urn string) (*types.Freshness, error) {
+	variables := map[string]any{
+		"urn":   urn,
+		"limit": freshnessOperationLimit,
+	}
+
+	var response struct {
+		Dataset struct {
+			URN        string `json:"urn"`
+			Operations []struct {
+				LastUpdatedTimestamp int64  `json:"lastUpdatedTimestamp"`
+				OperationType        string `json:"operationType"`
+				CustomOperationType  string `json:"customOperationType"`
+				Actor                string `json:"actor"`
+			} `json:"operations"`
+			Properties *struct {
+				LastModified *auditStampGQL `json:"lastModified"`
+			} `json:"properties"`
+		} `json:"dataset"`
+	}
+
+	if err := c.Execute(ctx, GetDatasetFreshnessQuery, variables, &response); err != nil {
+		return nil, fmt.Errorf("GetDatasetFreshness(%s): %w", urn, err)
+	}
+	if response.Dataset.URN == "" {
+		return nil, fmt.Errorf("GetDatasetFreshness(%s): %w", urn, ErrNotFound)
+	}
+
+	result := &types.Freshness{URN: urn}
+	for _, op := range response.Dataset.Operations {
+		if op.LastUpdatedTimestamp <= result.LastUpdated {
+			continue
+		}
+		result.LastUpdated = op.LastUpdatedTimestamp
+		result.Source = types.FreshnessSourceOperation
+		result.Operation = op.OperationType
+		if op.OperationType == "CUSTOM" && op.CustomOperationType != "" {
+			result.Operation = op.CustomOperationType
+		}
+		result.Actor = op.Actor
+	}
+	if result.LastUpdated > 0 {
+		return result, nil
+	}
+
+	if p := response.Dataset.Properties; p != nil && p.LastModified != nil && p.LastModified.Time > 0 {
+		result.LastUpdated = p.LastModified.Time
+		result.Source = types.FreshnessSourceLastModified
+		result.Actor = p.LastModified.Actor
+	}
+	return result, nil
+}
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+func TestClientGetDatasetFreshness(t *testing.T) {
+	var vars map[string]any
+	c := newGraphQLTestClient(t, map[string]any{
+		"dataset": map[string]any{
+			"urn": "urn:li:dataset:orders",
+			"operations": []map[string]any{
+				{"lastUpdatedTimestamp": 1000, "operationType": "INSERT", "actor": "urn:li:corpuser:etl"},
+				{"lastUpdatedTimestamp": 3000, "operationType": "CUSTOM", "customOperationType": "MERGE", "actor": "urn:li:corpuser:dbt"},
+				{"lastUpdatedTimestamp": 2000, "operationType": "UPDATE"},
+			},
+			"properties": map[string]any{"lastModified": map[string]any{"time": 5000}},
+		},
+	}, &vars)
+
+	freshness, err := c.GetDatasetFreshness(context.Background(), "urn:li:dataset:orders")
+	if err != nil {
+		t.Fatalf("GetDatasetFreshness() unexpected error: %v", err)
+	}
+	if vars["urn"] != "urn:li:dataset:orders" || vars["limit"] != float64(freshnessOperationLimit) {
+		t.Errorf("GetDatasetFreshness() variables = %v", vars)
+	}
+	want := types.Freshness{
+		URN:         "urn:li:dataset:orders",
+		LastUpdated: 3000,
+		Source:      types.FreshnessSourceOperation,
+		Operation:   "MERGE",
+		Actor:       "urn:li:corpuser:dbt",
+	}
+	if *freshness != want {
+		t.Errorf("GetDatasetFreshness() = %+v, want the latest operation %+v", *freshness, want)
+	}
+}
+
+func TestClientGetDatasetFreshnessLastModified(t *testing.T) {
+	c := newGraphQLTestClient(t, map[string]any{
+		"dataset": map[string]any{
+			"urn":        "urn:li:dataset:orders",
+			"operations": []map[string]any{},
+			"properties": map[string]any{"lastModified": map[string]any{"time": 5000, "actor": "urn:li:corpuser:dba"}},
+		},
+	}, nil)
+
+	freshness, err := c.GetDatasetFreshness(context.Background(), "urn:li:dataset:orders")
+	if err != nil {
+		t.Fatalf("GetDatasetFreshness() unexpected error: %v", err)
+	}
+	if freshness.LastUpdated != 5000 || freshness.Source != types.FreshnessSourceLastModified || freshness.Actor != "urn:li:corpuser:dba" {
+		t.Errorf("GetDatasetFreshness() = %+v, want the source system's last-modified time", freshness)
+	}
+}
+
+func TestClientGetDatasetFreshnessUnknown(t *testing.T) {
+	c := newGraphQLTestClient(t, map[string]any{
+		"dataset": map[string]any{"urn": "urn:li:dataset:orders"},
+	}, nil)
+
+	freshness, err := c.GetDatasetFreshness(context.Background(), "urn:li:dataset:orders")
+	if err != nil {
+		t.Fatalf("GetDatasetFreshness() unexpected error: %v", err)
+	}
+	if freshness.LastUpdated != 0 || freshness.Source != "" {
+		t.Errorf("GetDatasetFreshness() = %+v, want no update time", freshness)
+	}
+}
+
+func TestClientGetDatasetFreshnessNotFound(t *testing.T) {
+	c := newGraphQLTestClient(t, map[string]any{"dataset": nil}, nil)
+
+	_, err := c.GetDatasetFreshness(context.Background(), "urn:li:dataset:missing")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetDatasetFreshness() error = %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,234 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+// glossaryRelationsRaw mirrors the glossaryRelations fragment.
+type glossaryRelationsRaw struct {
+	Relationships []struct {
+		Type   string `json:"type"`
+		Entity *struct {
+			URN        string `json:"urn"`
+			Name       string `json:"name"`
+			Properties *struct {
+				Name string `json:"name"`
+			} `json:"properties"`
+		} `json:"entity"`
+	} `json:"relationships"`
+}
+
+// toRelations converts the raw relationships, tagging each with direction.
+func (r *glossaryRelationsRaw) toRelations(direction string) []types.GlossaryTermRelation {
+	if r == nil {
+		return nil
+	}
+	var relations []types.GlossaryTermRelation
+	for _, rel := range r.Relationships {
+		e := rel.Entity
+		if e == nil || e.URN == "" {
+			continue
+		}
+		relation := types.GlossaryTermRelation{
+			URN:          e.URN,
+			Name:         e.Name,
+			RelationType: rel.Type,
+			Direction:    direction,
+		}
+		if e.Properties != nil && e.Properties.Name != "" {
+			relation.Name = e.Properties.Name
+		}
+		relations = append(relations, relation)
+	}
+	return relations
+}
+
+// glossaryEntryRaw mirrors the glossaryNodeEntry and glossaryTermEntry fragments.
+type glossaryEntryRaw struct {
+	URN        string `json:"urn"`
+	Type       string `json:"type"`
+	Name       string `json:"name"`
+	Properties *struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	} `json:"properties"`
+	ChildrenCount *struct {
+		NodesCount int `json:"nodesCount"`
+		TermsCount int `json:"termsCount"`
+	} `json:"childrenCount"`
+}
+
+// toEntry converts the raw node or term.
+func (r *glossaryEntryRaw) toEntry() types.GlossaryEntry {
+	entry := types.GlossaryEntry{URN: r.URN, Type: r.Type, Name: r.Name}
+	if p := r.Properties; p != nil {
+		entry.Name = firstNonEmpty(p.Name, entry.Name)
+		entry.Description = p.Description
+	}
+	if c := r.ChildrenCount; c != nil {
+		entry.ChildNodes = c.NodesCount
+		entry.ChildTerms = c.TermsCount
+	}
+	return entry
+}
+
+// ListGlossary lists one level of the business glossary: the nodes and terms directly
+// under the glossary node parentURN, or at the root of the glossary when parentURN is
+// empty. At the root, nodes are listed before terms.
+func (c *Client) ListGlossary(ctx context.Context, parentURN string, opts ...BrowseOption) (*types.GlossaryListing, error) {
+	options := c.browseOptions(opts)
+
+	var (
+		listing *types.GlossaryListing
+		err     error
+	)
+	if parentURN == "" {
+		listing, err = c.listRootGlossary(ctx, options)
+	} else {
+		listing, err = c.listGlossaryNode(ctx, parentURN, options)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("ListGlossary(%s): %w", parentURN, err)
+	}
+
+	listing.Offset = options.offset
+	listing.Limit = options.limit
+	return listing, nil
+}
+
+// rootGlossaryResponse is the GraphQL response shape for ListRootGlossaryQuery.
+type rootGlossaryResponse struct {
+	Nodes struct {
+		Total int                `json:"total"`
+		Nodes []glossaryEntryRaw `json:"nodes"`
+	} `json:"getRootGlossaryNodes"`
+	Terms struct {
+		Total int                `json:"total"`
+		Terms []glossaryEntryRaw `json:"terms"`
+	} `json:"getRootGlossaryTerms"`
+}
+
+// listRootGlossary pages over the root nodes followed by the root terms. The first
+// request fetches the node page and both totals; a second request fetches terms only
+// when the page extends past the last root node.
+func (c *Client) listRootGlossary(ctx context.Context, options *browseOptions) (*types.GlossaryListing, error) {
+	variables := map[string]any{
+		"nodeStart": options.offset,
+		"nodeCount": options.limit,
+		"termStart": 0,
+		"termCount": 0,
+	}
+
+	var first rootGlossaryResponse
+	if err := c.Execute(ctx, ListRootGlossaryQuery, variables, &first); err != nil {
+		return nil, err
+	}
+
+	listing := &types.GlossaryListing{
+		Total:   first.Nodes.Total + first.Terms.Total,
+		Entries: make([]types.GlossaryEntry, 0, options.limit),
+	}
+	for i := range first.Nodes.Nodes {
+		listing.Entries = append(listing.Entries, first.Nodes.Nodes[i].toEntry())
+	}
+
+	remaining := options.limit - len(listing.Entries)
+	termStart := max(options.offset-first.Nodes.Total, 0)
+	if remaining <= 0 || termStart >= first.Terms.Total {
+		return listing, nil
+	}
+
+	variables = map[string]any{
+		"nodeStart": 0,
+		"nodeCount": 0,
+		"termStart": termStart,
+		"termCount": remaining,
+	}
+
+	var second rootGlossaryResponse
+	if err := c.Execute(ctx, ListRootGlossaryQuery, variables, &second); err != nil {
+		return nil, err
+	}
+	for i := range second.Terms.Terms {
+		listing.Entries = append(listing.Entries, second.Terms.Terms[i].toEntry())
+	}
+
+	return listing, nil
+}
+
+// listGlossaryNode pages over the children of a glossary node.
+func (c *Client) listGlossaryNode(ctx context.Context, urn string, options *browseOptions) (*types.GlossaryListing, error) {
+	variables := map[string]any{
+		"urn":   urn,
+		"start": options.offset,
+		"count": options.limit,
+	}
+
+	var response struct {
+		GlossaryNode struct {
+			URN        string `json:"urn"`
+			Properties *struct {
+				Name string `json:"name"`
+			} `json:"properties"`
+			ParentNodes *struct {
+				Nodes []struct {
+					URN        string `json:"urn"`
+					Properties *struct {
+						Name string `json:"name"`
+					} `json:"properties"`
+				} `json:"nodes"`
+			} `json:"parentNodes"`
+			Children *struct {
+				Total         int `json:"total"`
+				Relationships []struct {
+					Entity *glossaryEntryRaw `json:"entity"`
+				} `json:"relationships"`
+			} `json:"children"`
+		} `json:"glossaryNode"`
+	}
+
+	if err := c.Execute(ctx, ListGlossaryNodeQuery, variables, &response); err != nil {
+		return nil, err
+	}
+
+	node := response.GlossaryNode
+	if node.URN == "" {
+		return nil, ErrNotFound
+	}
+
+	listing := &types.GlossaryListing{
+		Parent:  node.URN,
+		Entries: []types.GlossaryEntry{},
+	}
+
+	// Parent nodes are returned nearest first.
+	if node.ParentNodes != nil {
+		for i := len(node.ParentNodes.Nodes) - 1; i >= 0; i-- {
+			p := node.ParentNodes.Nodes[i]
+			ref := types.EntityRef{URN: p.URN, Type: types.GlossaryEntryNode}
+			if p.Properties != nil {
+				ref.Name = p.Properties.Name
+			}
+			listing.Path = append(listing.Path, ref)
+		}
+	}
+	self := types.EntityRef{URN: node.URN, Type: types.GlossaryEntryNode}
+	if node.Properties != nil {
+		self.Name = node.Properties.Name
+	}
+	listing.Path = append(listing.Path, self)
+
+	if node.Children != nil {
+		listing.Total = node.Children.Total
+		for _, rel := range node.Children.Relationships {
+			if rel.Entity != nil && rel.Entity.URN != "" {
+				listing.Entries = append(listing.Entries, rel.Entity.toEntry())
+			}
+		}
+	}
+
+	return listing, nil
+}
@@ -0,0 +1,146 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestClientGetGlossaryTermHierarchyAndRelations(t *testing.T) {
+	c := newGraphQLTestClient(t, map[string]any{
+		"glossaryTerm": map[string]any{
+			"urn":        "urn:li:glossaryTerm:Classification.PII",
+			"properties": map[string]any{"name": "PII"},
+			"parentNodes": map[string]any{
+				"nodes": []map[string]any{
+					{"urn": "urn:li:glossaryNode:Classification", "properties": map[string]any{"name": "Classification"}},
+					{"urn": "urn:li:glossaryNode:Governance", "properties": map[string]any{"name": "Governance"}},
+				},
+			},
+			"relatedTo": map[string]any{
+				"relationships": []map[string]any{
+					{"type": "IsA", "entity": map[string]any{
+						"urn": "urn:li:glossaryTerm:Sensitive", "properties": map[string]any{"name": "Sensitive Data"},
+					}},
+					{"type": "IsA", "entity": nil},
+				},
+			},
+			"relatedFrom": map[string]any{
+				"relationships": []map[string]any{
+					{"type": "IsA", "entity": map[string]any{"urn": "urn:li:glossaryTerm:Email", "name": "Email"}},
+				},
+			},
+		},
+		"usage": map[string]any{"total": 37},
+	}, nil)
+
+	term, err := c.GetGlossaryTerm(context.Background(), "urn:li:glossaryTerm:Classification.PII")
+	if err != nil {
+		t.Fatalf("GetGlossaryTerm() unexpected error: %v", err)
+	}
+	if term.ParentNode != "urn:li:glossaryNode:Classification" {
+		t.Errorf("ParentNode = %q, want direct parent", term.ParentNode)
+	}
+	if len(term.ParentNodes) != 2 || term.ParentNodes[0].Name != "Governance" || term.ParentNodes[1].Name != "Classification" {
+		t.Errorf("ParentNodes = %+v, want root first", term.ParentNodes)
+	}
+	if len(term.RelatedTerms) != 2 {
+		t.Fatalf("RelatedTerms = %+v, want 2", term.RelatedTerms)
+	}
+	if r := term.RelatedTerms[0]; r.Name != "Sensitive Data" || r.RelationType != "IsA" || r.Direction != "OUTGOING" {
+		t.Errorf("RelatedTerms[0] = %+v", r)
+	}
+	if r := term.RelatedTerms[1]; r.Name != "Email" || r.Direction != "INCOMING" {
+		t.Errorf("RelatedTerms[1] = %+v", r)
+	}
+	if term.EntityCount != 37 {
+		t.Errorf("EntityCount = %d, want 37", term.EntityCount)
+	}
+}
+
+func TestClientListGlossaryRoot(t *testing.T) {
+	var vars map[string]any
+	c := newGraphQLTestClient(t, map[string]any{
+		"getRootGlossaryNodes": map[string]any{
+			"total": 1,
+			"nodes": []map[string]any{
+				{
+					"urn": "urn:li:glossaryNode:Finance", "type": "GLOSSARY_NODE", "properties": map[string]any{"name": "Finance"},
+					"childrenCount": map[string]any{"nodesCount": 1, "termsCount": 4},
+				},
+			},
+		},
+		"getRootGlossaryTerms": map[string]any{
+			"total": 2,
+			"terms": []map[string]any{
+				{"urn": "urn:li:glossaryTerm:Customer", "type": "GLOSSARY_TERM", "name": "Customer"},
+				{
+					"urn": "urn:li:glossaryTerm:Order", "type": "GLOSSARY_TERM", "name": "Order",
+					"properties": map[string]any{"description": "A purchase"},
+				},
+			},
+		},
+	}, &vars)
+
+	listing, err := c.ListGlossary(context.Background(), "")
+	if err != nil {
+		t.Fatalf("ListGlossary() unexpected error: %v", err)
+	}
+	if listing.Parent != "" || listing.Total != 3 || listing.Limit != c.config.DefaultLimit {
+		t.Errorf("unexpected listing: %+v", listing)
+	}
+	if len(listing.Entries) != 3 || listing.Entries[0].Type != "GLOSSARY_NODE" || listing.Entries[0].ChildTerms != 4 {
+		t.Fatalf("Entries = %+v, want node then terms", listing.Entries)
+	}
+	if listing.Entries[2].Description != "A purchase" {
+		t.Errorf("Entries[2] = %+v", listing.Entries[2])
+	}
+	// The second request fetches only the terms that fill the rest of the page.
+	if vars["nodeCount"] != float64(0) || vars["termStart"] != float64(0) || vars["termCount"] != float64(c.config.DefaultLimit-1) {
+		t.Errorf("second request variables = %v", vars)
+	}
+}
+
+func TestClientListGlossaryNode(t *testing.T) {
+	var vars map[string]any
+	c := newGraphQLTestClient(t, map[string]any{
+		"glossaryNode": map[string]any{
+			"urn":        "urn:li:glossaryNode:Finance",
+			"properties": map[string]any{"name": "Finance"},
+			"parentNodes": map[string]any{
+				"nodes": []map[string]any{{"urn": "urn:li:glossaryNode:Business", "properties": map[string]any{"name": "Business"}}},
+			},
+			"children": map[string]any{
+				"total": 25,
+				"relationships": []map[string]any{
+					{"entity": map[string]any{
+						"urn": "urn:li:glossaryTerm:Revenue", "type": "GLOSSARY_TERM", "properties": map[string]any{"name": "Revenue"},
+					}},
+				},
+			},
+		},
+	}, &vars)
+
+	listing, err := c.ListGlossary(context.Background(), "urn:li:glossaryNode:Finance", WithBrowseLimit(1), WithBrowseOffset(5))
+	if err != nil {
+		t.Fatalf("ListGlossary() unexpected error: %v", err)
+	}
+	if vars["start"] != float64(5) || vars["count"] != float64(1) {
+		t.Errorf("variables = %v", vars)
+	}
+	if listing.Parent != "urn:li:glossaryNode:Finance" || listing.Total != 25 || listing.Offset != 5 || len(listing.Entries) != 1 {
+		t.Errorf("unexpected listing: %+v", listing)
+	}
+	if len(listing.Path) != 2 || listing.Path[0].Name != "Business" || listing.Path[1].Name != "Finance" {
+		t.Errorf("Path = %+v, want root down to the node", listing.Path)
+	}
+}
+
+func TestClientListGlossaryNodeNotFound(t *testing.T) {
+	c := newGraphQLTestClient(t, map[string]any{"glossaryNode": nil}, nil)
+
+	_, err := c.ListGlossary(context.Background(), "urn:li:glossaryNode:missing")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("ListGlossary() error = %v, want ErrNotFound", err)
+	}
+}
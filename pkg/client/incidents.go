@@ -0,0 +1,126 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+// defaultIncidentLimit is the number of incidents retrieved when no limit is given.
+const defaultIncidentLimit = 20
+
+// incidentResultsRaw mirrors EntityIncidentsResult in the GraphQL schema.
+type incidentResultsRaw struct {
+	Total     int `json:"total"`
+	Incidents []struct {
+		URN         string `json:"urn"`
+		Type        string `json:"incidentType"`
+		CustomType  string `json:"customType"`
+		Title       string `json:"title"`
+		Description string `json:"description"`
+		Priority    string `json:"priority"`
+		Status      struct {
+			State       string         `json:"state"`
+			Stage       string         `json:"stage"`
+			Message     string         `json:"message"`
+			LastUpdated *auditStampGQL `json:"lastUpdated"`
+		} `json:"status"`
+		Source *struct {
+			Type string `json:"type"`
+		} `json:"source"`
+		Entity *struct {
+			URN string `json:"urn"`
+		} `json:"entity"`
+		Assignees []struct {
+			URN string `json:"urn"`
+		} `json:"assignees"`
+		Created *auditStampGQL `json:"created"`
+	} `json:"incidents"`
+}
+
+// ListIncidents retrieves the incidents raised on an entity, newest first.
+// Incidents are supported on datasets, data jobs, data flows, dashboards and charts.
+func (c *Client) ListIncidents(ctx context.Context, urn string, opts ...IncidentOption) (*types.IncidentList, error) {
+	options := &incidentOptions{limit: defaultIncidentLimit}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.limit <= 0 {
+		options.limit = defaultIncidentLimit
+	}
+
+	variables := map[string]any{
+		"urn":   urn,
+		"start": 0,
+		"count": options.limit,
+	}
+	if options.state != "" {
+		variables["state"] = options.state
+	}
+
+	var response struct {
+		Entity struct {
+			URN       string              `json:"urn"`
+			Incidents *incidentResultsRaw `json:"incidents"`
+		} `json:"entity"`
+	}
+
+	if err := c.Execute(ctx, GetIncidentsQuery, variables, &response); err != nil {
+		return nil, fmt.Errorf("ListIncidents(%s): %w", urn, err)
+	}
+	if response.Entity.URN == "" {
+		return nil, fmt.Errorf("ListIncidents(%s): %w", urn, ErrNotFound)
+	}
+
+	result := &types.IncidentList{
+		URN:       response.Entity.URN,
+		Incidents: []types.Incident{},
+	}
+	raw := response.Entity.Incidents
+	if raw == nil {
+		return result, nil
+	}
+
+	result.Total = raw.Total
+	for _, i := range raw.Incidents {
+		incident := types.Incident{
+			URN:         i.URN,
+			Type:        i.Type,
+			CustomType:  i.CustomType,
+			Title:       i.Title,
+			Description: i.Description,
+			Priority:    i.Priority,
+			State:       i.Status.State,
+			Stage:       i.Status.Stage,
+			Message:     i.Status.Message,
+		}
+		if i.Status.LastUpdated != nil {
+			incident.LastUpdated = i.Status.LastUpdated.Time
+			incident.LastUpdatedBy = i.Status.LastUpdated.Actor
+		}
+		if i.Source != nil {
+			incident.Source = i.Source.Type
+		}
+		if i.Entity != nil {
+			incident.EntityURN = i.Entity.URN
+		}
+		for _, a := range i.Assignees {
+			if a.URN != "" {
+				incident.Assignees = append(incident.Assignees, a.URN)
+			}
+		}
+		if i.Created != nil {
+			incident.Created = i.Created.Time
+			incident.CreatedBy = i.Created.Actor
+		}
+		result.Incidents = append(result.Incidents, incident)
+	}
+
+	sort.SliceStable(result.Incidents, func(a, b int) bool {
+		return result.Incidents[a].Created > result.Incidents[b].Created
+	})
+
+	return result, nil
+}
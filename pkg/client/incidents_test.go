@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+func TestClientListIncidents(t *testing.T) {
+	var vars map[string]any
+	c := newGraphQLTestClient(t, map[string]any{
+		"entity": map[string]any{
+			"urn": "urn:li:dataset:orders",
+			"incidents": map[string]any{
+				"total": 2,
+				"incidents": []map[string]any{
+					{
+						"urn":          "urn:li:incident:old",
+						"incidentType": "FRESHNESS",
+						"title":        "Stale data",
+						"status": map[string]any{
+							"state":       "RESOLVED",
+							"message":     "Backfilled",
+							"lastUpdated": map[string]any{"time": 300, "actor": "urn:li:corpuser:bob"},
+						},
+						"created": map[string]any{"time": 100, "actor": "urn:li:corpuser:ann"},
+					},
+					{
+						"urn":          "urn:li:incident:new",
+						"incidentType": "CUSTOM",
+						"customType":   "Duplicate rows",
+						"priority":     "HIGH",
+						"status":       map[string]any{"state": "ACTIVE", "stage": "TRIAGE"},
+						"source":       map[string]any{"type": "MANUAL"},
+						"entity":       map[string]any{"urn": "urn:li:dataset:orders"},
+						"assignees": []map[string]any{
+							{"urn": "urn:li:corpuser:ann"},
+							{"urn": "urn:li:corpGroup:data-eng"},
+						},
+						"created": map[string]any{"time": 200, "actor": "urn:li:corpuser:cid"},
+					},
+				},
+			},
+		},
+	}, &vars)
+
+	list, err := c.ListIncidents(context.Background(), "urn:li:dataset:orders",
+		WithIncidentState("active"), WithIncidentLimit(5))
+	if err != nil {
+		t.Fatalf("ListIncidents() unexpected error: %v", err)
+	}
+	if vars["state"] != types.IncidentStateActive || vars["count"] != float64(5) {
+		t.Errorf("variables = %v, want state ACTIVE and count 5", vars)
+	}
+	if list.Total != 2 || len(list.Incidents) != 2 {
+		t.Fatalf("expected 2 incidents, got %+v", list)
+	}
+
+	newest := list.Incidents[0]
+	if newest.URN != "urn:li:incident:new" {
+		t.Errorf("Incidents[0] = %s, want newest first", newest.URN)
+	}
+	if newest.CustomType != "Duplicate rows" || newest.Priority != "HIGH" || newest.Stage != "TRIAGE" ||
+		newest.Source != "MANUAL" || newest.EntityURN != "urn:li:dataset:orders" {
+		t.Errorf("incident not parsed: %+v", newest)
+	}
+	if len(newest.Assignees) != 2 || newest.Assignees[1] != "urn:li:corpGroup:data-eng" {
+		t.Errorf("Assignees = %v", newest.Assignees)
+	}
+
+	resolved := list.Incidents[1]
+	if resolved.State != types.IncidentStateResolved || resolved.Message != "Backfilled" ||
+		resolved.LastUpdated != 300 || resolved.LastUpdatedBy != "urn:li:corpuser:bob" || resolved.CreatedBy != "urn:li:corpuser:ann" {
+		t.Errorf("resolved incident not parsed: %+v", resolved)
+	}
+}
+
+func TestClientListIncidentsDefaults(t *testing.T) {
+	var vars map[string]any
+	c := newGraphQLTestClient(t, map[string]any{
+		"entity": map[string]any{"urn": "urn:li:glossaryTerm:revenue"},
+	}, &vars)
+
+	list, err := c.ListIncidents(context.Background(), "urn:li:glossaryTerm:revenue")
+	if err != nil {
+		t.Fatalf("ListIncidents() unexpected error: %v", err)
+	}
+	if _, ok := vars["state"]; ok {
+		t.Error("state should not be sent when no filter is set")
+	}
+	if vars["count"] != float64(defaultIncidentLimit) {
+		t.Errorf("count = %v, want default %d", vars["count"], defaultIncidentLimit)
+	}
+	if list.Incidents == nil || len(list.Incidents) != 0 {
+		t.Errorf("expected empty non-nil incidents, got %v", list.Incidents)
+	}
+}
+
+func TestClientListIncidentsNotFound(t *testing.T) {
+	c := newGraphQLTestClient(t, map[string]any{"entity": nil}, nil)
+
+	_, err := c.ListIncidents(context.Background(), "urn:li:dataset:missing")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("ListIncidents() error = %v, want ErrNotFound", err)
+	}
+}
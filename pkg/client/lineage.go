@@ -0,0 +1,224 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+// lineageHopRaw mirrors one entity in the GetLineageHopQuery response.
+type lineageHopRaw struct {
+	URN     string `json:"urn"`
+	Lineage *struct {
+		Relationships []lineageRelationshipRaw `json:"relationships"`
+	} `json:"lineage"`
+}
+
+// lineageRelationshipRaw mirrors a LineageRelationship.
+type lineageRelationshipRaw struct {
+	Type         string `json:"type"`
+	CreatedOn    int64  `json:"createdOn"`
+	CreatedActor *struct {
+		URN string `json:"urn"`
+	} `json:"createdActor"`
+	UpdatedOn    int64 `json:"updatedOn"`
+	UpdatedActor *struct {
+		URN string `json:"urn"`
+	} `json:"updatedActor"`
+	IsManual *bool `json:"isManual"`
+	Entity   *struct {
+		URN  string `json:"urn"`
+		Type string `json:"type"`
+	} `json:"entity"`
+}
+
+// toEdge converts the relationship into an edge from, or (for upstream) to, urn.
+func (r *lineageRelationshipRaw) toEdge(urn, direction string) types.LineageEdge {
+	edge := types.LineageEdge{
+		Source:  urn,
+		Target:  r.Entity.URN,
+		Type:    r.Type,
+		Created: r.CreatedOn,
+		Updated: r.UpdatedOn,
+	}
+	if direction == LineageDirectionUpstream {
+		edge.Source, edge.Target = edge.Target, edge.Source
+	}
+	switch {
+	case r.UpdatedActor != nil && r.UpdatedActor.URN != "":
+		edge.UpdatedBy = r.UpdatedActor.URN
+	case r.CreatedActor != nil:
+		edge.UpdatedBy = r.CreatedActor.URN
+	}
+	if r.IsManual != nil {
+		edge.Origin = types.LineageEdgeIngested
+		if *r.IsManual {
+			edge.Origin = types.LineageEdgeManual
+		}
+	}
+	return edge
+}
+
+// reconstructLineageEdges rebuilds the edges between the nodes of a traversal one hop
+// at a time, starting from urn. It is used when searchAcrossLineage returns no paths,
+// which otherwise leaves nodes beyond the first hop disconnected. Relationships to
+// entities outside nodes (for example ones removed by filters) are ignored.
+func (c *Client) reconstructLineageEdges(
+	ctx context.Context, urn, direction string, nodes []types.LineageNode, options *lineageOptions,
+) ([]types.LineageEdge, error) {
+	nodeTypes := make(map[string]string, len(nodes)+1)
+	for _, node := range nodes {
+		nodeTypes[node.URN] = node.Type
+	}
+	nodeTypes[urn] = ""
+
+	var edges []types.LineageEdge
+	edgeSet := make(map[string]bool)
+	visited := map[string]bool{urn: true}
+	frontier := []string{urn}
+
+	for hop := 1; hop <= options.depth && len(frontier) > 0; hop++ {
+		entities, err := c.fetchLineageHop(ctx, frontier, direction, options.limit,
+			options.startTimeMillis, options.endTimeMillis)
+		if err != nil {
+			return nil, fmt.Errorf("hop %d: %w", hop, err)
+		}
+
+		var next []string
+		for _, entity := range entities {
+			if entity == nil || entity.Lineage == nil {
+				continue
+			}
+			for _, rel := range entity.Lineage.Relationships {
+				if rel.Entity == nil {
+					continue
+				}
+				if _, ok := nodeTypes[rel.Entity.URN]; !ok {
+					continue
+				}
+				edge := rel.toEdge(entity.URN, direction)
+				key := edge.Source + "->" + edge.Target
+				if !edgeSet[key] {
+					edgeSet[key] = true
+					edges = append(edges, edge)
+				}
+				if !visited[rel.Entity.URN] {
+					visited[rel.Entity.URN] = true
+					next = append(next, rel.Entity.URN)
+				}
+			}
+		}
+		frontier = next
+	}
+
+	annotateViaJobs(edges, nodeTypes)
+	return edges, nil
+}
+
+// fetchLineageHop returns the direct lineage of urns in one direction, limited to
+// the window [start, end] when either bound is set.
+func (c *Client) fetchLineageHop(
+	ctx context.Context, urns []string, direction string, count int, start, end int64,
+) ([]*lineageHopRaw, error) {
+	variables := map[string]any{
+		"urns":      urns,
+		"direction": direction,
+		"count":     count,
+	}
+	if start > 0 {
+		variables["startTimeMillis"] = start
+	}
+	if end > 0 {
+		variables["endTimeMillis"] = end
+	}
+
+	var response struct {
+		Entities []*lineageHopRaw `json:"entities"`
+	}
+	if err := c.Execute(ctx, GetLineageHopQuery, variables, &response); err != nil {
+		return nil, err
+	}
+	return response.Entities, nil
+}
+
+// laterLineageEdges returns the keys ("source->target") of the edges DataHub reports
+// for the entities of edges in the window [since, until]. Each entity is queried in
+// one batch rather than traversed, so an edge behind a removed one is still found.
+func (c *Client) laterLineageEdges(
+	ctx context.Context, edges []types.LineageEdge, direction string, count int, since, until int64,
+) (map[string]bool, error) {
+	var urns []string
+	seen := make(map[string]bool)
+	for _, edge := range edges {
+		urn := edge.Source
+		if direction == LineageDirectionUpstream {
+			urn = edge.Target
+		}
+		if !seen[urn] {
+			seen[urn] = true
+			urns = append(urns, urn)
+		}
+	}
+
+	entities, err := c.fetchLineageHop(ctx, urns, direction, count, since, until)
+	if err != nil {
+		return nil, err
+	}
+
+	later := make(map[string]bool)
+	for _, entity := range entities {
+		if entity == nil || entity.Lineage == nil {
+			continue
+		}
+		for _, rel := range entity.Lineage.Relationships {
+			if rel.Entity == nil {
+				continue
+			}
+			edge := rel.toEdge(entity.URN, direction)
+			later[edge.Source+"->"+edge.Target] = true
+		}
+	}
+	return later, nil
+}
+
+// flagLineageChanges marks the edges created in the window [start, end] as added.
+// later holds the edges that still exist after the window; an ingested edge seen in
+// the window but missing from later was removed. A nil later, as for windows that
+// have not ended, flags no removals. Manual edges are never flagged as removed. A
+// zero bound leaves that side of the window open.
+func flagLineageChanges(edges []types.LineageEdge, start, end int64, later map[string]bool) {
+	for i := range edges {
+		edge := &edges[i]
+		switch {
+		case start > 0 && edge.Created >= start && (end == 0 || edge.Created <= end):
+			edge.Change = types.LineageEdgeAdded
+		case later != nil && edge.Origin != types.LineageEdgeManual && !later[edge.Source+"->"+edge.Target]:
+			edge.Change = types.LineageEdgeRemoved
+		}
+	}
+}
+
+// annotateViaJobs sets Via on each direct edge A->B that is also produced through a
+// data job, i.e. when edges A->J and J->B exist for a DATA_JOB node J.
+func annotateViaJobs(edges []types.LineageEdge, nodeTypes map[string]string) {
+	index := make(map[string]int, len(edges))
+	for i, edge := range edges {
+		index[edge.Source+"->"+edge.Target] = i
+	}
+
+	for _, in := range edges {
+		if nodeTypes[in.Target] != "DATA_JOB" {
+			continue
+		}
+		job := in.Target
+		for _, out := range edges {
+			if out.Source != job {
+				continue
+			}
+			if i, ok := index[in.Source+"->"+out.Target]; ok && edges[i].Via == "" {
+				edges[i].Via = job
+			}
+		}
+	}
+}
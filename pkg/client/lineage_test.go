@@ -0,0 +1,355 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+// lineageHopServer serves searchAcrossLineage results without paths and answers
+// getLineageHop queries from hops, keyed by entity URN.
+func lineageHopServer(t *testing.T, results []map[string]any, hops map[string][]map[string]any, hopStatus int) *Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Query     string         `json:"query"`
+			Variables map[string]any `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+
+		if !strings.Contains(req.Query, "getLineageHop") {
+			writeJSON(t, w, map[string]any{"data": map[string]any{
+				"searchAcrossLineage": map[string]any{"searchResults": results},
+			}})
+			return
+		}
+		if hopStatus != 0 {
+			w.WriteHeader(hopStatus)
+			return
+		}
+
+		urns, _ := req.Variables["urns"].([]any)
+		entities := make([]any, 0, len(urns))
+		for _, u := range urns {
+			urn, _ := u.(string)
+			entities = append(entities, map[string]any{
+				"urn":     urn,
+				"lineage": map[string]any{"relationships": hops[urn]},
+			})
+		}
+		writeJSON(t, w, map[string]any{"data": map[string]any{"entities": entities}})
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := New(Config{URL: server.URL, Token: "test-token", RetryMax: 0})
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+	return c
+}
+
+// hopRelationship builds a LineageRelationship for lineageHopServer.
+func hopRelationship(urn, entityType string, manual bool) map[string]any {
+	return map[string]any{
+		"type":         "DownstreamOf",
+		"createdOn":    1000,
+		"createdActor": map[string]any{"urn": "urn:li:corpuser:ingest"},
+		"updatedOn":    2000,
+		"isManual":     manual,
+		"entity":       map[string]any{"urn": urn, "type": entityType},
+	}
+}
+
+func TestClientGetLineageReconstructsEdges(t *testing.T) {
+	const start = "urn:li:dataset:start"
+	results := []map[string]any{
+		lineageResult("urn:li:dataJob:load", 1),
+		lineageResult("urn:li:dataset:a", 1),
+		lineageResult("urn:li:dataset:b", 2),
+		lineageResult("urn:li:dataset:c", 3),
+	}
+	results[0]["entity"].(map[string]any)["type"] = "DATA_JOB"
+	hops := map[string][]map[string]any{
+		start: {
+			hopRelationship("urn:li:dataJob:load", "DATA_JOB", false),
+			hopRelationship("urn:li:dataset:a", "DATASET", false),
+		},
+		"urn:li:dataJob:load": {hopRelationship("urn:li:dataset:a", "DATASET", false)},
+		"urn:li:dataset:a": {
+			hopRelationship("urn:li:dataset:b", "DATASET", true),
+			hopRelationship("urn:li:dataset:filtered", "DATASET", false),
+		},
+		"urn:li:dataset:b": {hopRelationship("urn:li:dataset:c", "DATASET", false)},
+	}
+
+	c := lineageHopServer(t, results, hops, 0)
+	result, err := c.GetLineage(context.Background(), start, WithDepth(3))
+	if err != nil {
+		t.Fatalf("GetLineage() unexpected error: %v", err)
+	}
+
+	edges := make(map[string]types.LineageEdge)
+	for _, edge := range result.Edges {
+		edges[edge.Source+"->"+edge.Target] = edge
+	}
+	for _, key := range []string{
+		start + "->urn:li:dataJob:load",
+		start + "->urn:li:dataset:a",
+		"urn:li:dataJob:load->urn:li:dataset:a",
+		"urn:li:dataset:a->urn:li:dataset:b",
+		"urn:li:dataset:b->urn:li:dataset:c",
+	} {
+		if _, ok := edges[key]; !ok {
+			t.Errorf("GetLineage() missing edge %s", key)
+		}
+	}
+	if len(result.Edges) != 5 {
+		t.Errorf("GetLineage() Edges count = %d, want 5 (filtered entity excluded)", len(result.Edges))
+	}
+
+	direct := edges[start+"->urn:li:dataset:a"]
+	if direct.Via != "urn:li:dataJob:load" {
+		t.Errorf("GetLineage() Via = %q, want the data job", direct.Via)
+	}
+	if direct.Type != "DownstreamOf" || direct.Created != 1000 || direct.Updated != 2000 {
+		t.Errorf("GetLineage() edge metadata = %+v", direct)
+	}
+	if direct.UpdatedBy != "urn:li:corpuser:ingest" || direct.Origin != types.LineageEdgeIngested {
+		t.Errorf("GetLineage() edge actor/origin = %s/%s", direct.UpdatedBy, direct.Origin)
+	}
+	if edges["urn:li:dataset:a->urn:li:dataset:b"].Origin != types.LineageEdgeManual {
+		t.Errorf("GetLineage() manual edge origin = %s", edges["urn:li:dataset:a->urn:li:dataset:b"].Origin)
+	}
+}
+
+func TestClientGetLineageReconstructsUpstreamEdges(t *testing.T) {
+	const start = "urn:li:dataset:start"
+	results := []map[string]any{
+		lineageResult("urn:li:dataset:raw", 1),
+		lineageResult("urn:li:dataset:source", 2),
+	}
+	hops := map[string][]map[string]any{
+		start:                {hopRelationship("urn:li:dataset:raw", "DATASET", false)},
+		"urn:li:dataset:raw": {hopRelationship("urn:li:dataset:source", "DATASET", false)},
+	}
+
+	c := lineageHopServer(t, results, hops, 0)
+	result, err := c.GetLineage(context.Background(), start, WithDirection(LineageDirectionUpstream), WithDepth(2))
+	if err != nil {
+		t.Fatalf("GetLineage() unexpected error: %v", err)
+	}
+	if len(result.Edges) != 2 {
+		t.Fatalf("GetLineage() Edges count = %d, want 2", len(result.Edges))
+	}
+	if result.Edges[0].Source != "urn:li:dataset:raw" || result.Edges[0].Target != start {
+		t.Errorf("GetLineage() first edge = %+v, want raw -> start", result.Edges[0])
+	}
+	if result.Edges[1].Source != "urn:li:dataset:source" || result.Edges[1].Target != "urn:li:dataset:raw" {
+		t.Errorf("GetLineage() second edge = %+v, want source -> raw", result.Edges[1])
+	}
+}
+
+func TestClientGetLineageReconstructionFallback(t *testing.T) {
+	const start = "urn:li:dataset:start"
+	results := []map[string]any{
+		lineageResult("urn:li:dataset:a", 1),
+		lineageResult("urn:li:dataset:b", 2),
+	}
+
+	c := lineageHopServer(t, results, nil, http.StatusInternalServerError)
+	result, err := c.GetLineage(context.Background(), start, WithDepth(2))
+	if err != nil {
+		t.Fatalf("GetLineage() unexpected error: %v", err)
+	}
+	if len(result.Edges) != 1 || result.Edges[0].Source != start || result.Edges[0].Target != "urn:li:dataset:a" {
+		t.Errorf("GetLineage() Edges = %+v, want the inferred start -> a edge", result.Edges)
+	}
+}
+
+func TestClientGetLineageTimeWindow(t *testing.T) {
+	const start = "urn:li:dataset:start"
+	var searchVars, hopVars map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Query     string         `json:"query"`
+			Variables map[string]any `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+		if !strings.Contains(req.Query, "getLineageHop") {
+			searchVars = req.Variables
+			writeJSON(t, w, map[string]any{"data": map[string]any{
+				"searchAcrossLineage": map[string]any{"searchResults": []map[string]any{
+					lineageResult("urn:li:dataset:a", 1, start, "urn:li:dataset:a"),
+				}},
+			}})
+			return
+		}
+		if hopVars == nil {
+			hopVars = req.Variables
+		}
+		writeJSON(t, w, map[string]any{"data": map[string]any{"entities": []any{map[string]any{
+			"urn": start,
+			"lineage": map[string]any{"relationships": []map[string]any{
+				hopRelationship("urn:li:dataset:a", "DATASET", false),
+			}},
+		}}}})
+	}))
+	t.Cleanup(server.Close)
+	c, err := New(Config{URL: server.URL, Token: "test-token", RetryMax: 0})
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+
+	result, err := c.GetLineage(context.Background(), start, WithLineageTimeRange(time.UnixMilli(500), time.UnixMilli(1500)))
+	if err != nil {
+		t.Fatalf("GetLineage() unexpected error: %v", err)
+	}
+	for name, vars := range map[string]map[string]any{"search": searchVars, "hop": hopVars} {
+		if vars["startTimeMillis"] != float64(500) || vars["endTimeMillis"] != float64(1500) {
+			t.Errorf("GetLineage() %s time window = %v-%v", name, vars["startTimeMillis"], vars["endTimeMillis"])
+		}
+	}
+	if len(result.Edges) != 1 || result.Edges[0].Created != 1000 {
+		t.Fatalf("GetLineage() Edges = %+v, want the rebuilt edge with timestamps", result.Edges)
+	}
+	if result.Edges[0].Change != types.LineageEdgeAdded {
+		t.Errorf("GetLineage() Change = %q, want ADDED", result.Edges[0].Change)
+	}
+
+	result, err = c.GetLineage(context.Background(), start, WithLineageAsOf(time.UnixMilli(1200)))
+	if err != nil {
+		t.Fatalf("GetLineage() unexpected error: %v", err)
+	}
+	if searchVars["startTimeMillis"] != float64(1200) || searchVars["endTimeMillis"] != float64(1200) {
+		t.Errorf("GetLineage() as-of window = %v-%v", searchVars["startTimeMillis"], searchVars["endTimeMillis"])
+	}
+	if result.AsOf != 1200 || result.Edges[0].Change != "" {
+		t.Errorf("GetLineage() AsOf/Change = %d/%q, want 1200 and no flag", result.AsOf, result.Edges[0].Change)
+	}
+}
+
+func TestClientGetLineageTimeWindowRemovals(t *testing.T) {
+	const start = "urn:li:dataset:start"
+	var laterVars map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Query     string         `json:"query"`
+			Variables map[string]any `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+		if !strings.Contains(req.Query, "getLineageHop") {
+			writeJSON(t, w, map[string]any{"data": map[string]any{
+				"searchAcrossLineage": map[string]any{"searchResults": []map[string]any{
+					lineageResult("urn:li:dataset:a", 1),
+					lineageResult("urn:li:dataset:b", 2),
+				}},
+			}})
+			return
+		}
+
+		// Within the window start feeds a and a feeds b; afterwards only a -> b remains.
+		hops := map[string][]map[string]any{
+			start:              {hopRelationship("urn:li:dataset:a", "DATASET", false)},
+			"urn:li:dataset:a": {hopRelationship("urn:li:dataset:b", "DATASET", false)},
+		}
+		if req.Variables["startTimeMillis"] == float64(1500) {
+			laterVars = req.Variables
+			delete(hops, start)
+		}
+		urns, _ := req.Variables["urns"].([]any)
+		entities := make([]any, 0, len(urns))
+		for _, u := range urns {
+			urn, _ := u.(string)
+			entities = append(entities, map[string]any{"urn": urn, "lineage": map[string]any{"relationships": hops[urn]}})
+		}
+		writeJSON(t, w, map[string]any{"data": map[string]any{"entities": entities}})
+	}))
+	t.Cleanup(server.Close)
+	c, err := New(Config{URL: server.URL, Token: "test-token", RetryMax: 0})
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+
+	result, err := c.GetLineage(context.Background(), start, WithDepth(2),
+		WithLineageTimeRange(time.UnixMilli(1100), time.UnixMilli(1500)))
+	if err != nil {
+		t.Fatalf("GetLineage() unexpected error: %v", err)
+	}
+	if laterVars == nil {
+		t.Fatal("GetLineage() did not query the edges after the window")
+	}
+	if urns, _ := laterVars["urns"].([]any); len(urns) != 2 {
+		t.Errorf("GetLineage() later query urns = %v, want both edge sources in one batch", laterVars["urns"])
+	}
+	changes := make(map[string]string)
+	for _, edge := range result.Edges {
+		changes[edge.Source+"->"+edge.Target] = edge.Change
+	}
+	want := map[string]string{start + "->urn:li:dataset:a": types.LineageEdgeRemoved, "urn:li:dataset:a->urn:li:dataset:b": ""}
+	for key, change := range want {
+		if got, ok := changes[key]; !ok || got != change {
+			t.Errorf("GetLineage() %s change = %q (present %v), want %q", key, got, ok, change)
+		}
+	}
+}
+
+func TestFlagLineageChanges(t *testing.T) {
+	seen := map[string]bool{"a->b": true}
+	tests := []struct {
+		name       string
+		edge       types.LineageEdge
+		start, end int64
+		later      map[string]bool
+		want       string
+	}{
+		{"created in window", types.LineageEdge{Created: 150}, 100, 200, seen, types.LineageEdgeAdded},
+		{"created in open window", types.LineageEdge{Created: 150}, 100, 0, nil, types.LineageEdgeAdded},
+		{"created before window", types.LineageEdge{Created: 50}, 100, 200, seen, ""},
+		{"no start bound", types.LineageEdge{Created: 50}, 0, 200, seen, ""},
+		{"gone after window", types.LineageEdge{Source: "a", Target: "c", Created: 50}, 100, 200, seen, types.LineageEdgeRemoved},
+		{"manual edge", types.LineageEdge{Source: "a", Target: "c", Origin: types.LineageEdgeManual}, 100, 200, seen, ""},
+		{"window not over", types.LineageEdge{Source: "a", Target: "c", Created: 50}, 100, 2000, nil, ""},
+		{"added and removed", types.LineageEdge{Source: "a", Target: "c", Created: 120}, 100, 200, seen, types.LineageEdgeAdded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			edges := []types.LineageEdge{tt.edge}
+			if tt.edge.Source == "" {
+				edges[0].Source, edges[0].Target = "a", "b"
+			}
+			flagLineageChanges(edges, tt.start, tt.end, tt.later)
+			if edges[0].Change != tt.want {
+				t.Errorf("flagLineageChanges() = %q, want %q", edges[0].Change, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnnotateViaJobs(t *testing.T) {
+	edges := []types.LineageEdge{
+		{Source: "a", Target: "job"},
+		{Source: "job", Target: "b"},
+		{Source: "a", Target: "b"},
+		{Source: "a", Target: "c"},
+	}
+	annotateViaJobs(edges, map[string]string{"job": "DATA_JOB", "a": "DATASET", "b": "DATASET"})
+
+	if edges[2].Via != "job" {
+		t.Errorf("annotateViaJobs() a->b Via = %q, want job", edges[2].Via)
+	}
+	if edges[3].Via != "" {
+		t.Errorf("annotateViaJobs() a->c Via = %q, want empty", edges[3].Via)
+	}
+}
@@ -0,0 +1,107 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// Authentication modes for Config.AuthMode.
+const (
+	// AuthModeToken authenticates with a personal access token (Token or TokenProvider).
+	AuthModeToken = "token"
+
+	// AuthModeOAuth2 authenticates with tokens obtained via the OAuth2 client-credentials grant.
+	AuthModeOAuth2 = "oauth2"
+)
+
+// OAuth2Config configures the OAuth2 client-credentials grant.
+type OAuth2Config struct {
+	// ClientID is the OAuth2 client ID (required).
+	ClientID string
+
+	// ClientSecret is the OAuth2 client secret (required).
+	ClientSecret string
+
+	// TokenURL is the identity provider's token endpoint (required).
+	TokenURL string
+
+	// Scopes are the requested scopes (optional).
+	Scopes []string
+
+	// EndpointParams are extra parameters sent to the token endpoint, such as "audience".
+	EndpointParams map[string][]string
+}
+
+// Validate checks if the OAuth2 configuration is complete.
+func (c OAuth2Config) Validate() error {
+	if c.ClientID == "" {
+		return fmt.Errorf("DATAHUB_OAUTH2_CLIENT_ID is required for oauth2 auth mode")
+	}
+	if c.ClientSecret == "" {
+		return fmt.Errorf("DATAHUB_OAUTH2_CLIENT_SECRET is required for oauth2 auth mode")
+	}
+	if c.TokenURL == "" {
+		return fmt.Errorf("DATAHUB_OAUTH2_TOKEN_URL is required for oauth2 auth mode")
+	}
+	return nil
+}
+
+// OAuth2TokenProvider obtains access tokens with the OAuth2 client-credentials grant.
+// Tokens are cached and fetched again shortly before they expire. Each fetch runs
+// under the caller's context, so cancelling it aborts the token request.
+type OAuth2TokenProvider struct {
+	config     clientcredentials.Config
+	httpClient *http.Client
+
+	mu    sync.Mutex
+	token *oauth2.Token
+}
+
+// NewOAuth2TokenProvider creates an OAuth2TokenProvider.
+// httpClient is used to call the token endpoint; if nil, http.DefaultClient is used.
+func NewOAuth2TokenProvider(cfg OAuth2Config, httpClient *http.Client) *OAuth2TokenProvider {
+	return &OAuth2TokenProvider{
+		config: clientcredentials.Config{
+			ClientID:       cfg.ClientID,
+			ClientSecret:   cfg.ClientSecret,
+			TokenURL:       cfg.TokenURL,
+			Scopes:         cfg.Scopes,
+			EndpointParams: cfg.EndpointParams,
+		},
+		httpClient: httpClient,
+	}
+}
+
+// Token implements TokenProvider.
+// The cached token is returned until it is about to expire; concurrent callers
+// wait for a single fetch rather than each requesting a token.
+func (p *OAuth2TokenProvider) Token(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.token.Valid() {
+		return p.token.AccessToken, nil
+	}
+	if p.httpClient != nil {
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, p.httpClient)
+	}
+	tok, err := p.config.Token(ctx)
+	if err != nil {
+		return "", fmt.Errorf("oauth2 token request: %w", err)
+	}
+	p.token = tok
+	return tok.AccessToken, nil
+}
+
+// Refresh implements TokenProvider by discarding the cached token and requesting a new one.
+func (p *OAuth2TokenProvider) Refresh(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	p.token = nil
+	p.mu.Unlock()
+	return p.Token(ctx)
+}
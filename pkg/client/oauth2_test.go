@@ -0,0 +1,286 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeTokenEndpoint is a minimal OAuth2 token endpoint for the client-credentials grant.
+// Each issued token is numbered: "oauth-1", "oauth-2", ...
+type fakeTokenEndpoint struct {
+	server    *httptest.Server
+	issued    atomic.Int32
+	expiresIn int
+	lastScope atomic.Value
+}
+
+func newFakeTokenEndpoint(t *testing.T, expiresIn int) *fakeTokenEndpoint {
+	t.Helper()
+	f := &fakeTokenEndpoint{expiresIn: expiresIn}
+	f.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		id, secret, ok := r.BasicAuth()
+		if !ok {
+			id, secret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+		}
+		if r.PostForm.Get("grant_type") != "client_credentials" || id != "client" || secret != "secret" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error": "invalid_client"}`))
+			return
+		}
+		f.lastScope.Store(r.PostForm.Get("scope"))
+
+		n := f.issued.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": fmt.Sprintf("oauth-%d", n),
+			"token_type":   "Bearer",
+			"expires_in":   f.expiresIn,
+		})
+	}))
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+func (f *fakeTokenEndpoint) config() OAuth2Config {
+	return OAuth2Config{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		TokenURL:     f.server.URL,
+		Scopes:       []string{"datahub.read", "datahub.write"},
+	}
+}
+
+func TestOAuth2TokenProvider_CachesToken(t *testing.T) {
+	endpoint := newFakeTokenEndpoint(t, 3600)
+	p := NewOAuth2TokenProvider(endpoint.config(), nil)
+
+	for range 3 {
+		token, err := p.Token(context.Background())
+		if err != nil || token != "oauth-1" {
+			t.Fatalf("Token() = %q, %v; want %q, nil", token, err, "oauth-1")
+		}
+	}
+	if got := endpoint.issued.Load(); got != 1 {
+		t.Errorf("expected 1 token request, got %d", got)
+	}
+	if scope, _ := endpoint.lastScope.Load().(string); scope != "datahub.read datahub.write" {
+		t.Errorf("scope = %q, want %q", scope, "datahub.read datahub.write")
+	}
+}
+
+func TestOAuth2TokenProvider_RenewsExpiredToken(t *testing.T) {
+	// Tokens expiring within the oauth2 package's expiry delta are treated as expired
+	endpoint := newFakeTokenEndpoint(t, 1)
+	p := NewOAuth2TokenProvider(endpoint.config(), nil)
+
+	first, err := p.Token(context.Background())
+	if err != nil {
+		t.Fatalf("Token() unexpected error: %v", err)
+	}
+	second, err := p.Token(context.Background())
+	if err != nil {
+		t.Fatalf("Token() unexpected error: %v", err)
+	}
+	if first == second {
+		t.Errorf("expected a new token after expiry, got %q twice", first)
+	}
+}
+
+func TestOAuth2TokenProvider_Refresh(t *testing.T) {
+	endpoint := newFakeTokenEndpoint(t, 3600)
+	p := NewOAuth2TokenProvider(endpoint.config(), nil)
+
+	if _, err := p.Token(context.Background()); err != nil {
+		t.Fatalf("Token() unexpected error: %v", err)
+	}
+	token, err := p.Refresh(context.Background())
+	if err != nil || token != "oauth-2" {
+		t.Errorf("Refresh() = %q, %v; want %q, nil", token, err, "oauth-2")
+	}
+	token, _ = p.Token(context.Background())
+	if token != "oauth-2" {
+		t.Errorf("Token() after refresh = %q, want %q", token, "oauth-2")
+	}
+}
+
+func TestOAuth2TokenProvider_CancelledContext(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(release) })
+
+	p := NewOAuth2TokenProvider(OAuth2Config{ClientID: "client", ClientSecret: "secret", TokenURL: server.URL}, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := p.Token(ctx)
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Token() error = %v, want context.DeadlineExceeded", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Token() did not return after its context was cancelled")
+	}
+}
+
+func TestOAuth2TokenProvider_InvalidClient(t *testing.T) {
+	endpoint := newFakeTokenEndpoint(t, 3600)
+	cfg := endpoint.config()
+	cfg.ClientSecret = "wrong"
+
+	_, err := NewOAuth2TokenProvider(cfg, nil).Token(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "oauth2 token request") {
+		t.Errorf("Token() error = %v, want oauth2 token request failure", err)
+	}
+}
+
+func TestClient_OAuth2(t *testing.T) {
+	endpoint := newFakeTokenEndpoint(t, 3600)
+
+	// DataHub accepts only the second token, forcing a refresh after the first 401
+	var requests atomic.Int32
+	datahub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.Header.Get("Authorization") != "Bearer oauth-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {"__typename": "Query"}}`))
+	}))
+	t.Cleanup(datahub.Close)
+
+	c, err := New(Config{URL: datahub.URL, AuthMode: AuthModeOAuth2, OAuth2: endpoint.config()})
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+	if err := c.Ping(context.Background()); err != nil {
+		t.Fatalf("Ping() unexpected error: %v", err)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("expected 2 DataHub requests (401 then retry), got %d", got)
+	}
+	if got := endpoint.issued.Load(); got != 2 {
+		t.Errorf("expected 2 token requests, got %d", got)
+	}
+}
+
+func TestConfig_ValidateAuthMode(t *testing.T) {
+	oauth := OAuth2Config{ClientID: "id", ClientSecret: "secret", TokenURL: "https://sso.example.com/token"}
+
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr string
+	}{
+		{"oauth2 complete", Config{URL: "https://d", AuthMode: AuthModeOAuth2, OAuth2: oauth}, ""},
+		{"oauth2 missing client id", Config{URL: "https://d", AuthMode: AuthModeOAuth2,
+			OAuth2: OAuth2Config{ClientSecret: "s", TokenURL: "https://t"}}, "DATAHUB_OAUTH2_CLIENT_ID"},
+		{"oauth2 missing secret", Config{URL: "https://d", AuthMode: AuthModeOAuth2,
+			OAuth2: OAuth2Config{ClientID: "id", TokenURL: "https://t"}}, "DATAHUB_OAUTH2_CLIENT_SECRET"},
+		{"oauth2 missing token url", Config{URL: "https://d", AuthMode: AuthModeOAuth2,
+			OAuth2: OAuth2Config{ClientID: "id", ClientSecret: "s"}}, "DATAHUB_OAUTH2_TOKEN_URL"},
+		{"explicit token mode", Config{URL: "https://d", AuthMode: AuthModeToken, Token: "t"}, ""},
+		{"token mode without token", Config{URL: "https://d", AuthMode: AuthModeToken}, "DATAHUB_TOKEN"},
+		{"unknown mode", Config{URL: "https://d", AuthMode: "saml", Token: "t"}, "DATAHUB_AUTH_MODE"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want mention of %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFromEnv_OAuth2(t *testing.T) {
+	t.Setenv("DATAHUB_TOKEN", "")
+	t.Setenv("DATAHUB_TOKEN_FILE", "")
+	t.Setenv("DATAHUB_TOKEN_COMMAND", "")
+	t.Setenv("DATAHUB_AUTH_MODE", "OAuth2")
+	t.Setenv("DATAHUB_OAUTH2_CLIENT_ID", "id")
+	t.Setenv("DATAHUB_OAUTH2_CLIENT_SECRET", "secret")
+	t.Setenv("DATAHUB_OAUTH2_TOKEN_URL", "https://sso.example.com/token")
+	t.Setenv("DATAHUB_OAUTH2_SCOPES", "read, write openid")
+	t.Setenv("DATAHUB_OAUTH2_AUDIENCE", "datahub")
+
+	cfg, err := FromEnv()
+	if err != nil {
+		t.Fatalf("FromEnv() unexpected error: %v", err)
+	}
+	if cfg.AuthMode != AuthModeOAuth2 {
+		t.Errorf("AuthMode = %q, want %q", cfg.AuthMode, AuthModeOAuth2)
+	}
+	if cfg.OAuth2.ClientID != "id" || cfg.OAuth2.ClientSecret != "secret" ||
+		cfg.OAuth2.TokenURL != "https://sso.example.com/token" {
+		t.Errorf("unexpected OAuth2 config: %+v", cfg.OAuth2)
+	}
+	if got := strings.Join(cfg.OAuth2.Scopes, "|"); got != "read|write|openid" {
+		t.Errorf("Scopes = %v, want [read write openid]", cfg.OAuth2.Scopes)
+	}
+	if got := cfg.OAuth2.EndpointParams["audience"]; len(got) != 1 || got[0] != "datahub" {
+		t.Errorf("audience = %v, want [datahub]", got)
+	}
+
+	cfg.URL = "https://datahub.example.com"
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("Validate() should accept oauth2 mode without a token: %v", err)
+	}
+}
+
+func TestOAuth2TokenProvider_UsesHTTPClient(t *testing.T) {
+	endpoint := newFakeTokenEndpoint(t, 3600)
+
+	var used atomic.Bool
+	httpClient := &http.Client{
+		Timeout: time.Second,
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			used.Store(true)
+			return http.DefaultTransport.RoundTrip(r)
+		}),
+	}
+
+	if _, err := NewOAuth2TokenProvider(endpoint.config(), httpClient).Token(context.Background()); err != nil {
+		t.Fatalf("Token() unexpected error: %v", err)
+	}
+	if !used.Load() {
+		t.Error("expected token request to use the supplied HTTP client")
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"strings"
+	"time"
 	"unicode"
 )
 
@@ -58,22 +59,40 @@ func WithOffset(offset int) SearchOption {
 	}
 }
 
-// WithFilters adds search filters.
+// WithFilters adds search filters. Each key is a search field (e.g. "platform")
+// matched against any of its values; all keys must match.
 func WithFilters(filters map[string][]string) SearchOption {
 	return func(o *searchOptions) {
-		o.filters = filters
+		if o.filters == nil {
+			o.filters = make(map[string][]string, len(filters))
+		}
+		for field, values := range filters {
+			o.filters[field] = values
+		}
 	}
 }
 
+// WithContainer limits search to the direct children of a container.
+func WithContainer(containerURN string) SearchOption {
+	return WithFilters(map[string][]string{"container": {containerURN}})
+}
+
 // LineageOption configures lineage queries.
 type LineageOption func(*lineageOptions)
 
 type lineageOptions struct {
-	direction string
-	depth     int
+	direction       string
+	depth           int
+	limit           int
+	offset          int
+	entityTypes     []string
+	platforms       []string
+	startTimeMillis int64
+	endTimeMillis   int64
+	asOfMillis      int64
 }
 
-// WithDirection sets the lineage direction (UPSTREAM or DOWNSTREAM).
+// WithDirection sets the lineage direction (UPSTREAM, DOWNSTREAM or BOTH).
 // The direction is normalized to uppercase.
 func WithDirection(dir string) LineageOption {
 	return func(o *lineageOptions) {
@@ -88,8 +107,199 @@ func WithDepth(depth int) LineageOption {
 	}
 }
 
+// WithLineageLimit sets the maximum number of lineage results fetched per direction.
+// The result reports Truncated when more are available.
+func WithLineageLimit(limit int) LineageOption {
+	return func(o *lineageOptions) {
+		o.limit = limit
+	}
+}
+
+// WithLineageOffset sets the lineage result offset for pagination.
+func WithLineageOffset(offset int) LineageOption {
+	return func(o *lineageOptions) {
+		o.offset = offset
+	}
+}
+
+// WithLineageEntityTypes limits lineage results to the given entity types
+// (e.g. DATASET, DASHBOARD). Types are normalized like WithEntityType.
+func WithLineageEntityTypes(entityTypes ...string) LineageOption {
+	return func(o *lineageOptions) {
+		for _, t := range entityTypes {
+			o.entityTypes = append(o.entityTypes, toEnumCase(t))
+		}
+	}
+}
+
+// WithLineagePlatforms limits lineage results to entities on the given platforms.
+// Platforms may be names (snowflake) or platform URNs (urn:li:dataPlatform:snowflake).
+func WithLineagePlatforms(platforms ...string) LineageOption {
+	return func(o *lineageOptions) {
+		for _, p := range platforms {
+			o.platforms = append(o.platforms, BuildPlatformURN(p))
+		}
+	}
+}
+
+// WithLineageTimeRange limits lineage to edges observed within the time window.
+// Either bound may be zero to leave that side open.
+func WithLineageTimeRange(start, end time.Time) LineageOption {
+	return func(o *lineageOptions) {
+		if !start.IsZero() {
+			o.startTimeMillis = start.UnixMilli()
+		}
+		if !end.IsZero() {
+			o.endTimeMillis = end.UnixMilli()
+		}
+	}
+}
+
+// WithLineageAsOf returns lineage as it stood at t: only edges that existed at that
+// moment are followed. It replaces any WithLineageTimeRange window.
+func WithLineageAsOf(t time.Time) LineageOption {
+	return func(o *lineageOptions) {
+		if !t.IsZero() {
+			o.asOfMillis = t.UnixMilli()
+		}
+	}
+}
+
 // Constants for lineage directions.
 const (
 	LineageDirectionUpstream   = "UPSTREAM"
 	LineageDirectionDownstream = "DOWNSTREAM"
+	// LineageDirectionBoth traverses upstream and downstream; upstream levels are negative.
+	LineageDirectionBoth = "BOTH"
+)
+
+// ProfileOption configures dataset profile queries.
+type ProfileOption func(*profileOptions)
+
+type profileOptions struct {
+	start time.Time
+	end   time.Time
+	limit int
+}
+
+// WithProfileTimeRange restricts profiles to those taken between start and end.
+// A zero start or end leaves that side of the range open.
+func WithProfileTimeRange(start, end time.Time) ProfileOption {
+	return func(o *profileOptions) {
+		o.start = start
+		o.end = end
+	}
+}
+
+// WithProfileLimit sets the maximum number of profiles to retrieve, including the latest.
+func WithProfileLimit(limit int) ProfileOption {
+	return func(o *profileOptions) {
+		o.limit = limit
+	}
+}
+
+// UsageOption configures usage statistics queries.
+type UsageOption func(*usageOptions)
+
+type usageOptions struct {
+	timeRange string
+	topUsers  int
+}
+
+// WithUsageRange sets the usage window: DAY, WEEK, MONTH, QUARTER, YEAR or ALL.
+// The range is normalized to uppercase.
+func WithUsageRange(timeRange string) UsageOption {
+	return func(o *usageOptions) {
+		o.timeRange = strings.ToUpper(timeRange)
+	}
+}
+
+// WithTopUsers limits the number of users returned, ordered by query count.
+func WithTopUsers(n int) UsageOption {
+	return func(o *usageOptions) {
+		o.topUsers = n
+	}
+}
+
+// Constants for usage time ranges.
+const (
+	UsageRangeDay     = "DAY"
+	UsageRangeWeek    = "WEEK"
+	UsageRangeMonth   = "MONTH"
+	UsageRangeQuarter = "QUARTER"
+	UsageRangeYear    = "YEAR"
+	UsageRangeAll     = "ALL"
 )
+
+// AssertionOption configures assertion queries.
+type AssertionOption func(*assertionOptions)
+
+type assertionOptions struct {
+	runLimit int
+}
+
+// WithAssertionRunLimit sets the maximum number of recent runs retrieved per assertion.
+func WithAssertionRunLimit(limit int) AssertionOption {
+	return func(o *assertionOptions) {
+		o.runLimit = limit
+	}
+}
+
+// IncidentOption configures incident queries.
+type IncidentOption func(*incidentOptions)
+
+type incidentOptions struct {
+	state string
+	limit int
+}
+
+// WithIncidentState filters incidents by state (ACTIVE or RESOLVED).
+// The state is normalized to uppercase.
+func WithIncidentState(state string) IncidentOption {
+	return func(o *incidentOptions) {
+		o.state = strings.ToUpper(state)
+	}
+}
+
+// WithIncidentLimit sets the maximum number of incidents returned.
+func WithIncidentLimit(limit int) IncidentOption {
+	return func(o *incidentOptions) {
+		o.limit = limit
+	}
+}
+
+// BrowseOption configures browse queries.
+type BrowseOption func(*browseOptions)
+
+type browseOptions struct {
+	limit  int
+	offset int
+}
+
+// WithBrowseLimit sets the maximum number of children returned.
+func WithBrowseLimit(limit int) BrowseOption {
+	return func(o *browseOptions) {
+		o.limit = limit
+	}
+}
+
+// WithBrowseOffset sets the result offset for pagination.
+func WithBrowseOffset(offset int) BrowseOption {
+	return func(o *browseOptions) {
+		o.offset = offset
+	}
+}
+
+// DataJobOption configures data job queries.
+type DataJobOption func(*dataJobOptions)
+
+type dataJobOptions struct {
+	runLimit int
+}
+
+// WithDataJobRunLimit sets the maximum number of recent runs retrieved.
+func WithDataJobRunLimit(limit int) DataJobOption {
+	return func(o *dataJobOptions) {
+		o.runLimit = limit
+	}
+}
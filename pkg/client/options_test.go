@@ -2,6 +2,7 @@ package client
 
 import (
 	"testing"
+	"time"
 )
 
 func TestToEnumCase(t *testing.T) {
@@ -88,6 +89,11 @@ func TestWithFilters(t *testing.T) {
 	if len(opts.filters) != 1 {
 		t.Errorf("WithFilters() count = %d, want 1", len(opts.filters))
 	}
+
+	WithContainer("urn:li:container:abc")(opts)
+	if len(opts.filters) != 2 || opts.filters["container"][0] != "urn:li:container:abc" {
+		t.Errorf("WithContainer() filters = %v, want container added", opts.filters)
+	}
 }
 
 func TestLineageOptions(t *testing.T) {
@@ -150,6 +156,63 @@ func TestLineageOptions(t *testing.T) {
 				return o.depth == 3
 			},
 		},
+		{
+			name: "limit and offset",
+			applyOpts: func(o *lineageOptions) {
+				WithLineageLimit(25)(o)
+				WithLineageOffset(50)(o)
+			},
+			checkFunc: func(o *lineageOptions) bool {
+				return o.limit == 25 && o.offset == 50
+			},
+		},
+		{
+			name: "entity types normalized",
+			applyOpts: func(o *lineageOptions) {
+				WithLineageEntityTypes("dataset", "dataJob")(o)
+			},
+			checkFunc: func(o *lineageOptions) bool {
+				return len(o.entityTypes) == 2 && o.entityTypes[0] == "DATASET" && o.entityTypes[1] == "DATA_JOB"
+			},
+		},
+		{
+			name: "platforms converted to URNs",
+			applyOpts: func(o *lineageOptions) {
+				WithLineagePlatforms("snowflake", "urn:li:dataPlatform:looker")(o)
+			},
+			checkFunc: func(o *lineageOptions) bool {
+				return len(o.platforms) == 2 &&
+					o.platforms[0] == "urn:li:dataPlatform:snowflake" &&
+					o.platforms[1] == "urn:li:dataPlatform:looker"
+			},
+		},
+		{
+			name: "time range",
+			applyOpts: func(o *lineageOptions) {
+				WithLineageTimeRange(time.UnixMilli(1000), time.UnixMilli(2000))(o)
+			},
+			checkFunc: func(o *lineageOptions) bool {
+				return o.startTimeMillis == 1000 && o.endTimeMillis == 2000
+			},
+		},
+		{
+			name: "open-ended time range",
+			applyOpts: func(o *lineageOptions) {
+				WithLineageTimeRange(time.UnixMilli(1000), time.Time{})(o)
+			},
+			checkFunc: func(o *lineageOptions) bool {
+				return o.startTimeMillis == 1000 && o.endTimeMillis == 0
+			},
+		},
+		{
+			name: "as of",
+			applyOpts: func(o *lineageOptions) {
+				WithLineageAsOf(time.UnixMilli(1500))(o)
+			},
+			checkFunc: func(o *lineageOptions) bool {
+				return o.asOfMillis == 1500
+			},
+		},
 	}
 
 	for _, tt := range tests {
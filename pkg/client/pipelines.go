@@ -0,0 +1,284 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+// defaultDataJobRunLimit is the number of recent runs retrieved when no limit is given.
+const defaultDataJobRunLimit = 10
+
+// ownershipRaw mirrors the ownership selection used by the pipeline queries.
+type ownershipRaw struct {
+	Owners []struct {
+		Owner struct {
+			URN      string `json:"urn"`
+			Username string `json:"username"`
+			Name     string `json:"name"`
+			Info     *struct {
+				DisplayName string `json:"displayName"`
+				Email       string `json:"email"`
+			} `json:"info"`
+		} `json:"owner"`
+		Type string `json:"type"`
+	} `json:"owners"`
+}
+
+// toOwners converts the raw owners, preferring display names.
+func (r *ownershipRaw) toOwners() []types.Owner {
+	if r == nil {
+		return nil
+	}
+	var owners []types.Owner
+	for _, o := range r.Owners {
+		owner := types.Owner{URN: o.Owner.URN, Type: types.OwnershipType(o.Type)}
+		var displayName string
+		if o.Owner.Info != nil {
+			displayName = o.Owner.Info.DisplayName
+			owner.Email = o.Owner.Info.Email
+		}
+		owner.Name = firstNonEmpty(displayName, o.Owner.Name, o.Owner.Username)
+		owners = append(owners, owner)
+	}
+	return owners
+}
+
+// pipelineDatasetRaw mirrors the pipelineDataset fragment.
+type pipelineDatasetRaw struct {
+	URN        string `json:"urn"`
+	Type       string `json:"type"`
+	Name       string `json:"name"`
+	Properties *struct {
+		Name string `json:"name"`
+	} `json:"properties"`
+	Platform *struct {
+		Name string `json:"name"`
+	} `json:"platform"`
+}
+
+// toDatasetRefs converts the raw datasets into entity references.
+func toDatasetRefs(raw []pipelineDatasetRaw) []types.EntityRef {
+	var refs []types.EntityRef
+	for _, d := range raw {
+		ref := types.EntityRef{URN: d.URN, Type: d.Type, Name: d.Name}
+		if d.Properties != nil && d.Properties.Name != "" {
+			ref.Name = d.Properties.Name
+		}
+		if d.Platform != nil {
+			ref.Platform = d.Platform.Name
+		}
+		refs = append(refs, ref)
+	}
+	return refs
+}
+
+// dataJobRaw mirrors the dataJobDetails fragment.
+type dataJobRaw struct {
+	URN      string `json:"urn"`
+	Type     string `json:"type"`
+	JobID    string `json:"jobId"`
+	DataFlow *struct {
+		URN          string `json:"urn"`
+		Orchestrator string `json:"orchestrator"`
+	} `json:"dataFlow"`
+	Properties *struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		ExternalURL string `json:"externalUrl"`
+	} `json:"properties"`
+	EditableProperties *struct {
+		Description string `json:"description"`
+	} `json:"editableProperties"`
+	InputOutput *struct {
+		InputDatasets  []pipelineDatasetRaw `json:"inputDatasets"`
+		OutputDatasets []pipelineDatasetRaw `json:"outputDatasets"`
+	} `json:"inputOutput"`
+	Runs *struct {
+		Total int `json:"total"`
+		Runs  []struct {
+			URN         string         `json:"urn"`
+			Name        string         `json:"name"`
+			ExternalURL string         `json:"externalUrl"`
+			Created     *auditStampGQL `json:"created"`
+			State       []struct {
+				Status          string `json:"status"`
+				Attempt         int    `json:"attempt"`
+				TimestampMillis int64  `json:"timestampMillis"`
+				DurationMillis  int64  `json:"durationMillis"`
+				Result          *struct {
+					ResultType       string `json:"resultType"`
+					NativeResultType string `json:"nativeResultType"`
+				} `json:"result"`
+			} `json:"state"`
+		} `json:"runs"`
+	} `json:"runs"`
+	Ownership *ownershipRaw `json:"ownership"`
+}
+
+// toPipeline converts the raw data job. Runs are ordered newest first.
+func (r *dataJobRaw) toPipeline() types.Pipeline {
+	job := types.Pipeline{
+		Entity: types.Entity{
+			URN:    r.URN,
+			Type:   r.Type,
+			Name:   r.JobID,
+			Owners: r.Ownership.toOwners(),
+		},
+	}
+	if r.DataFlow != nil {
+		job.DataFlow = r.DataFlow.URN
+		job.Orchestrator = r.DataFlow.Orchestrator
+	}
+	if p := r.Properties; p != nil {
+		if p.Name != "" {
+			job.Name = p.Name
+		}
+		job.Description = p.Description
+		job.ExternalURL = p.ExternalURL
+	}
+	if r.EditableProperties != nil && r.EditableProperties.Description != "" {
+		job.Description = r.EditableProperties.Description
+	}
+	if io := r.InputOutput; io != nil {
+		job.Inputs = toDatasetRefs(io.InputDatasets)
+		job.Outputs = toDatasetRefs(io.OutputDatasets)
+	}
+	if r.Runs == nil {
+		return job
+	}
+
+	job.TotalRuns = r.Runs.Total
+	for _, raw := range r.Runs.Runs {
+		run := types.PipelineRun{
+			URN:         raw.URN,
+			Name:        raw.Name,
+			ExternalURL: raw.ExternalURL,
+		}
+		if raw.Created != nil {
+			run.StartTime = raw.Created.Time
+		}
+		if len(raw.State) > 0 {
+			event := raw.State[0]
+			run.Status = event.Status
+			run.Attempt = event.Attempt
+			run.DurationMillis = event.DurationMillis
+			if run.StartTime == 0 {
+				run.StartTime = event.TimestampMillis
+			}
+			if event.Result != nil {
+				run.Result = event.Result.ResultType
+				run.NativeResult = event.Result.NativeResultType
+			}
+		}
+		job.Runs = append(job.Runs, run)
+	}
+	sort.SliceStable(job.Runs, func(a, b int) bool {
+		return job.Runs[a].StartTime > job.Runs[b].StartTime
+	})
+
+	return job
+}
+
+// GetDataJob retrieves a data job with its input and output datasets, parent flow,
+// owners and most recent runs, newest first.
+func (c *Client) GetDataJob(ctx context.Context, urn string, opts ...DataJobOption) (*types.Pipeline, error) {
+	options := &dataJobOptions{runLimit: defaultDataJobRunLimit}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.runLimit <= 0 {
+		options.runLimit = defaultDataJobRunLimit
+	}
+
+	variables := map[string]any{
+		"urn":      urn,
+		"runLimit": options.runLimit,
+	}
+
+	var response struct {
+		DataJob dataJobRaw `json:"dataJob"`
+	}
+
+	if err := c.Execute(ctx, GetDataJobQuery, variables, &response); err != nil {
+		return nil, fmt.Errorf("GetDataJob(%s): %w", urn, err)
+	}
+	if response.DataJob.URN == "" {
+		return nil, fmt.Errorf("GetDataJob(%s): %w", urn, ErrNotFound)
+	}
+
+	job := response.DataJob.toPipeline()
+	return &job, nil
+}
+
+// GetDataFlow retrieves a data flow with its owners and jobs, each with its latest run.
+// At most 100 jobs are returned; TotalJobs reports the full count.
+func (c *Client) GetDataFlow(ctx context.Context, urn string) (*types.DataFlow, error) {
+	variables := map[string]any{
+		"urn":      urn,
+		"runLimit": 1,
+	}
+
+	var response struct {
+		DataFlow struct {
+			URN          string `json:"urn"`
+			Type         string `json:"type"`
+			Orchestrator string `json:"orchestrator"`
+			Cluster      string `json:"cluster"`
+			Properties   *struct {
+				Name        string `json:"name"`
+				Description string `json:"description"`
+				ExternalURL string `json:"externalUrl"`
+			} `json:"properties"`
+			EditableProperties *struct {
+				Description string `json:"description"`
+			} `json:"editableProperties"`
+			Ownership *ownershipRaw `json:"ownership"`
+			Jobs      *struct {
+				Total         int `json:"total"`
+				Relationships []struct {
+					Entity *dataJobRaw `json:"entity"`
+				} `json:"relationships"`
+			} `json:"jobs"`
+		} `json:"dataFlow"`
+	}
+
+	if err := c.Execute(ctx, GetDataFlowQuery, variables, &response); err != nil {
+		return nil, fmt.Errorf("GetDataFlow(%s): %w", urn, err)
+	}
+
+	raw := response.DataFlow
+	if raw.URN == "" {
+		return nil, fmt.Errorf("GetDataFlow(%s): %w", urn, ErrNotFound)
+	}
+
+	flow := &types.DataFlow{
+		Entity: types.Entity{
+			URN:    raw.URN,
+			Type:   raw.Type,
+			Owners: raw.Ownership.toOwners(),
+		},
+		Orchestrator: raw.Orchestrator,
+		Cluster:      raw.Cluster,
+	}
+	if p := raw.Properties; p != nil {
+		flow.Name = p.Name
+		flow.Description = p.Description
+		flow.ExternalURL = p.ExternalURL
+	}
+	if raw.EditableProperties != nil && raw.EditableProperties.Description != "" {
+		flow.Description = raw.EditableProperties.Description
+	}
+	if raw.Jobs != nil {
+		flow.TotalJobs = raw.Jobs.Total
+		for _, rel := range raw.Jobs.Relationships {
+			if rel.Entity != nil && rel.Entity.URN != "" {
+				flow.Jobs = append(flow.Jobs, rel.Entity.toPipeline())
+			}
+		}
+	}
+
+	return flow, nil
+}
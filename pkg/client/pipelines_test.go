@@ -0,0 +1,153 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func testDataJobData() map[string]any {
+	return map[string]any{
+		"urn":      "urn:li:dataJob:(urn:li:dataFlow:(airflow,daily_sales,prod),load_orders)",
+		"type":     "DATA_JOB",
+		"jobId":    "load_orders",
+		"dataFlow": map[string]any{"urn": "urn:li:dataFlow:(airflow,daily_sales,prod)", "orchestrator": "airflow"},
+		"properties": map[string]any{
+			"description": "Loads orders",
+			"externalUrl": "https://airflow.example.com/load_orders",
+		},
+		"inputOutput": map[string]any{
+			"inputDatasets": []map[string]any{{
+				"urn": "urn:li:dataset:raw", "type": "DATASET", "name": "raw/orders", "platform": map[string]any{"name": "s3"},
+			}},
+			"outputDatasets": []map[string]any{{"urn": ordersURN, "type": "DATASET", "properties": map[string]any{"name": "orders"}}},
+		},
+		"runs": map[string]any{
+			"total": 412,
+			"runs": []map[string]any{
+				{
+					"urn":     "urn:li:dataProcessInstance:older",
+					"created": map[string]any{"time": 1000},
+					"state":   []map[string]any{{"status": "COMPLETE", "result": map[string]any{"resultType": "SUCCESS"}}},
+				},
+				{
+					"urn":  "urn:li:dataProcessInstance:newer",
+					"name": "scheduled__2024-01-02",
+					"state": []map[string]any{{
+						"status":          "COMPLETE",
+						"attempt":         2,
+						"timestampMillis": 2000,
+						"durationMillis":  93000,
+						"result":          map[string]any{"resultType": "FAILURE", "nativeResultType": "failed"},
+					}},
+				},
+				{"urn": "urn:li:dataProcessInstance:unknown", "state": []map[string]any{}},
+			},
+		},
+	}
+}
+
+func TestClientGetDataJob(t *testing.T) {
+	var vars map[string]any
+	data := testDataJobData()
+	data["ownership"] = map[string]any{
+		"owners": []map[string]any{
+			{
+				"owner": map[string]any{
+					"urn": "urn:li:corpuser:jdoe", "username": "jdoe",
+					"info": map[string]any{"displayName": "Jane Doe", "email": "jdoe@example.com"},
+				},
+				"type": "TECHNICAL_OWNER",
+			},
+			{"owner": map[string]any{"urn": "urn:li:corpGroup:data", "name": "data"}, "type": "DATAOWNER"},
+		},
+	}
+	c := newGraphQLTestClient(t, map[string]any{"dataJob": data}, &vars)
+
+	job, err := c.GetDataJob(context.Background(), "urn:li:dataJob:x", WithDataJobRunLimit(3))
+	if err != nil {
+		t.Fatalf("GetDataJob() unexpected error: %v", err)
+	}
+	if vars["runLimit"] != float64(3) {
+		t.Errorf("runLimit = %v, want 3", vars["runLimit"])
+	}
+	if job.Name != "load_orders" || job.Orchestrator != "airflow" || job.DataFlow == "" || job.ExternalURL == "" {
+		t.Errorf("unexpected job: %+v", job)
+	}
+	if len(job.Owners) != 2 || job.Owners[0].Name != "Jane Doe" || job.Owners[0].Email == "" || job.Owners[1].Name != "data" {
+		t.Errorf("Owners = %+v", job.Owners)
+	}
+	if len(job.Inputs) != 1 || job.Inputs[0].Platform != "s3" || len(job.Outputs) != 1 || job.Outputs[0].Name != "orders" {
+		t.Errorf("Inputs = %+v, Outputs = %+v", job.Inputs, job.Outputs)
+	}
+	if job.TotalRuns != 412 || len(job.Runs) != 3 {
+		t.Fatalf("runs = %d of %d, want 3 of 412", len(job.Runs), job.TotalRuns)
+	}
+	latest := job.Runs[0]
+	if latest.URN != "urn:li:dataProcessInstance:newer" || latest.Result != "FAILURE" ||
+		latest.DurationMillis != 93000 || latest.StartTime != 2000 {
+		t.Errorf("latest run = %+v", latest)
+	}
+	if job.Runs[2].Status != "" {
+		t.Errorf("run without state = %+v", job.Runs[2])
+	}
+}
+
+func TestClientGetDataJobDefaultRunLimit(t *testing.T) {
+	var vars map[string]any
+	c := newGraphQLTestClient(t, map[string]any{"dataJob": map[string]any{"urn": "urn:li:dataJob:x", "jobId": "x"}}, &vars)
+
+	job, err := c.GetDataJob(context.Background(), "urn:li:dataJob:x", WithDataJobRunLimit(0))
+	if err != nil {
+		t.Fatalf("GetDataJob() unexpected error: %v", err)
+	}
+	if vars["runLimit"] != float64(defaultDataJobRunLimit) {
+		t.Errorf("runLimit = %v, want %d", vars["runLimit"], defaultDataJobRunLimit)
+	}
+	if job.Name != "x" || job.Runs != nil {
+		t.Errorf("unexpected job: %+v", job)
+	}
+}
+
+func TestClientGetDataFlow(t *testing.T) {
+	var vars map[string]any
+	c := newGraphQLTestClient(t, map[string]any{
+		"dataFlow": map[string]any{
+			"urn":                "urn:li:dataFlow:(airflow,daily_sales,prod)",
+			"type":               "DATA_FLOW",
+			"orchestrator":       "airflow",
+			"cluster":            "prod",
+			"properties":         map[string]any{"name": "daily_sales", "externalUrl": "https://airflow.example.com/daily_sales"},
+			"editableProperties": map[string]any{"description": "Daily sales load"},
+			"jobs": map[string]any{
+				"total":         1,
+				"relationships": []map[string]any{{"entity": testDataJobData()}, {"entity": nil}},
+			},
+		},
+	}, &vars)
+
+	flow, err := c.GetDataFlow(context.Background(), "urn:li:dataFlow:(airflow,daily_sales,prod)")
+	if err != nil {
+		t.Fatalf("GetDataFlow() unexpected error: %v", err)
+	}
+	if vars["runLimit"] != float64(1) {
+		t.Errorf("runLimit = %v, want 1", vars["runLimit"])
+	}
+	if flow.Name != "daily_sales" || flow.Description != "Daily sales load" || flow.Cluster != "prod" {
+		t.Errorf("unexpected flow: %+v", flow)
+	}
+	if flow.TotalJobs != 1 || len(flow.Jobs) != 1 || flow.Jobs[0].Name != "load_orders" {
+		t.Errorf("Jobs = %+v", flow.Jobs)
+	}
+}
+
+func TestClientPipelinesNotFound(t *testing.T) {
+	c := newGraphQLTestClient(t, map[string]any{"dataJob": nil, "dataFlow": nil}, nil)
+
+	if _, err := c.GetDataJob(context.Background(), "urn:li:dataJob:missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetDataJob() error = %v, want ErrNotFound", err)
+	}
+	if _, err := c.GetDataFlow(context.Background(), "urn:li:dataFlow:missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetDataFlow() error = %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,118 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+// defaultProfileLimit is the number of profiles retrieved when no limit is given.
+const defaultProfileLimit = 10
+
+// GetDatasetProfile retrieves the most recent profile of a dataset within the
+// requested time range. Earlier profiles in the range are returned as History.
+// Returns ErrNotFound if the dataset does not exist or has no profiles in range.
+func (c *Client) GetDatasetProfile(ctx context.Context, urn string, opts ...ProfileOption) (*types.DatasetProfile, error) {
+	options := &profileOptions{limit: defaultProfileLimit}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.limit <= 0 {
+		options.limit = defaultProfileLimit
+	}
+
+	variables := map[string]any{
+		"urn":   urn,
+		"limit": options.limit,
+	}
+	if !options.start.IsZero() {
+		variables["startTimeMillis"] = options.start.UnixMilli()
+	}
+	if !options.end.IsZero() {
+		variables["endTimeMillis"] = options.end.UnixMilli()
+	}
+
+	var response struct {
+		Dataset struct {
+			URN             string `json:"urn"`
+			DatasetProfiles []struct {
+				TimestampMillis int64 `json:"timestampMillis"`
+				RowCount        int64 `json:"rowCount"`
+				ColumnCount     int64 `json:"columnCount"`
+				SizeInBytes     int64 `json:"sizeInBytes"`
+				PartitionSpec   *struct {
+					Partition string `json:"partition"`
+				} `json:"partitionSpec"`
+				FieldProfiles []struct {
+					FieldPath        string   `json:"fieldPath"`
+					UniqueCount      *int64   `json:"uniqueCount"`
+					UniqueProportion *float64 `json:"uniqueProportion"`
+					NullCount        *int64   `json:"nullCount"`
+					NullProportion   *float64 `json:"nullProportion"`
+					Min              string   `json:"min"`
+					Max              string   `json:"max"`
+					Mean             string   `json:"mean"`
+					Median           string   `json:"median"`
+					Stdev            string   `json:"stdev"`
+					SampleValues     []string `json:"sampleValues"`
+				} `json:"fieldProfiles"`
+			} `json:"datasetProfiles"`
+		} `json:"dataset"`
+	}
+
+	if err := c.Execute(ctx, GetDatasetProfilesQuery, variables, &response); err != nil {
+		return nil, fmt.Errorf("GetDatasetProfile(%s): %w", urn, err)
+	}
+	if response.Dataset.URN == "" {
+		return nil, fmt.Errorf("GetDatasetProfile(%s): %w", urn, ErrNotFound)
+	}
+
+	profiles := response.Dataset.DatasetProfiles
+	if len(profiles) == 0 {
+		return nil, fmt.Errorf("GetDatasetProfile(%s): no profiles in the requested time range: %w", urn, ErrNotFound)
+	}
+	sort.SliceStable(profiles, func(i, j int) bool {
+		return profiles[i].TimestampMillis > profiles[j].TimestampMillis
+	})
+
+	latest := profiles[0]
+	result := &types.DatasetProfile{
+		URN:         urn,
+		Timestamp:   latest.TimestampMillis,
+		RowCount:    latest.RowCount,
+		ColumnCount: latest.ColumnCount,
+		SizeInBytes: latest.SizeInBytes,
+	}
+	if latest.PartitionSpec != nil && latest.PartitionSpec.Partition != "FULL_TABLE_SNAPSHOT" {
+		result.Partition = latest.PartitionSpec.Partition
+	}
+
+	for _, f := range latest.FieldProfiles {
+		result.Fields = append(result.Fields, types.FieldProfile{
+			FieldPath:        f.FieldPath,
+			UniqueCount:      f.UniqueCount,
+			UniqueProportion: f.UniqueProportion,
+			NullCount:        f.NullCount,
+			NullProportion:   f.NullProportion,
+			Min:              f.Min,
+			Max:              f.Max,
+			Mean:             f.Mean,
+			Median:           f.Median,
+			Stdev:            f.Stdev,
+			SampleValues:     f.SampleValues,
+		})
+	}
+
+	for _, p := range profiles[1:] {
+		result.History = append(result.History, types.ProfileSnapshot{
+			Timestamp:   p.TimestampMillis,
+			RowCount:    p.RowCount,
+			ColumnCount: p.ColumnCount,
+			SizeInBytes: p.SizeInBytes,
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// newGraphQLTestClient returns a client whose server answers every request with data.
+// The variables of the most recent request are stored in vars when it is non-nil.
+func newGraphQLTestClient(t *testing.T, data map[string]any, vars *map[string]any) *Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Variables map[string]any `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+		if vars != nil {
+			*vars = req.Variables
+		}
+		writeJSON(t, w, map[string]any{"data": data})
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := New(Config{URL: server.URL, Token: "test-token", RetryMax: 0})
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+	return c
+}
+
+func TestClientGetDatasetProfile(t *testing.T) {
+	var vars map[string]any
+	c := newGraphQLTestClient(t, map[string]any{
+		"dataset": map[string]any{
+			"urn": "urn:li:dataset:orders",
+			"datasetProfiles": []map[string]any{
+				{"timestampMillis": 1000, "rowCount": 90, "columnCount": 3},
+				{
+					"timestampMillis": 2000,
+					"rowCount":        100,
+					"columnCount":     3,
+					"sizeInBytes":     4096,
+					"partitionSpec":   map[string]any{"partition": "FULL_TABLE_SNAPSHOT"},
+					"fieldProfiles": []map[string]any{
+						{
+							"fieldPath":        "id",
+							"uniqueCount":      100,
+							"uniqueProportion": 1.0,
+							"nullCount":        0,
+							"nullProportion":   0.0,
+							"min":              "1",
+							"max":              "100",
+							"sampleValues":     []string{"1", "2"},
+						},
+						{"fieldPath": "note"},
+					},
+				},
+			},
+		},
+	}, &vars)
+
+	start := time.UnixMilli(500)
+	profile, err := c.GetDatasetProfile(context.Background(), "urn:li:dataset:orders",
+		WithProfileTimeRange(start, time.Time{}), WithProfileLimit(5))
+	if err != nil {
+		t.Fatalf("GetDatasetProfile() unexpected error: %v", err)
+	}
+
+	if vars["startTimeMillis"] != float64(500) {
+		t.Errorf("startTimeMillis = %v, want 500", vars["startTimeMillis"])
+	}
+	if _, ok := vars["endTimeMillis"]; ok {
+		t.Error("endTimeMillis should be omitted for an open range")
+	}
+	if vars["limit"] != float64(5) {
+		t.Errorf("limit = %v, want 5", vars["limit"])
+	}
+
+	if profile.Timestamp != 2000 || profile.RowCount != 100 || profile.SizeInBytes != 4096 {
+		t.Errorf("latest profile not selected: %+v", profile)
+	}
+	if profile.Partition != "" {
+		t.Errorf("Partition = %q, full-table snapshots should be omitted", profile.Partition)
+	}
+	if len(profile.Fields) != 2 {
+		t.Fatalf("expected 2 field profiles, got %d", len(profile.Fields))
+	}
+	id := profile.Fields[0]
+	if id.NullCount == nil || *id.NullCount != 0 || id.UniqueCount == nil || *id.UniqueCount != 100 {
+		t.Errorf("id statistics not parsed: %+v", id)
+	}
+	if profile.Fields[1].NullCount != nil {
+		t.Error("missing statistics should stay nil")
+	}
+	if len(profile.History) != 1 || profile.History[0].RowCount != 90 {
+		t.Errorf("History = %+v, want one earlier snapshot", profile.History)
+	}
+}
+
+func TestClientGetDatasetProfileNotFound(t *testing.T) {
+	tests := []struct {
+		name    string
+		dataset map[string]any
+	}{
+		{"missing dataset", map[string]any{"urn": ""}},
+		{"no profiles", map[string]any{"urn": "urn:li:dataset:orders", "datasetProfiles": []any{}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newGraphQLTestClient(t, map[string]any{"dataset": tt.dataset}, nil)
+			_, err := c.GetDatasetProfile(context.Background(), "urn:li:dataset:orders")
+			if !errors.Is(err, ErrNotFound) {
+				t.Errorf("GetDatasetProfile() error = %v, want ErrNotFound", err)
+			}
+		})
+	}
+}
+
+func TestClientGetDatasetProfileDefaultLimit(t *testing.T) {
+	var vars map[string]any
+	c := newGraphQLTestClient(t, map[string]any{
+		"dataset": map[string]any{
+			"urn":             "urn:li:dataset:orders",
+			"datasetProfiles": []map[string]any{{"timestampMillis": 1, "rowCount": 1}},
+		},
+	}, &vars)
+
+	if _, err := c.GetDatasetProfile(context.Background(), "urn:li:dataset:orders", WithProfileLimit(0)); err != nil {
+		t.Fatalf("GetDatasetProfile() unexpected error: %v", err)
+	}
+	if vars["limit"] != float64(defaultProfileLimit) {
+		t.Errorf("limit = %v, want %d", vars["limit"], defaultProfileLimit)
+	}
+}
@@ -287,15 +287,34 @@ query getSchema($urn: String!) {
 
 	// GetLineageQuery retrieves lineage for an entity.
 	// Note: maxHops parameter was removed from DataHub's SearchAcrossLineageInput.
-	// Depth filtering should be done client-side using the returned degree values.
+	// Depth is limited with the "degree" filter (values 1, 2 and 3+), so degrees
+	// beyond 3 are still filtered client-side.
 	GetLineageQuery = `
-query getLineage($urn: String!, $direction: LineageDirection!) {
+query getLineage(
+  $urn: String!
+  $direction: LineageDirection!
+  $start: Int
+  $count: Int
+  $types: [EntityType!]
+  $orFilters: [AndFilterInput!]
+  $startTimeMillis: Long
+  $endTimeMillis: Long
+) {
   searchAcrossLineage(
     input: {
       urn: $urn
       direction: $direction
+      start: $start
+      count: $count
+      types: $types
+      orFilters: $orFilters
+      startTimeMillis: $startTimeMillis
+      endTimeMillis: $endTimeMillis
     }
   ) {
+    start
+    count
+    total
     searchResults {
       entity {
         urn
@@ -409,6 +428,30 @@ query getGlossaryTerm($urn: String!) {
         type
       }
     }
+    relatedTo: relationships(input: {types: ["IsA", "HasA", "HasValue", "IsRelatedTo"], direction: OUTGOING, start: 0, count: 100}) {
+      ...glossaryRelations
+    }
+    relatedFrom: relationships(input: {types: ["IsA", "HasA", "HasValue", "IsRelatedTo"], direction: INCOMING, start: 0, count: 100}) {
+      ...glossaryRelations
+    }
+  }
+  usage: searchAcrossEntities(input: {query: "*", start: 0, count: 0, orFilters: [{and: [{field: "glossaryTerms", values: [$urn]}]}]}) {
+    total
+  }
+}
+
+fragment glossaryRelations on EntityRelationshipsResult {
+  relationships {
+    type
+    entity {
+      urn
+      ... on GlossaryTerm {
+        name
+        properties {
+          name
+        }
+      }
+    }
   }
 }
 `
@@ -711,5 +754,1066 @@ query batchGetSchemas($urns: [String!]!) {
     }
   }
 }
+`
+
+	// GetDatasetFreshnessQuery retrieves a dataset's latest operations and the
+	// last-modified time reported by its source system.
+	GetDatasetFreshnessQuery = `
+query getDatasetFreshness($urn: String!, $limit: Int) {
+  dataset(urn: $urn) {
+    urn
+    operations(limit: $limit) {
+      lastUpdatedTimestamp
+      operationType
+      customOperationType
+      actor
+    }
+    properties {
+      lastModified {
+        time
+        actor
+      }
+    }
+  }
+}
+`
+
+	// GetDatasetProfilesQuery retrieves dataset profiles within a time range, newest first.
+	GetDatasetProfilesQuery = `
+query getDatasetProfiles($urn: String!, $startTimeMillis: Long, $endTimeMillis: Long, $limit: Int) {
+  dataset(urn: $urn) {
+    urn
+    datasetProfiles(startTimeMillis: $startTimeMillis, endTimeMillis: $endTimeMillis, limit: $limit) {
+      timestampMillis
+      rowCount
+      columnCount
+      sizeInBytes
+      partitionSpec {
+        partition
+      }
+      fieldProfiles {
+        fieldPath
+        uniqueCount
+        uniqueProportion
+        nullCount
+        nullProportion
+        min
+        max
+        mean
+        median
+        stdev
+        sampleValues
+      }
+    }
+  }
+}
+`
+
+	// GetUsageStatsQuery retrieves aggregated dataset usage over a time range.
+	GetUsageStatsQuery = `
+query getUsageStats($urn: String!, $range: TimeRange) {
+  dataset(urn: $urn) {
+    urn
+    usageStats(range: $range) {
+      aggregations {
+        uniqueUserCount
+        totalSqlQueries
+        users {
+          user {
+            urn
+            username
+          }
+          count
+          userEmail
+        }
+        fields {
+          fieldName
+          count
+        }
+      }
+      buckets {
+        bucket
+        duration
+        metrics {
+          uniqueUserCount
+          totalSqlQueries
+        }
+      }
+    }
+  }
+}
+`
+
+	// GetAssertionsQuery retrieves a page of the assertions on a dataset with their recent run results.
+	GetAssertionsQuery = `
+query getAssertions($urn: String!, $start: Int!, $count: Int!, $runLimit: Int) {
+  dataset(urn: $urn) {
+    urn
+    assertions(start: $start, count: $count) {
+      total
+      assertions {
+        ...assertionDetails
+      }
+    }
+  }
+}
+` + assertionFragments
+
+	// assertionFragments selects an assertion's definition and its recent completed runs.
+	// Queries using it must declare a $runLimit variable.
+	assertionFragments = `
+fragment assertionDetails on Assertion {
+  urn
+  info {
+    type
+    description
+    externalUrl
+    source {
+      type
+    }
+    datasetAssertion {
+      scope
+      fields {
+        path
+      }
+      aggregation
+      operator
+      nativeType
+      parameters {
+        ...assertionParameters
+      }
+    }
+    freshnessAssertion {
+      type
+      schedule {
+        type
+        cron {
+          cron
+          timezone
+        }
+        fixedInterval {
+          unit
+          multiple
+        }
+      }
+    }
+    volumeAssertion {
+      type
+      rowCountTotal {
+        operator
+        parameters {
+          ...assertionParameters
+        }
+      }
+    }
+    sqlAssertion {
+      type
+      statement
+      operator
+      parameters {
+        ...assertionParameters
+      }
+    }
+    fieldAssertion {
+      type
+      fieldValuesAssertion {
+        field {
+          path
+        }
+        operator
+        parameters {
+          ...assertionParameters
+        }
+      }
+      fieldMetricAssertion {
+        field {
+          path
+        }
+        metric
+        operator
+        parameters {
+          ...assertionParameters
+        }
+      }
+    }
+    schemaAssertion {
+      compatibility
+    }
+  }
+  runEvents(status: COMPLETE, limit: $runLimit) {
+    total
+    failed
+    succeeded
+    runEvents {
+      timestampMillis
+      runId
+      result {
+        type
+        actualAggValue
+        rowCount
+        unexpectedCount
+        externalUrl
+        nativeResults {
+          key
+          value
+        }
+      }
+    }
+  }
+}
+
+fragment assertionParameters on AssertionStdParameters {
+  value {
+    value
+  }
+  minValue {
+    value
+  }
+  maxValue {
+    value
+  }
+}
+`
+
+	// GetIncidentsQuery retrieves incidents raised on an entity.
+	GetIncidentsQuery = `
+query getIncidents($urn: String!, $state: IncidentState, $start: Int, $count: Int) {
+  entity(urn: $urn) {
+    urn
+    ... on Dataset {
+      incidents(state: $state, start: $start, count: $count) {
+        ...incidentResults
+      }
+    }
+    ... on DataJob {
+      incidents(state: $state, start: $start, count: $count) {
+        ...incidentResults
+      }
+    }
+    ... on DataFlow {
+      incidents(state: $state, start: $start, count: $count) {
+        ...incidentResults
+      }
+    }
+    ... on Dashboard {
+      incidents(state: $state, start: $start, count: $count) {
+        ...incidentResults
+      }
+    }
+    ... on Chart {
+      incidents(state: $state, start: $start, count: $count) {
+        ...incidentResults
+      }
+    }
+  }
+}
+
+fragment incidentResults on EntityIncidentsResult {
+  total
+  incidents {
+    urn
+    incidentType
+    customType
+    title
+    description
+    priority
+    status {
+      state
+      stage
+      message
+      lastUpdated {
+        time
+        actor
+      }
+    }
+    source {
+      type
+    }
+    entity {
+      urn
+    }
+    assignees {
+      ... on CorpUser {
+        urn
+      }
+      ... on CorpGroup {
+        urn
+      }
+    }
+    created {
+      time
+      actor
+    }
+  }
+}
+`
+
+	// RaiseIncidentMutation raises a new incident on an entity.
+	RaiseIncidentMutation = `
+mutation raiseIncident($input: RaiseIncidentInput!) {
+  raiseIncident(input: $input)
+}
+`
+
+	// UpdateIncidentStatusMutation changes the status of an incident.
+	UpdateIncidentStatusMutation = `
+mutation updateIncidentStatus($urn: String!, $input: IncidentStatusInput!) {
+  updateIncidentStatus(urn: $urn, input: $input)
+}
+`
+
+	// UpdateLineageMutation adds and removes manual lineage edges.
+	UpdateLineageMutation = `
+mutation updateLineage($input: UpdateLineageInput!) {
+  updateLineage(input: $input)
+}
+`
+
+	// GetDataContractQuery retrieves the data contract on a dataset with its assertions.
+	GetDataContractQuery = `
+query getDataContract($urn: String!, $runLimit: Int) {
+  dataset(urn: $urn) {
+    urn
+    contract {
+      urn
+      properties {
+        entityUrn
+        freshness {
+          assertion {
+            ...assertionDetails
+          }
+        }
+        schema {
+          assertion {
+            ...assertionDetails
+          }
+        }
+        dataQuality {
+          assertion {
+            ...assertionDetails
+          }
+        }
+      }
+      status {
+        state
+      }
+    }
+  }
+}
+` + assertionFragments
+
+	// GetContainerQuery retrieves a container with its browse path and child count.
+	GetContainerQuery = `
+query getContainer($urn: String!) {
+  container(urn: $urn) {
+    urn
+    properties {
+      name
+      description
+      externalUrl
+    }
+    editableProperties {
+      description
+    }
+    platform {
+      name
+    }
+    dataPlatformInstance {
+      urn
+    }
+    subTypes {
+      typeNames
+    }
+    entities(input: {start: 0, count: 0}) {
+      total
+    }
+    browsePathV2 {
+      ...browsePathEntries
+    }
+  }
+}
+` + browsePathFragment
+
+	// GetBrowsePathQuery retrieves the browse path of any browsable entity.
+	GetBrowsePathQuery = `
+query getBrowsePath($urn: String!) {
+  entity(urn: $urn) {
+    urn
+    ... on Dataset {
+      browsePathV2 {
+        ...browsePathEntries
+      }
+    }
+    ... on Container {
+      browsePathV2 {
+        ...browsePathEntries
+      }
+    }
+    ... on Dashboard {
+      browsePathV2 {
+        ...browsePathEntries
+      }
+    }
+    ... on Chart {
+      browsePathV2 {
+        ...browsePathEntries
+      }
+    }
+    ... on DataFlow {
+      browsePathV2 {
+        ...browsePathEntries
+      }
+    }
+    ... on DataJob {
+      browsePathV2 {
+        ...browsePathEntries
+      }
+    }
+    ... on MLModel {
+      browsePathV2 {
+        ...browsePathEntries
+      }
+    }
+  }
+}
+` + browsePathFragment
+
+	// browsePathFragment selects the levels of a BrowsePathV2.
+	browsePathFragment = `
+fragment browsePathEntries on BrowsePathV2 {
+  path {
+    name
+    entity {
+      urn
+      type
+      ... on Container {
+        properties {
+          name
+        }
+        subTypes {
+          typeNames
+        }
+      }
+    }
+  }
+}
+`
+
+	// BrowseQuery lists a page of entities matching search filters, such as the direct
+	// children of a container, platform or platform instance, or the entities an owner owns.
+	BrowseQuery = `
+query browse($input: SearchAcrossEntitiesInput!) {
+  searchAcrossEntities(input: $input) {
+    start
+    count
+    total
+    searchResults {
+      entity {
+        urn
+        type
+        ... on Container {
+          properties {
+            name
+            description
+          }
+          platform {
+            name
+          }
+          subTypes {
+            typeNames
+          }
+        }
+        ... on Dataset {
+          name
+          properties {
+            name
+            description
+          }
+          platform {
+            name
+          }
+          subTypes {
+            typeNames
+          }
+        }
+        ... on Dashboard {
+          properties {
+            name
+            description
+          }
+          platform {
+            name
+          }
+          subTypes {
+            typeNames
+          }
+        }
+        ... on Chart {
+          properties {
+            name
+            description
+          }
+          platform {
+            name
+          }
+          subTypes {
+            typeNames
+          }
+        }
+        ... on DataFlow {
+          properties {
+            name
+            description
+          }
+          platform {
+            name
+          }
+        }
+        ... on DataJob {
+          properties {
+            name
+            description
+          }
+        }
+        ... on MLModel {
+          name
+          description
+          platform {
+            name
+          }
+        }
+        ... on GlossaryTerm {
+          properties {
+            name
+            description
+          }
+        }
+        ... on Domain {
+          properties {
+            name
+            description
+          }
+        }
+        ... on DataProduct {
+          properties {
+            name
+            description
+          }
+        }
+      }
+    }
+  }
+}
+`
+
+	// GetDashboardQuery retrieves a dashboard with its charts and the datasets and fields they read.
+	GetDashboardQuery = `
+query getDashboard($urn: String!) {
+  dashboard(urn: $urn) {
+    urn
+    type
+    platform {
+      name
+    }
+    properties {
+      name
+      description
+      externalUrl
+      dashboardUrl
+      lastRefreshed
+      created {
+        time
+      }
+      lastModified {
+        time
+      }
+    }
+    editableProperties {
+      description
+    }
+    inputFields {
+      ...inputFieldEntries
+    }
+    datasets: relationships(input: {types: ["Consumes"], direction: OUTGOING, start: 0, count: 100}) {
+      ...relatedEntities
+    }
+    charts: relationships(input: {types: ["Contains"], direction: OUTGOING, start: 0, count: 100}) {
+      total
+      relationships {
+        entity {
+          ...chartDetails
+        }
+      }
+    }
+  }
+}
+` + chartFragments
+
+	// GetChartQuery retrieves a chart with its query, inputs and the dashboards containing it.
+	GetChartQuery = `
+query getChart($urn: String!) {
+  chart(urn: $urn) {
+    ...chartDetails
+    dashboards: relationships(input: {types: ["Contains"], direction: INCOMING, start: 0, count: 100}) {
+      ...relatedEntities
+    }
+  }
+}
+` + chartFragments
+
+	// chartFragments are the chart details shared by GetDashboardQuery and GetChartQuery.
+	chartFragments = `
+fragment chartDetails on Chart {
+  urn
+  type
+  platform {
+    name
+  }
+  properties {
+    name
+    description
+    externalUrl
+    chartUrl
+    type
+    lastRefreshed
+    created {
+      time
+    }
+    lastModified {
+      time
+    }
+  }
+  editableProperties {
+    description
+  }
+  query {
+    rawQuery
+    type
+  }
+  inputFields {
+    ...inputFieldEntries
+  }
+  datasets: relationships(input: {types: ["Consumes"], direction: OUTGOING, start: 0, count: 100}) {
+    ...relatedEntities
+  }
+}
+
+fragment inputFieldEntries on InputFields {
+  fields {
+    schemaFieldUrn
+    schemaField {
+      fieldPath
+      type
+      nativeDataType
+    }
+  }
+}
+
+fragment relatedEntities on EntityRelationshipsResult {
+  total
+  relationships {
+    entity {
+      urn
+      type
+      ... on Dataset {
+        name
+        properties {
+          name
+        }
+        platform {
+          name
+        }
+      }
+      ... on Dashboard {
+        properties {
+          name
+        }
+        platform {
+          name
+        }
+      }
+    }
+  }
+}
+`
+
+	// GetDataJobQuery retrieves a data job with its inputs, outputs, parent flow and recent runs.
+	GetDataJobQuery = `
+query getDataJob($urn: String!, $runLimit: Int!) {
+  dataJob(urn: $urn) {
+    ...dataJobDetails
+    ownership {
+      owners {
+        owner {
+          ... on CorpUser {
+            urn
+            username
+            info {
+              displayName
+              email
+            }
+          }
+          ... on CorpGroup {
+            urn
+            name
+          }
+        }
+        type
+      }
+    }
+  }
+}
+` + dataJobFragment
+
+	// GetDataFlowQuery retrieves a data flow with its jobs and the latest runs of each job.
+	GetDataFlowQuery = `
+query getDataFlow($urn: String!, $runLimit: Int!) {
+  dataFlow(urn: $urn) {
+    urn
+    type
+    orchestrator
+    cluster
+    properties {
+      name
+      description
+      externalUrl
+    }
+    editableProperties {
+      description
+    }
+    ownership {
+      owners {
+        owner {
+          ... on CorpUser {
+            urn
+            username
+            info {
+              displayName
+              email
+            }
+          }
+          ... on CorpGroup {
+            urn
+            name
+          }
+        }
+        type
+      }
+    }
+    jobs: relationships(input: {types: ["IsPartOf"], direction: INCOMING, start: 0, count: 100}) {
+      total
+      relationships {
+        entity {
+          ...dataJobDetails
+        }
+      }
+    }
+  }
+}
+` + dataJobFragment
+
+	// dataJobFragment holds the data job details shared by GetDataJobQuery and GetDataFlowQuery.
+	dataJobFragment = `
+fragment dataJobDetails on DataJob {
+  urn
+  type
+  jobId
+  dataFlow {
+    urn
+    orchestrator
+  }
+  properties {
+    name
+    description
+    externalUrl
+  }
+  editableProperties {
+    description
+  }
+  inputOutput {
+    inputDatasets {
+      ...pipelineDataset
+    }
+    outputDatasets {
+      ...pipelineDataset
+    }
+  }
+  runs(start: 0, count: $runLimit) {
+    total
+    runs {
+      urn
+      name
+      externalUrl
+      created {
+        time
+      }
+      state(limit: 1) {
+        status
+        attempt
+        timestampMillis
+        durationMillis
+        result {
+          resultType
+          nativeResultType
+        }
+      }
+    }
+  }
+}
+
+fragment pipelineDataset on Dataset {
+  urn
+  type
+  name
+  properties {
+    name
+  }
+  platform {
+    name
+  }
+}
+`
+
+	// GetUserQuery retrieves a user's profile and group memberships.
+	GetUserQuery = `
+query getUser($urn: String!) {
+  corpUser(urn: $urn) {
+    ...userProfile
+    properties {
+      departmentName
+      manager {
+        urn
+      }
+    }
+    editableProperties {
+      teams
+      phone
+    }
+    groups: relationships(input: {types: ["IsMemberOfGroup", "IsMemberOfNativeGroup"], direction: OUTGOING, start: 0, count: 100}) {
+      total
+      relationships {
+        entity {
+          urn
+          type
+          ... on CorpGroup {
+            name
+            properties {
+              displayName
+            }
+          }
+        }
+      }
+    }
+  }
+}
+` + userProfileFragment
+
+	// GetGroupQuery retrieves a group with its contact details, owners and members.
+	GetGroupQuery = `
+query getGroup($urn: String!) {
+  corpGroup(urn: $urn) {
+    urn
+    name
+    properties {
+      displayName
+      description
+      email
+      slack
+    }
+    editableProperties {
+      description
+      email
+      slack
+    }
+    ownership {
+      owners {
+        owner {
+          ... on CorpUser {
+            urn
+            username
+            info {
+              displayName
+              email
+            }
+          }
+          ... on CorpGroup {
+            urn
+            name
+          }
+        }
+        type
+      }
+    }
+    members: relationships(input: {types: ["IsMemberOfGroup", "IsMemberOfNativeGroup"], direction: INCOMING, start: 0, count: 100}) {
+      total
+      relationships {
+        entity {
+          ... on CorpUser {
+            ...userProfile
+          }
+        }
+      }
+    }
+  }
+}
+` + userProfileFragment
+
+	// userProfileFragment holds the user contact details shared by GetUserQuery and GetGroupQuery.
+	userProfileFragment = `
+fragment userProfile on CorpUser {
+  urn
+  username
+  properties {
+    active
+    displayName
+    fullName
+    email
+    title
+  }
+  editableProperties {
+    displayName
+    email
+    title
+    slack
+  }
+}
+`
+
+	// ListRootGlossaryQuery lists the glossary nodes and terms at the root of the glossary.
+	ListRootGlossaryQuery = `
+query listRootGlossary($nodeStart: Int!, $nodeCount: Int!, $termStart: Int!, $termCount: Int!) {
+  getRootGlossaryNodes(input: {start: $nodeStart, count: $nodeCount}) {
+    total
+    nodes {
+      ...glossaryNodeEntry
+    }
+  }
+  getRootGlossaryTerms(input: {start: $termStart, count: $termCount}) {
+    total
+    terms {
+      ...glossaryTermEntry
+    }
+  }
+}
+` + glossaryEntryFragments
+
+	// ListGlossaryNodeQuery lists the glossary nodes and terms directly under a glossary node.
+	ListGlossaryNodeQuery = `
+query listGlossaryNode($urn: String!, $start: Int!, $count: Int!) {
+  glossaryNode(urn: $urn) {
+    urn
+    properties {
+      name
+    }
+    parentNodes {
+      nodes {
+        urn
+        properties {
+          name
+        }
+      }
+    }
+    children: relationships(input: {types: ["IsPartOf"], direction: INCOMING, start: $start, count: $count}) {
+      total
+      relationships {
+        entity {
+          ... on GlossaryNode {
+            ...glossaryNodeEntry
+          }
+          ... on GlossaryTerm {
+            ...glossaryTermEntry
+          }
+        }
+      }
+    }
+  }
+}
+` + glossaryEntryFragments
+
+	// glossaryEntryFragments hold the node and term fields shared by the glossary listings.
+	glossaryEntryFragments = `
+fragment glossaryNodeEntry on GlossaryNode {
+  urn
+  type
+  properties {
+    name
+    description
+  }
+  childrenCount {
+    nodesCount
+    termsCount
+  }
+}
+
+fragment glossaryTermEntry on GlossaryTerm {
+  urn
+  type
+  name
+  properties {
+    name
+    description
+  }
+}
+`
+
+	// GetLineageHopQuery retrieves the direct lineage relationships of several entities,
+	// with edge metadata. It is used to rebuild multi-hop edges when
+	// searchAcrossLineage returns no paths.
+	GetLineageHopQuery = `
+query getLineageHop(
+  $urns: [String!]!
+  $direction: LineageDirection!
+  $count: Int
+  $startTimeMillis: Long
+  $endTimeMillis: Long
+) {
+  entities(urns: $urns) {
+    urn
+    ... on EntityWithRelationships {
+      lineage(
+        input: {
+          direction: $direction
+          start: 0
+          count: $count
+          startTimeMillis: $startTimeMillis
+          endTimeMillis: $endTimeMillis
+        }
+      ) {
+        relationships {
+          type
+          createdOn
+          createdActor {
+            urn
+          }
+          updatedOn
+          updatedActor {
+            urn
+          }
+          isManual
+          entity {
+            urn
+            type
+          }
+        }
+      }
+    }
+  }
+}
+`
+
+	// GetQueryStatementsQuery retrieves the SQL statements of several Query entities.
+	GetQueryStatementsQuery = `
+query getQueryStatements($urns: [String!]!) {
+  entities(urns: $urns) {
+    urn
+    ... on QueryEntity {
+      properties {
+        statement {
+          value
+        }
+      }
+    }
+  }
+}
 `
 )
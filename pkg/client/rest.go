@@ -52,31 +52,16 @@ func (c *Client) getAspect(ctx context.Context, entityURN, aspectName string) (j
 		"aspect", aspectName,
 		"url", url)
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	c.setRESTHeaders(req)
-
-	resp, err := c.httpClient.Do(req) //#nosec G704 -- URL is constructed from configured endpoint, not arbitrary user input
+	statusCode, body, err := c.doREST(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("REST GET failed: %w", err)
 	}
-	defer func() {
-		_ = resp.Body.Close()
-	}()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
-	}
 
 	c.logger.Debug("REST GET response",
-		"status", resp.StatusCode,
+		"status", statusCode,
 		"response_size", len(body))
 
-	if err := c.checkRESTStatus(resp.StatusCode, body); err != nil {
+	if err := c.checkRESTStatus(statusCode, body); err != nil {
 		return nil, err
 	}
 
@@ -125,17 +110,49 @@ func (c *Client) postIngestProposal(ctx context.Context, proposal ingestProposal
 		"entity_type", proposal.EntityType,
 		"url", url)
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(jsonBody))
+	statusCode, body, err := c.doREST(ctx, http.MethodPost, url, jsonBody)
+	if err != nil {
+		return fmt.Errorf("REST POST failed: %w", err)
+	}
+
+	c.logger.Debug("REST POST response",
+		"status", statusCode,
+		"response_size", len(body))
+
+	return c.checkRESTStatus(statusCode, body)
+}
+
+// doREST sends a REST API request and returns the status code and response body.
+// On 401 the token is refreshed once and the request retried.
+func (c *Client) doREST(ctx context.Context, method, url string, jsonBody []byte) (int, []byte, error) {
+	statusCode, body, err := c.sendREST(ctx, method, url, jsonBody)
+	if err == nil && statusCode == http.StatusUnauthorized && c.refreshToken(ctx) {
+		return c.sendREST(ctx, method, url, jsonBody)
+	}
+	return statusCode, body, err
+}
+
+// sendREST performs a single REST API request.
+func (c *Client) sendREST(ctx context.Context, method, url string, jsonBody []byte) (int, []byte, error) {
+	var reqBody io.Reader
+	if jsonBody != nil {
+		reqBody = bytes.NewReader(jsonBody)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return 0, nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	c.setRESTHeaders(req)
-	req.Header.Set("Content-Type", "application/json")
+	if err := c.setRESTHeaders(ctx, req); err != nil {
+		return 0, nil, err
+	}
+	if jsonBody != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
 
 	resp, err := c.httpClient.Do(req) //#nosec G704 -- URL is constructed from configured endpoint, not arbitrary user input
 	if err != nil {
-		return fmt.Errorf("REST POST failed: %w", err)
+		return 0, nil, err
 	}
 	defer func() {
 		_ = resp.Body.Close()
@@ -143,20 +160,20 @@ func (c *Client) postIngestProposal(ctx context.Context, proposal ingestProposal
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("failed to read response: %w", err)
+		return 0, nil, fmt.Errorf("failed to read response: %w", err)
 	}
-
-	c.logger.Debug("REST POST response",
-		"status", resp.StatusCode,
-		"response_size", len(body))
-
-	return c.checkRESTStatus(resp.StatusCode, body)
+	return resp.StatusCode, body, nil
 }
 
 // setRESTHeaders sets common headers for REST API requests.
-func (c *Client) setRESTHeaders(req *http.Request) {
-	req.Header.Set("Authorization", "Bearer "+c.token)
+func (c *Client) setRESTHeaders(ctx context.Context, req *http.Request) error {
+	token, err := c.currentToken(ctx)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
 	req.Header.Set("X-RestLi-Protocol-Version", "2.0.0")
+	return nil
 }
 
 // isNullOrEmptyJSON returns true if the raw JSON message is nil, empty,
@@ -0,0 +1,270 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// TokenProvider supplies the bearer token used to authenticate with DataHub.
+// Implementations must be safe for concurrent use.
+type TokenProvider interface {
+	// Token returns the token to use for the next request.
+	Token(ctx context.Context) (string, error)
+
+	// Refresh discards any cached token and loads a fresh one.
+	// The client calls Refresh once when DataHub rejects a request with 401
+	// before reporting ErrUnauthorized.
+	Refresh(ctx context.Context) (string, error)
+}
+
+// StaticTokenProvider always returns the same token.
+type StaticTokenProvider string
+
+// Token implements TokenProvider.
+func (p StaticTokenProvider) Token(_ context.Context) (string, error) {
+	return string(p), nil
+}
+
+// Refresh implements TokenProvider. A static token cannot change, so the same value is returned.
+func (p StaticTokenProvider) Refresh(ctx context.Context) (string, error) {
+	return p.Token(ctx)
+}
+
+// EnvTokenProvider reads the token from an environment variable on every call.
+type EnvTokenProvider struct {
+	// Var is the environment variable name.
+	Var string
+}
+
+// Token implements TokenProvider.
+func (p EnvTokenProvider) Token(_ context.Context) (string, error) {
+	token := strings.TrimSpace(os.Getenv(p.Var))
+	if token == "" {
+		return "", fmt.Errorf("environment variable %s is empty", p.Var)
+	}
+	return token, nil
+}
+
+// Refresh implements TokenProvider.
+func (p EnvTokenProvider) Refresh(ctx context.Context) (string, error) {
+	return p.Token(ctx)
+}
+
+// FileTokenProvider reads the token from a file and reloads it when the file changes.
+// This suits Kubernetes mounted secrets, which are replaced in place on rotation.
+type FileTokenProvider struct {
+	path string
+
+	mu      sync.Mutex
+	token   string
+	modTime time.Time
+	size    int64
+}
+
+// NewFileTokenProvider creates a FileTokenProvider for the given path.
+// The file is read lazily on first use.
+func NewFileTokenProvider(path string) *FileTokenProvider {
+	return &FileTokenProvider{path: path}
+}
+
+// Token implements TokenProvider. The file is re-read when its modification
+// time or size differs from the last read.
+func (p *FileTokenProvider) Token(_ context.Context) (string, error) {
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return "", fmt.Errorf("reading token file: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.token != "" && info.ModTime().Equal(p.modTime) && info.Size() == p.size {
+		return p.token, nil
+	}
+	return p.loadLocked(info)
+}
+
+// Refresh implements TokenProvider by unconditionally re-reading the file.
+func (p *FileTokenProvider) Refresh(_ context.Context) (string, error) {
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return "", fmt.Errorf("reading token file: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.loadLocked(info)
+}
+
+// loadLocked reads the token file. The caller must hold p.mu.
+func (p *FileTokenProvider) loadLocked(info os.FileInfo) (string, error) {
+	data, err := os.ReadFile(p.path) //#nosec G304 -- path is operator-provided configuration
+	if err != nil {
+		return "", fmt.Errorf("reading token file: %w", err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", p.path)
+	}
+	p.token = token
+	p.modTime = info.ModTime()
+	p.size = info.Size()
+	return token, nil
+}
+
+// CommandTokenProvider obtains the token by running an external command and
+// reading its standard output. The command is run directly, not through a shell.
+type CommandTokenProvider struct {
+	command []string
+	ttl     time.Duration
+
+	mu      sync.Mutex
+	token   string
+	fetched time.Time
+}
+
+// NewCommandTokenProvider creates a CommandTokenProvider.
+// The output is cached for ttl; a zero ttl caches until the next Refresh.
+func NewCommandTokenProvider(command []string, ttl time.Duration) *CommandTokenProvider {
+	return &CommandTokenProvider{command: command, ttl: ttl}
+}
+
+// Token implements TokenProvider.
+func (p *CommandTokenProvider) Token(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.token != "" && (p.ttl == 0 || time.Since(p.fetched) < p.ttl) {
+		return p.token, nil
+	}
+	return p.runLocked(ctx)
+}
+
+// Refresh implements TokenProvider by running the command again.
+func (p *CommandTokenProvider) Refresh(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.runLocked(ctx)
+}
+
+// runLocked runs the token command. The caller must hold p.mu.
+func (p *CommandTokenProvider) runLocked(ctx context.Context) (string, error) {
+	if len(p.command) == 0 {
+		return "", fmt.Errorf("token command is empty")
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, p.command[0], p.command[1:]...) //#nosec G204 -- command is operator-provided configuration
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("running token command %q: %w: %s",
+			p.command[0], err, truncateString(strings.TrimSpace(stderr.String()), 200))
+	}
+
+	token := strings.TrimSpace(string(out))
+	if token == "" {
+		return "", fmt.Errorf("token command %q produced no output", p.command[0])
+	}
+	p.token = token
+	p.fetched = time.Now()
+	return token, nil
+}
+
+// splitCommandLine splits a command line into arguments the way a POSIX shell
+// would, without expanding variables or globs. Single quotes keep their contents
+// literally; elsewhere a backslash escapes the next character. An unterminated
+// quote or a trailing backslash is an error.
+func splitCommandLine(line string) ([]string, error) {
+	var (
+		args    []string
+		current strings.Builder
+		inArg   bool
+		escaped bool
+		quote   rune
+	)
+	for _, r := range line {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\\':
+			escaped, inArg = true, true
+		case r == quote:
+			quote = 0
+		case quote != 0:
+			current.WriteRune(r)
+		case r == '\'' || r == '"':
+			quote, inArg = r, true
+		case unicode.IsSpace(r):
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quote != 0 || escaped {
+		return nil, fmt.Errorf("unterminated quote or escape in %q", line)
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args, nil
+}
+
+// Token reference prefixes for indirection in configuration values.
+const (
+	tokenRefEnvPrefix  = "${env:"
+	tokenRefFilePrefix = "${file:"
+	tokenRefSuffix     = "}"
+)
+
+// IsTokenRef returns true if s is a token reference of the form
+// ${env:VAR} or ${file:/path}.
+func IsTokenRef(s string) bool {
+	return strings.HasSuffix(s, tokenRefSuffix) &&
+		(strings.HasPrefix(s, tokenRefEnvPrefix) || strings.HasPrefix(s, tokenRefFilePrefix))
+}
+
+// TokenProviderFromRef creates a TokenProvider from a token reference:
+//
+//	${env:VAR}    reads environment variable VAR on every request
+//	${file:/path} reads /path, reloading it when the file changes
+//
+// Any other value is treated as a literal token.
+func TokenProviderFromRef(ref string) (TokenProvider, error) {
+	if !IsTokenRef(ref) {
+		return StaticTokenProvider(ref), nil
+	}
+
+	if strings.HasPrefix(ref, tokenRefEnvPrefix) {
+		name := strings.TrimSuffix(strings.TrimPrefix(ref, tokenRefEnvPrefix), tokenRefSuffix)
+		if name == "" {
+			return nil, fmt.Errorf("invalid token reference %q: missing variable name", ref)
+		}
+		return EnvTokenProvider{Var: name}, nil
+	}
+
+	path := strings.TrimSuffix(strings.TrimPrefix(ref, tokenRefFilePrefix), tokenRefSuffix)
+	if path == "" {
+		return nil, fmt.Errorf("invalid token reference %q: missing file path", ref)
+	}
+	return NewFileTokenProvider(path), nil
+}
@@ -0,0 +1,343 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestStaticTokenProvider(t *testing.T) {
+	p := StaticTokenProvider("abc")
+
+	token, err := p.Token(context.Background())
+	if err != nil || token != "abc" {
+		t.Errorf("Token() = %q, %v; want %q, nil", token, err, "abc")
+	}
+	token, err = p.Refresh(context.Background())
+	if err != nil || token != "abc" {
+		t.Errorf("Refresh() = %q, %v; want %q, nil", token, err, "abc")
+	}
+}
+
+func TestEnvTokenProvider(t *testing.T) {
+	t.Setenv("TEST_DATAHUB_SECRET", " env-token\n")
+	p := EnvTokenProvider{Var: "TEST_DATAHUB_SECRET"}
+
+	token, err := p.Token(context.Background())
+	if err != nil || token != "env-token" {
+		t.Errorf("Token() = %q, %v; want %q, nil", token, err, "env-token")
+	}
+
+	t.Setenv("TEST_DATAHUB_SECRET", "")
+	if _, err := p.Token(context.Background()); err == nil {
+		t.Error("Token() should fail for empty variable")
+	}
+}
+
+func TestFileTokenProvider_ReloadOnChange(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("first\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	p := NewFileTokenProvider(path)
+	token, err := p.Token(context.Background())
+	if err != nil || token != "first" {
+		t.Fatalf("Token() = %q, %v; want %q, nil", token, err, "first")
+	}
+
+	// Rotate the secret; bump mtime so the change is visible on coarse filesystems
+	if err := os.WriteFile(path, []byte("second-token"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatal(err)
+	}
+
+	token, err = p.Token(context.Background())
+	if err != nil || token != "second-token" {
+		t.Errorf("Token() after rotation = %q, %v; want %q, nil", token, err, "second-token")
+	}
+}
+
+func TestFileTokenProvider_Errors(t *testing.T) {
+	p := NewFileTokenProvider(filepath.Join(t.TempDir(), "missing"))
+	if _, err := p.Token(context.Background()); err == nil {
+		t.Error("Token() should fail for missing file")
+	}
+	if _, err := p.Refresh(context.Background()); err == nil {
+		t.Error("Refresh() should fail for missing file")
+	}
+
+	empty := filepath.Join(t.TempDir(), "empty")
+	if err := os.WriteFile(empty, []byte("  \n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewFileTokenProvider(empty).Token(context.Background()); err == nil {
+		t.Error("Token() should fail for empty file")
+	}
+}
+
+func TestCommandTokenProvider(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires a POSIX shell")
+	}
+
+	counter := filepath.Join(t.TempDir(), "count")
+	script := `echo x >> "$1"; echo "token-$(wc -l < "$1" | tr -d ' ')"`
+	p := NewCommandTokenProvider([]string{"sh", "-c", script, "sh", counter}, 0)
+
+	token, err := p.Token(context.Background())
+	if err != nil || token != "token-1" {
+		t.Fatalf("Token() = %q, %v; want %q, nil", token, err, "token-1")
+	}
+
+	// Cached until refresh
+	token, _ = p.Token(context.Background())
+	if token != "token-1" {
+		t.Errorf("Token() second call = %q, want cached %q", token, "token-1")
+	}
+
+	token, err = p.Refresh(context.Background())
+	if err != nil || token != "token-2" {
+		t.Errorf("Refresh() = %q, %v; want %q, nil", token, err, "token-2")
+	}
+}
+
+func TestCommandTokenProvider_Errors(t *testing.T) {
+	if _, err := NewCommandTokenProvider(nil, 0).Token(context.Background()); err == nil {
+		t.Error("Token() should fail for empty command")
+	}
+	if runtime.GOOS == "windows" {
+		return
+	}
+	if _, err := NewCommandTokenProvider([]string{"sh", "-c", "exit 3"}, 0).Token(context.Background()); err == nil {
+		t.Error("Token() should fail when the command fails")
+	}
+	if _, err := NewCommandTokenProvider([]string{"true"}, 0).Token(context.Background()); err == nil {
+		t.Error("Token() should fail when the command prints nothing")
+	}
+}
+
+func TestTokenProviderFromRef(t *testing.T) {
+	tests := []struct {
+		ref      string
+		wantType string
+		wantErr  bool
+	}{
+		{"plain-token", "static", false},
+		{"${env:MY_TOKEN}", "env", false},
+		{"${file:/run/secrets/datahub}", "file", false},
+		{"${env:}", "", true},
+		{"${file:}", "", true},
+		{"${MY_TOKEN}", "static", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			p, err := TokenProviderFromRef(tt.ref)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TokenProviderFromRef() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			var gotType string
+			switch p.(type) {
+			case StaticTokenProvider:
+				gotType = "static"
+			case EnvTokenProvider:
+				gotType = "env"
+			case *FileTokenProvider:
+				gotType = "file"
+			}
+			if gotType != tt.wantType {
+				t.Errorf("TokenProviderFromRef(%q) type = %s, want %s", tt.ref, gotType, tt.wantType)
+			}
+		})
+	}
+}
+
+// rotatingTokenProvider returns a stale token until refreshed.
+type rotatingTokenProvider struct {
+	refreshed atomic.Bool
+}
+
+func (p *rotatingTokenProvider) Token(_ context.Context) (string, error) {
+	if p.refreshed.Load() {
+		return "fresh", nil
+	}
+	return "stale", nil
+}
+
+func (p *rotatingTokenProvider) Refresh(ctx context.Context) (string, error) {
+	p.refreshed.Store(true)
+	return p.Token(ctx)
+}
+
+func newTokenCheckingServer(t *testing.T, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.Header.Get("Authorization") != "Bearer fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(`{"value": {"tags": []}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": {"__typename": "Query"}}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestClient_RefreshesTokenOnUnauthorized(t *testing.T) {
+	var requests atomic.Int32
+	server := newTokenCheckingServer(t, &requests)
+
+	c, err := New(Config{URL: server.URL, TokenProvider: &rotatingTokenProvider{}})
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	if err := c.Ping(context.Background()); err != nil {
+		t.Fatalf("Ping() unexpected error: %v", err)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("expected 2 requests (401 then retry), got %d", got)
+	}
+}
+
+func TestClient_RESTRefreshesTokenOnUnauthorized(t *testing.T) {
+	var requests atomic.Int32
+	server := newTokenCheckingServer(t, &requests)
+
+	c, err := New(Config{URL: server.URL, TokenProvider: &rotatingTokenProvider{}})
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	if _, err := c.getAspect(context.Background(), "urn:li:dataset:x", "globalTags"); err != nil {
+		t.Fatalf("getAspect() unexpected error: %v", err)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("expected 2 requests (401 then retry), got %d", got)
+	}
+}
+
+func TestClient_StaticTokenUnauthorizedNotRetried(t *testing.T) {
+	var requests atomic.Int32
+	server := newTokenCheckingServer(t, &requests)
+
+	c, err := New(Config{URL: server.URL, Token: "stale"})
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	if err := c.Ping(context.Background()); !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("Ping() error = %v, want ErrUnauthorized", err)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("expected a single request, got %d", got)
+	}
+}
+
+func TestClient_TokenRef(t *testing.T) {
+	var requests atomic.Int32
+	server := newTokenCheckingServer(t, &requests)
+
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("fresh"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := New(Config{URL: server.URL, Token: "${file:" + path + "}"})
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+	if err := c.Ping(context.Background()); err != nil {
+		t.Errorf("Ping() unexpected error: %v", err)
+	}
+
+	if _, err := New(Config{URL: server.URL, Token: "${env:}"}); err == nil {
+		t.Error("New() should reject an invalid token reference")
+	}
+}
+
+func TestSplitCommandLine(t *testing.T) {
+	tests := []struct {
+		line    string
+		want    []string
+		wantErr bool
+	}{
+		{"vault read -field=token secret/datahub", []string{"vault", "read", "-field=token", "secret/datahub"}, false},
+		{`vault read -field="token" "secret/data hub"`, []string{"vault", "read", "-field=token", "secret/data hub"}, false},
+		{`  print-token 'it''s' "a \"quoted\" word" a\ b `, []string{"print-token", "its", `a "quoted" word`, "a b"}, false},
+		{`cmd ""`, []string{"cmd", ""}, false},
+		{`cmd 'single \ kept'`, []string{"cmd", `single \ kept`}, false},
+		{`cmd "unterminated`, nil, true},
+		{`cmd trailing\`, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			got, err := splitCommandLine(tt.line)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("splitCommandLine() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("splitCommandLine() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromEnv_TokenProviders(t *testing.T) {
+	t.Setenv("DATAHUB_TOKEN", "")
+	t.Setenv("DATAHUB_TOKEN_COMMAND", "")
+	t.Setenv("DATAHUB_TOKEN_FILE", "/run/secrets/datahub")
+
+	cfg, err := FromEnv()
+	if err != nil {
+		t.Fatalf("FromEnv() unexpected error: %v", err)
+	}
+	if _, ok := cfg.TokenProvider.(*FileTokenProvider); !ok {
+		t.Errorf("TokenProvider = %T, want *FileTokenProvider", cfg.TokenProvider)
+	}
+
+	t.Setenv("DATAHUB_TOKEN_COMMAND", `vault read -field="token" "secret/data hub"`)
+	cfg, err = FromEnv()
+	if err != nil {
+		t.Fatalf("FromEnv() unexpected error: %v", err)
+	}
+	cmd, ok := cfg.TokenProvider.(*CommandTokenProvider)
+	if !ok {
+		t.Fatalf("TokenProvider = %T, want *CommandTokenProvider", cfg.TokenProvider)
+	}
+	if len(cmd.command) != 4 || cmd.command[0] != "vault" || cmd.command[3] != "secret/data hub" {
+		t.Errorf("command = %q, want vault with 3 args", cmd.command)
+	}
+
+	t.Setenv("DATAHUB_TOKEN_COMMAND", `vault read "secret`)
+	if _, err := FromEnv(); err == nil || !strings.Contains(err.Error(), "DATAHUB_TOKEN_COMMAND") {
+		t.Errorf("FromEnv() error = %v, want invalid DATAHUB_TOKEN_COMMAND", err)
+	}
+
+	cfg.URL = "https://datahub.example.com"
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("Validate() should accept a TokenProvider without Token: %v", err)
+	}
+}
@@ -17,6 +17,15 @@ func BuildDatasetURN(platform, qualifiedName, env string) string {
 	return fmt.Sprintf("urn:li:dataset:(urn:li:dataPlatform:%s,%s,%s)", platform, encodedName, env)
 }
 
+// BuildPlatformURN constructs a data platform URN. A value that is already a
+// platform URN is returned unchanged.
+func BuildPlatformURN(platform string) string {
+	if strings.HasPrefix(platform, "urn:li:dataPlatform:") {
+		return platform
+	}
+	return "urn:li:dataPlatform:" + platform
+}
+
 // BuildDashboardURN constructs a dashboard URN.
 func BuildDashboardURN(platform, dashboardID string) string {
 	return fmt.Sprintf("urn:li:dashboard:(%s,%s)", platform, dashboardID)
@@ -37,6 +46,11 @@ func BuildDataJobURN(dataFlowURN, jobID string) string {
 	return fmt.Sprintf("urn:li:dataJob:(%s,%s)", dataFlowURN, jobID)
 }
 
+// BuildSchemaFieldURN constructs the URN of a dataset column.
+func BuildSchemaFieldURN(datasetURN, fieldPath string) string {
+	return fmt.Sprintf("urn:li:schemaField:(%s,%s)", datasetURN, fieldPath)
+}
+
 // BuildGlossaryTermURN constructs a glossary term URN.
 func BuildGlossaryTermURN(termPath string) string {
 	return fmt.Sprintf("urn:li:glossaryTerm:%s", termPath)
@@ -52,6 +66,16 @@ func BuildDomainURN(domainID string) string {
 	return fmt.Sprintf("urn:li:domain:%s", domainID)
 }
 
+// BuildUserURN constructs a user (corpuser) URN.
+func BuildUserURN(username string) string {
+	return fmt.Sprintf("urn:li:corpuser:%s", username)
+}
+
+// BuildGroupURN constructs a group (corpGroup) URN.
+func BuildGroupURN(groupName string) string {
+	return fmt.Sprintf("urn:li:corpGroup:%s", groupName)
+}
+
 // ParseURN parses a DataHub URN into its components.
 func ParseURN(urn string) (*types.ParsedURN, error) {
 	if !strings.HasPrefix(urn, "urn:li:") {
@@ -108,6 +108,14 @@ func TestBuildDataJobURN(t *testing.T) {
 	}
 }
 
+func TestBuildSchemaFieldURN(t *testing.T) {
+	got := BuildSchemaFieldURN("urn:li:dataset:(urn:li:dataPlatform:hive,db.orders,PROD)", "amount")
+	want := "urn:li:schemaField:(urn:li:dataset:(urn:li:dataPlatform:hive,db.orders,PROD),amount)"
+	if got != want {
+		t.Errorf("BuildSchemaFieldURN() = %v, want %v", got, want)
+	}
+}
+
 func TestBuildGlossaryTermURN(t *testing.T) {
 	got := BuildGlossaryTermURN("business.revenue")
 	want := "urn:li:glossaryTerm:business.revenue"
@@ -132,6 +140,31 @@ func TestBuildDomainURN(t *testing.T) {
 	}
 }
 
+func TestBuildUserURN(t *testing.T) {
+	got := BuildUserURN("jdoe")
+	want := "urn:li:corpuser:jdoe"
+	if got != want {
+		t.Errorf("BuildUserURN() = %v, want %v", got, want)
+	}
+}
+
+func TestBuildGroupURN(t *testing.T) {
+	got := BuildGroupURN("data-eng")
+	want := "urn:li:corpGroup:data-eng"
+	if got != want {
+		t.Errorf("BuildGroupURN() = %v, want %v", got, want)
+	}
+}
+
+func TestBuildPlatformURN(t *testing.T) {
+	if got := BuildPlatformURN("snowflake"); got != "urn:li:dataPlatform:snowflake" {
+		t.Errorf("BuildPlatformURN(name) = %v", got)
+	}
+	if got := BuildPlatformURN("urn:li:dataPlatform:looker"); got != "urn:li:dataPlatform:looker" {
+		t.Errorf("BuildPlatformURN(urn) = %v", got)
+	}
+}
+
 func TestParseURN(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+// defaultTopUsers is the number of users returned when no limit is given.
+const defaultTopUsers = 10
+
+// GetUsageStats retrieves aggregated usage of a dataset: total queries, unique users,
+// top users, per-column usage and a time series of usage buckets.
+// The window defaults to the last month.
+func (c *Client) GetUsageStats(ctx context.Context, urn string, opts ...UsageOption) (*types.UsageStats, error) {
+	options := &usageOptions{timeRange: UsageRangeMonth, topUsers: defaultTopUsers}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.timeRange == "" {
+		options.timeRange = UsageRangeMonth
+	}
+	if options.topUsers <= 0 {
+		options.topUsers = defaultTopUsers
+	}
+
+	variables := map[string]any{
+		"urn":   urn,
+		"range": options.timeRange,
+	}
+
+	var response struct {
+		Dataset struct {
+			URN        string `json:"urn"`
+			UsageStats struct {
+				Aggregations struct {
+					UniqueUserCount int `json:"uniqueUserCount"`
+					TotalSQLQueries int `json:"totalSqlQueries"`
+					Users           []struct {
+						User struct {
+							URN      string `json:"urn"`
+							Username string `json:"username"`
+						} `json:"user"`
+						Count     int    `json:"count"`
+						UserEmail string `json:"userEmail"`
+					} `json:"users"`
+					Fields []struct {
+						FieldName string `json:"fieldName"`
+						Count     int    `json:"count"`
+					} `json:"fields"`
+				} `json:"aggregations"`
+				Buckets []struct {
+					Bucket   int64  `json:"bucket"`
+					Duration string `json:"duration"`
+					Metrics  struct {
+						UniqueUserCount int `json:"uniqueUserCount"`
+						TotalSQLQueries int `json:"totalSqlQueries"`
+					} `json:"metrics"`
+				} `json:"buckets"`
+			} `json:"usageStats"`
+		} `json:"dataset"`
+	}
+
+	if err := c.Execute(ctx, GetUsageStatsQuery, variables, &response); err != nil {
+		return nil, fmt.Errorf("GetUsageStats(%s): %w", urn, err)
+	}
+	if response.Dataset.URN == "" {
+		return nil, fmt.Errorf("GetUsageStats(%s): %w", urn, ErrNotFound)
+	}
+
+	agg := response.Dataset.UsageStats.Aggregations
+	result := &types.UsageStats{
+		URN:          urn,
+		Range:        options.timeRange,
+		TotalQueries: agg.TotalSQLQueries,
+		UniqueUsers:  agg.UniqueUserCount,
+	}
+
+	for _, u := range agg.Users {
+		result.TopUsers = append(result.TopUsers, types.UserUsage{
+			User:     u.User.URN,
+			Username: u.User.Username,
+			Email:    u.UserEmail,
+			Count:    u.Count,
+		})
+	}
+	sort.SliceStable(result.TopUsers, func(i, j int) bool {
+		return result.TopUsers[i].Count > result.TopUsers[j].Count
+	})
+	if len(result.TopUsers) > options.topUsers {
+		result.TopUsers = result.TopUsers[:options.topUsers]
+	}
+
+	for _, f := range agg.Fields {
+		result.Fields = append(result.Fields, types.FieldUsage{Field: f.FieldName, Count: f.Count})
+	}
+	sort.SliceStable(result.Fields, func(i, j int) bool {
+		return result.Fields[i].Count > result.Fields[j].Count
+	})
+
+	for _, b := range response.Dataset.UsageStats.Buckets {
+		result.Buckets = append(result.Buckets, types.UsageBucket{
+			Timestamp:    b.Bucket,
+			Duration:     b.Duration,
+			TotalQueries: b.Metrics.TotalSQLQueries,
+			UniqueUsers:  b.Metrics.UniqueUserCount,
+		})
+	}
+	sort.Slice(result.Buckets, func(i, j int) bool {
+		return result.Buckets[i].Timestamp < result.Buckets[j].Timestamp
+	})
+
+	return result, nil
+}
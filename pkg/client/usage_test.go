@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestClientGetUsageStats(t *testing.T) {
+	var vars map[string]any
+	c := newGraphQLTestClient(t, map[string]any{
+		"dataset": map[string]any{
+			"urn": "urn:li:dataset:orders",
+			"usageStats": map[string]any{
+				"aggregations": map[string]any{
+					"uniqueUserCount": 3,
+					"totalSqlQueries": 60,
+					"users": []map[string]any{
+						{"user": map[string]any{"urn": "urn:li:corpuser:bob", "username": "bob"}, "count": 10},
+						{"user": map[string]any{"urn": "urn:li:corpuser:ann", "username": "ann"}, "count": 45, "userEmail": "ann@example.com"},
+						{"user": map[string]any{"urn": "urn:li:corpuser:cid", "username": "cid"}, "count": 5},
+					},
+					"fields": []map[string]any{
+						{"fieldName": "status", "count": 4},
+						{"fieldName": "order_id", "count": 58},
+					},
+				},
+				"buckets": []map[string]any{
+					{"bucket": 2000, "duration": "DAY", "metrics": map[string]any{"totalSqlQueries": 40, "uniqueUserCount": 2}},
+					{"bucket": 1000, "duration": "DAY", "metrics": map[string]any{"totalSqlQueries": 20, "uniqueUserCount": 3}},
+				},
+			},
+		},
+	}, &vars)
+
+	stats, err := c.GetUsageStats(context.Background(), "urn:li:dataset:orders",
+		WithUsageRange("week"), WithTopUsers(2))
+	if err != nil {
+		t.Fatalf("GetUsageStats() unexpected error: %v", err)
+	}
+
+	if vars["range"] != UsageRangeWeek {
+		t.Errorf("range = %v, want %s", vars["range"], UsageRangeWeek)
+	}
+	if stats.Range != UsageRangeWeek || stats.TotalQueries != 60 || stats.UniqueUsers != 3 {
+		t.Errorf("unexpected aggregates: %+v", stats)
+	}
+	if len(stats.TopUsers) != 2 || stats.TopUsers[0].Username != "ann" || stats.TopUsers[1].Username != "bob" {
+		t.Errorf("TopUsers = %+v, want ann then bob", stats.TopUsers)
+	}
+	if stats.TopUsers[0].Email != "ann@example.com" {
+		t.Errorf("Email = %q, want ann@example.com", stats.TopUsers[0].Email)
+	}
+	if len(stats.Fields) != 2 || stats.Fields[0].Field != "order_id" {
+		t.Errorf("Fields = %+v, want order_id first", stats.Fields)
+	}
+	if len(stats.Buckets) != 2 || stats.Buckets[0].Timestamp != 1000 || stats.Buckets[1].TotalQueries != 40 {
+		t.Errorf("Buckets = %+v, want chronological order", stats.Buckets)
+	}
+}
+
+func TestClientGetUsageStatsDefaults(t *testing.T) {
+	var vars map[string]any
+	c := newGraphQLTestClient(t, map[string]any{
+		"dataset": map[string]any{"urn": "urn:li:dataset:orders", "usageStats": map[string]any{}},
+	}, &vars)
+
+	stats, err := c.GetUsageStats(context.Background(), "urn:li:dataset:orders", WithUsageRange(""))
+	if err != nil {
+		t.Fatalf("GetUsageStats() unexpected error: %v", err)
+	}
+	if vars["range"] != UsageRangeMonth || stats.Range != UsageRangeMonth {
+		t.Errorf("range = %v, want default %s", vars["range"], UsageRangeMonth)
+	}
+	if stats.TotalQueries != 0 || len(stats.TopUsers) != 0 {
+		t.Errorf("expected empty usage, got %+v", stats)
+	}
+}
+
+func TestClientGetUsageStatsNotFound(t *testing.T) {
+	c := newGraphQLTestClient(t, map[string]any{"dataset": map[string]any{"urn": ""}}, nil)
+
+	_, err := c.GetUsageStats(context.Background(), "urn:li:dataset:missing")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetUsageStats() error = %v, want ErrNotFound", err)
+	}
+}
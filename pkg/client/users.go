@@ -0,0 +1,168 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+// userRaw mirrors the userProfile fragment plus the extra user fields in GetUserQuery.
+type userRaw struct {
+	URN        string `json:"urn"`
+	Username   string `json:"username"`
+	Properties *struct {
+		DisplayName    string `json:"displayName"`
+		FullName       string `json:"fullName"`
+		Email          string `json:"email"`
+		Title          string `json:"title"`
+		Active         bool   `json:"active"`
+		DepartmentName string `json:"departmentName"`
+		Manager        *struct {
+			URN string `json:"urn"`
+		} `json:"manager"`
+	} `json:"properties"`
+	EditableProperties *struct {
+		DisplayName string   `json:"displayName"`
+		Email       string   `json:"email"`
+		Title       string   `json:"title"`
+		Slack       string   `json:"slack"`
+		Teams       []string `json:"teams"`
+		Phone       string   `json:"phone"`
+	} `json:"editableProperties"`
+	Groups *relatedEntitiesRaw `json:"groups"`
+}
+
+// toUser converts the raw user. Editable properties take precedence, since they hold
+// the user's own edits in the DataHub UI.
+func (r *userRaw) toUser() types.User {
+	user := types.User{
+		URN:      r.URN,
+		Username: r.Username,
+		Groups:   r.Groups.toRefs(),
+	}
+	if p := r.Properties; p != nil {
+		user.DisplayName = firstNonEmpty(p.DisplayName, p.FullName)
+		user.Email = p.Email
+		user.Title = p.Title
+		user.Active = p.Active
+		user.Department = p.DepartmentName
+		if p.Manager != nil {
+			user.Manager = p.Manager.URN
+		}
+	}
+	if e := r.EditableProperties; e != nil {
+		user.DisplayName = firstNonEmpty(e.DisplayName, user.DisplayName)
+		user.Email = firstNonEmpty(e.Email, user.Email)
+		user.Title = firstNonEmpty(e.Title, user.Title)
+		user.Slack = e.Slack
+		user.Teams = e.Teams
+		user.Phone = e.Phone
+	}
+	if user.DisplayName == "" {
+		user.DisplayName = r.Username
+	}
+	return user
+}
+
+// GetUser retrieves a user's profile, contact details and group memberships.
+func (c *Client) GetUser(ctx context.Context, urn string) (*types.User, error) {
+	variables := map[string]any{"urn": urn}
+
+	var response struct {
+		CorpUser userRaw `json:"corpUser"`
+	}
+
+	if err := c.Execute(ctx, GetUserQuery, variables, &response); err != nil {
+		return nil, fmt.Errorf("GetUser(%s): %w", urn, err)
+	}
+	if response.CorpUser.URN == "" {
+		return nil, fmt.Errorf("GetUser(%s): %w", urn, ErrNotFound)
+	}
+
+	user := response.CorpUser.toUser()
+	return &user, nil
+}
+
+// GetGroup retrieves a group with its contact details, owners and members.
+// At most 100 members are returned; TotalMembers reports the full count.
+func (c *Client) GetGroup(ctx context.Context, urn string) (*types.Group, error) {
+	variables := map[string]any{"urn": urn}
+
+	var response struct {
+		CorpGroup struct {
+			URN        string `json:"urn"`
+			Name       string `json:"name"`
+			Properties *struct {
+				DisplayName string `json:"displayName"`
+				Description string `json:"description"`
+				Email       string `json:"email"`
+				Slack       string `json:"slack"`
+			} `json:"properties"`
+			EditableProperties *struct {
+				Description string `json:"description"`
+				Email       string `json:"email"`
+				Slack       string `json:"slack"`
+			} `json:"editableProperties"`
+			Ownership *ownershipRaw `json:"ownership"`
+			Members   *struct {
+				Total         int `json:"total"`
+				Relationships []struct {
+					Entity *userRaw `json:"entity"`
+				} `json:"relationships"`
+			} `json:"members"`
+		} `json:"corpGroup"`
+	}
+
+	if err := c.Execute(ctx, GetGroupQuery, variables, &response); err != nil {
+		return nil, fmt.Errorf("GetGroup(%s): %w", urn, err)
+	}
+
+	raw := response.CorpGroup
+	if raw.URN == "" {
+		return nil, fmt.Errorf("GetGroup(%s): %w", urn, ErrNotFound)
+	}
+
+	group := &types.Group{
+		URN:    raw.URN,
+		Name:   raw.Name,
+		Owners: raw.Ownership.toOwners(),
+	}
+	if p := raw.Properties; p != nil {
+		group.DisplayName = p.DisplayName
+		group.Description = p.Description
+		group.Email = p.Email
+		group.Slack = p.Slack
+	}
+	if e := raw.EditableProperties; e != nil {
+		group.Description = firstNonEmpty(e.Description, group.Description)
+		group.Email = firstNonEmpty(e.Email, group.Email)
+		group.Slack = firstNonEmpty(e.Slack, group.Slack)
+	}
+	if group.DisplayName == "" {
+		group.DisplayName = raw.Name
+	}
+	if raw.Members != nil {
+		group.TotalMembers = raw.Members.Total
+		for _, rel := range raw.Members.Relationships {
+			if rel.Entity != nil && rel.Entity.URN != "" {
+				group.Members = append(group.Members, rel.Entity.toUser())
+			}
+		}
+	}
+
+	return group, nil
+}
+
+// ListOwnedEntities lists the entities that a user or group directly owns. Entities
+// owned only through a group the user belongs to are not included.
+func (c *Client) ListOwnedEntities(ctx context.Context, ownerURN string, opts ...BrowseOption) (*types.BrowseResult, error) {
+	options := c.browseOptions(opts)
+	filters := []map[string]any{{"field": "owners", "values": []string{ownerURN}}}
+
+	result, err := c.listEntities(ctx, ownerURN, filters, options)
+	if err != nil {
+		return nil, fmt.Errorf("ListOwnedEntities(%s): %w", ownerURN, err)
+	}
+	return result, nil
+}
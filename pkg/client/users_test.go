@@ -0,0 +1,148 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestClientGetUser(t *testing.T) {
+	var vars map[string]any
+	c := newGraphQLTestClient(t, map[string]any{
+		"corpUser": map[string]any{
+			"urn":      "urn:li:corpuser:jdoe",
+			"username": "jdoe",
+			"properties": map[string]any{
+				"active":         true,
+				"fullName":       "Jane Doe",
+				"email":          "jane.doe@example.com",
+				"title":          "Engineer",
+				"departmentName": "Data Platform",
+				"manager":        map[string]any{"urn": "urn:li:corpuser:asmith"},
+			},
+			"editableProperties": map[string]any{
+				"email": "jdoe@example.com",
+				"title": "Senior Data Engineer",
+				"slack": "@jdoe",
+				"teams": []string{"ingestion"},
+			},
+			"groups": map[string]any{
+				"total": 1,
+				"relationships": []map[string]any{
+					{"entity": map[string]any{
+						"urn": "urn:li:corpGroup:data-eng", "type": "CORP_GROUP", "name": "data-eng",
+						"properties": map[string]any{"displayName": "Data Engineering"},
+					}},
+				},
+			},
+		},
+	}, &vars)
+
+	user, err := c.GetUser(context.Background(), "urn:li:corpuser:jdoe")
+	if err != nil {
+		t.Fatalf("GetUser() unexpected error: %v", err)
+	}
+	if vars["urn"] != "urn:li:corpuser:jdoe" {
+		t.Errorf("urn variable = %v", vars["urn"])
+	}
+	if user.DisplayName != "Jane Doe" || user.Email != "jdoe@example.com" || user.Title != "Senior Data Engineer" {
+		t.Errorf("unexpected profile: %+v", user)
+	}
+	if !user.Active || user.Slack != "@jdoe" || user.Department != "Data Platform" || user.Manager != "urn:li:corpuser:asmith" {
+		t.Errorf("unexpected details: %+v", user)
+	}
+	if len(user.Teams) != 1 || len(user.Groups) != 1 || user.Groups[0].Name != "data-eng" {
+		t.Errorf("Teams = %v, Groups = %+v", user.Teams, user.Groups)
+	}
+}
+
+func TestClientGetUserFallsBackToUsername(t *testing.T) {
+	c := newGraphQLTestClient(t, map[string]any{
+		"corpUser": map[string]any{"urn": "urn:li:corpuser:svc", "username": "svc"},
+	}, nil)
+
+	user, err := c.GetUser(context.Background(), "urn:li:corpuser:svc")
+	if err != nil {
+		t.Fatalf("GetUser() unexpected error: %v", err)
+	}
+	if user.DisplayName != "svc" {
+		t.Errorf("DisplayName = %q, want username", user.DisplayName)
+	}
+}
+
+func TestClientGetGroup(t *testing.T) {
+	c := newGraphQLTestClient(t, map[string]any{
+		"corpGroup": map[string]any{
+			"urn":                "urn:li:corpGroup:data-eng",
+			"name":               "data-eng",
+			"properties":         map[string]any{"description": "Builds pipelines", "email": "data-eng@example.com"},
+			"editableProperties": map[string]any{"slack": "#data-eng"},
+			"ownership": map[string]any{
+				"owners": []map[string]any{{"owner": map[string]any{"urn": "urn:li:corpuser:asmith", "username": "asmith"}, "type": "TECHNICAL_OWNER"}},
+			},
+			"members": map[string]any{
+				"total": 150,
+				"relationships": []map[string]any{
+					{"entity": map[string]any{
+						"urn": "urn:li:corpuser:jdoe", "username": "jdoe",
+						"properties": map[string]any{"displayName": "Jane Doe", "active": true},
+					}},
+					{"entity": map[string]any{}},
+				},
+			},
+		},
+	}, nil)
+
+	group, err := c.GetGroup(context.Background(), "urn:li:corpGroup:data-eng")
+	if err != nil {
+		t.Fatalf("GetGroup() unexpected error: %v", err)
+	}
+	if group.DisplayName != "data-eng" || group.Email != "data-eng@example.com" || group.Slack != "#data-eng" {
+		t.Errorf("unexpected group: %+v", group)
+	}
+	if len(group.Owners) != 1 || group.Owners[0].Name != "asmith" {
+		t.Errorf("Owners = %+v", group.Owners)
+	}
+	if group.TotalMembers != 150 || len(group.Members) != 1 || group.Members[0].DisplayName != "Jane Doe" || !group.Members[0].Active {
+		t.Errorf("Members = %+v (total %d)", group.Members, group.TotalMembers)
+	}
+}
+
+func TestClientListOwnedEntities(t *testing.T) {
+	var vars map[string]any
+	c := newGraphQLTestClient(t, map[string]any{
+		"searchAcrossEntities": map[string]any{
+			"start": 0,
+			"total": 1,
+			"searchResults": []map[string]any{
+				{"entity": map[string]any{"urn": ordersURN, "type": "DATASET", "properties": map[string]any{"name": "orders"}}},
+			},
+		},
+	}, &vars)
+
+	result, err := c.ListOwnedEntities(context.Background(), "urn:li:corpuser:jdoe", WithBrowseLimit(5))
+	if err != nil {
+		t.Fatalf("ListOwnedEntities() unexpected error: %v", err)
+	}
+	if result.URN != "urn:li:corpuser:jdoe" || result.Total != 1 || result.Limit != 5 || result.Entries[0].Name != "orders" {
+		t.Errorf("unexpected result: %+v", result)
+	}
+
+	input := vars["input"].(map[string]any)
+	and := input["orFilters"].([]any)[0].(map[string]any)["and"].([]any)
+	filter := and[0].(map[string]any)
+	if filter["field"] != "owners" || filter["values"].([]any)[0] != "urn:li:corpuser:jdoe" {
+		t.Errorf("filter = %v, want owners filter", filter)
+	}
+}
+
+func TestClientUsersNotFound(t *testing.T) {
+	c := newGraphQLTestClient(t, map[string]any{"corpUser": nil, "corpGroup": nil}, nil)
+
+	if _, err := c.GetUser(context.Background(), "urn:li:corpuser:missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetUser() error = %v, want ErrNotFound", err)
+	}
+	if _, err := c.GetGroup(context.Background(), "urn:li:corpGroup:missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetGroup() error = %v, want ErrNotFound", err)
+	}
+}
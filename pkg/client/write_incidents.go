@@ -0,0 +1,117 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+// RaiseIncidentInput holds the parameters for raising a new incident.
+type RaiseIncidentInput struct {
+	// ResourceURN is the URN of the entity the incident is raised on (required).
+	ResourceURN string
+
+	// Type is the incident type (default: "OPERATIONAL").
+	Type string
+
+	// CustomType names the incident type when Type is CUSTOM.
+	CustomType string
+
+	// Title is a short summary of the incident (required).
+	Title string
+
+	// Description is an optional longer description of the incident.
+	Description string
+
+	// Priority is an optional priority (CRITICAL, HIGH, MEDIUM, LOW).
+	Priority string
+
+	// AssigneeURNs are optional user or group URNs to assign.
+	AssigneeURNs []string
+}
+
+// raiseIncidentResponse is the GraphQL response shape for raiseIncident.
+type raiseIncidentResponse struct {
+	RaiseIncident string `json:"raiseIncident"`
+}
+
+// updateIncidentStatusResponse is the GraphQL response shape for updateIncidentStatus.
+type updateIncidentStatusResponse struct {
+	UpdateIncidentStatus bool `json:"updateIncidentStatus"`
+}
+
+// RaiseIncident raises a new active incident on an entity and returns the incident URN.
+// Every successful call opens a new incident, so the mutation is sent once and never
+// retried on timeouts or server errors; on such failures the incident may or may not
+// exist and callers should check before raising it again.
+func (c *Client) RaiseIncident(ctx context.Context, input RaiseIncidentInput) (string, error) {
+	if input.ResourceURN == "" {
+		return "", fmt.Errorf("RaiseIncident: resource urn is required")
+	}
+	if input.Title == "" {
+		return "", fmt.Errorf("RaiseIncident: title is required")
+	}
+
+	incidentType := input.Type
+	if incidentType == "" {
+		incidentType = "OPERATIONAL"
+	}
+
+	gqlInput := map[string]any{
+		"type":        incidentType,
+		"title":       input.Title,
+		"resourceUrn": input.ResourceURN,
+	}
+	if input.CustomType != "" {
+		gqlInput["customType"] = input.CustomType
+	}
+	if input.Description != "" {
+		gqlInput["description"] = input.Description
+	}
+	if input.Priority != "" {
+		gqlInput["priority"] = input.Priority
+	}
+	if len(input.AssigneeURNs) > 0 {
+		gqlInput["assigneeUrns"] = input.AssigneeURNs
+	}
+
+	variables := map[string]any{"input": gqlInput}
+
+	var resp raiseIncidentResponse
+	if err := c.executeOnce(ctx, RaiseIncidentMutation, variables, &resp); err != nil {
+		return "", fmt.Errorf("RaiseIncident: %w", err)
+	}
+	if resp.RaiseIncident == "" {
+		return "", fmt.Errorf("RaiseIncident: no incident urn returned")
+	}
+
+	return resp.RaiseIncident, nil
+}
+
+// ResolveIncident marks an incident as resolved with an optional resolution message.
+func (c *Client) ResolveIncident(ctx context.Context, urn, message string) error {
+	if urn == "" {
+		return fmt.Errorf("ResolveIncident: urn is required")
+	}
+
+	status := map[string]any{"state": types.IncidentStateResolved}
+	if message != "" {
+		status["message"] = message
+	}
+
+	variables := map[string]any{
+		"urn":   urn,
+		"input": status,
+	}
+
+	var resp updateIncidentStatusResponse
+	if err := c.Execute(ctx, UpdateIncidentStatusMutation, variables, &resp); err != nil {
+		return fmt.Errorf("ResolveIncident: %w", err)
+	}
+	if !resp.UpdateIncidentStatus {
+		return fmt.Errorf("ResolveIncident(%s): status update was not applied", urn)
+	}
+
+	return nil
+}
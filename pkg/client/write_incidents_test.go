@@ -0,0 +1,149 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestRaiseIncident(t *testing.T) {
+	var vars map[string]any
+	c := newGraphQLTestClient(t, map[string]any{"raiseIncident": "urn:li:incident:abc"}, &vars)
+
+	urn, err := c.RaiseIncident(context.Background(), RaiseIncidentInput{
+		ResourceURN:  "urn:li:dataset:orders",
+		Title:        "Orders table is empty",
+		Priority:     "CRITICAL",
+		AssigneeURNs: []string{"urn:li:corpuser:ann"},
+	})
+	if err != nil {
+		t.Fatalf("RaiseIncident() unexpected error: %v", err)
+	}
+	if urn != "urn:li:incident:abc" {
+		t.Errorf("urn = %q, want urn:li:incident:abc", urn)
+	}
+
+	input, ok := vars["input"].(map[string]any)
+	if !ok {
+		t.Fatalf("input variable missing: %v", vars)
+	}
+	if input["type"] != "OPERATIONAL" {
+		t.Errorf("type = %v, want default OPERATIONAL", input["type"])
+	}
+	if input["resourceUrn"] != "urn:li:dataset:orders" || input["priority"] != "CRITICAL" {
+		t.Errorf("unexpected input: %v", input)
+	}
+	if _, ok := input["description"]; ok {
+		t.Error("empty description should not be sent")
+	}
+	if assignees, ok := input["assigneeUrns"].([]any); !ok || len(assignees) != 1 {
+		t.Errorf("assigneeUrns = %v", input["assigneeUrns"])
+	}
+}
+
+func TestRaiseIncident_NoRetry(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		writeJSON(t, w, map[string]any{"data": map[string]any{"raiseIncident": "urn:li:incident:dup"}})
+	}))
+	t.Cleanup(server.Close)
+	c, err := New(Config{URL: server.URL, Token: "test-token", RetryMax: 3})
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+
+	if _, err := c.RaiseIncident(context.Background(), RaiseIncidentInput{ResourceURN: "urn:li:dataset:a", Title: "t"}); err == nil {
+		t.Error("RaiseIncident() expected the server error")
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("RaiseIncident() sent %d requests, want 1", got)
+	}
+}
+
+func TestRaiseIncident_RetriesUnauthorized(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if r.Header.Get("Authorization") != "Bearer fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		writeJSON(t, w, map[string]any{"data": map[string]any{"raiseIncident": "urn:li:incident:abc"}})
+	}))
+	t.Cleanup(server.Close)
+	tokens := &rotatingTokenProvider{}
+	c, err := New(Config{URL: server.URL, TokenProvider: tokens, RetryMax: 3})
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+
+	urn, err := c.RaiseIncident(context.Background(), RaiseIncidentInput{ResourceURN: "urn:li:dataset:a", Title: "t"})
+	if err != nil || urn != "urn:li:incident:abc" {
+		t.Errorf("RaiseIncident() = %q, %v; want the incident after a token refresh", urn, err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("RaiseIncident() sent %d requests, want 2", got)
+	}
+}
+
+func TestRaiseIncident_Validation(t *testing.T) {
+	c := &Client{logger: NopLogger{}}
+
+	if _, err := c.RaiseIncident(context.Background(), RaiseIncidentInput{Title: "x"}); err == nil {
+		t.Error("expected error for empty resource urn")
+	}
+	if _, err := c.RaiseIncident(context.Background(), RaiseIncidentInput{ResourceURN: "urn:li:dataset:x"}); err == nil {
+		t.Error("expected error for empty title")
+	}
+}
+
+func TestRaiseIncident_NoURN(t *testing.T) {
+	c := newGraphQLTestClient(t, map[string]any{"raiseIncident": nil}, nil)
+
+	_, err := c.RaiseIncident(context.Background(), RaiseIncidentInput{
+		ResourceURN: "urn:li:dataset:orders",
+		Title:       "Broken",
+	})
+	if err == nil {
+		t.Fatal("expected error when no incident urn is returned")
+	}
+}
+
+func TestResolveIncident(t *testing.T) {
+	var vars map[string]any
+	c := newGraphQLTestClient(t, map[string]any{"updateIncidentStatus": true}, &vars)
+
+	if err := c.ResolveIncident(context.Background(), "urn:li:incident:abc", "Backfilled"); err != nil {
+		t.Fatalf("ResolveIncident() unexpected error: %v", err)
+	}
+	if vars["urn"] != "urn:li:incident:abc" {
+		t.Errorf("urn = %v", vars["urn"])
+	}
+	input, _ := vars["input"].(map[string]any)
+	if input["state"] != "RESOLVED" || input["message"] != "Backfilled" {
+		t.Errorf("input = %v, want RESOLVED with message", input)
+	}
+}
+
+func TestResolveIncident_NotApplied(t *testing.T) {
+	c := newGraphQLTestClient(t, map[string]any{"updateIncidentStatus": false}, nil)
+
+	err := c.ResolveIncident(context.Background(), "urn:li:incident:abc", "")
+	if err == nil || !strings.Contains(err.Error(), "not applied") {
+		t.Errorf("ResolveIncident() error = %v, want not applied", err)
+	}
+}
+
+func TestResolveIncident_EmptyURN(t *testing.T) {
+	c := &Client{logger: NopLogger{}}
+	if err := c.ResolveIncident(context.Background(), "", ""); err == nil {
+		t.Error("expected error for empty urn")
+	}
+}
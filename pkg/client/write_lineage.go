@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// LineageEdgeInput is a manual lineage edge: data flows from UpstreamURN to
+// DownstreamURN. Edges between datasets, data jobs, charts and dashboards are
+// entity lineage; edges between two schema field URNs (see BuildSchemaFieldURN)
+// are column-level lineage.
+type LineageEdgeInput struct {
+	// UpstreamURN is the entity or column data flows from (required).
+	UpstreamURN string
+
+	// DownstreamURN is the entity or column data flows to (required).
+	DownstreamURN string
+}
+
+// updateLineageResponse is the GraphQL response shape for updateLineage.
+type updateLineageResponse struct {
+	UpdateLineage bool `json:"updateLineage"`
+}
+
+// AddLineage adds manual lineage edges, for pipelines that no ingestion source
+// reports. Adding an edge that already exists is a no-op.
+func (c *Client) AddLineage(ctx context.Context, edges ...LineageEdgeInput) error {
+	return c.updateLineage(ctx, "AddLineage", edges, nil)
+}
+
+// RemoveLineage removes lineage edges. Removing an edge that does not exist is a no-op.
+func (c *Client) RemoveLineage(ctx context.Context, edges ...LineageEdgeInput) error {
+	return c.updateLineage(ctx, "RemoveLineage", nil, edges)
+}
+
+// updateLineage runs the updateLineage mutation, prefixing errors with op.
+func (c *Client) updateLineage(ctx context.Context, op string, toAdd, toRemove []LineageEdgeInput) error {
+	if len(toAdd) == 0 && len(toRemove) == 0 {
+		return fmt.Errorf("%s: at least one edge is required", op)
+	}
+
+	edgesToAdd, err := lineageEdgeVariables(toAdd)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	edgesToRemove, err := lineageEdgeVariables(toRemove)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	variables := map[string]any{
+		"input": map[string]any{
+			"edgesToAdd":    edgesToAdd,
+			"edgesToRemove": edgesToRemove,
+		},
+	}
+
+	var resp updateLineageResponse
+	if err := c.Execute(ctx, UpdateLineageMutation, variables, &resp); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if !resp.UpdateLineage {
+		return fmt.Errorf("%s: lineage update was not applied", op)
+	}
+
+	return nil
+}
+
+// lineageEdgeVariables validates edges and converts them to GraphQL LineageEdge
+// inputs. The result is never nil, since both edge lists are required.
+func lineageEdgeVariables(edges []LineageEdgeInput) ([]map[string]string, error) {
+	vars := make([]map[string]string, 0, len(edges))
+	for _, edge := range edges {
+		if edge.UpstreamURN == "" || edge.DownstreamURN == "" {
+			return nil, fmt.Errorf("upstream and downstream urns are required")
+		}
+		if edge.UpstreamURN == edge.DownstreamURN {
+			return nil, fmt.Errorf("%s cannot be upstream of itself", edge.UpstreamURN)
+		}
+		if isSchemaFieldURN(edge.UpstreamURN) != isSchemaFieldURN(edge.DownstreamURN) {
+			return nil, fmt.Errorf("column-level lineage must connect two schema fields: %s -> %s",
+				edge.UpstreamURN, edge.DownstreamURN)
+		}
+		vars = append(vars, map[string]string{
+			"upstreamUrn":   edge.UpstreamURN,
+			"downstreamUrn": edge.DownstreamURN,
+		})
+	}
+	return vars, nil
+}
+
+// isSchemaFieldURN reports whether urn identifies a dataset column.
+func isSchemaFieldURN(urn string) bool {
+	return strings.HasPrefix(urn, "urn:li:schemaField:")
+}
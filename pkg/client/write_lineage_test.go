@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestAddLineage(t *testing.T) {
+	var vars map[string]any
+	c := newGraphQLTestClient(t, map[string]any{"updateLineage": true}, &vars)
+
+	err := c.AddLineage(context.Background(),
+		LineageEdgeInput{UpstreamURN: "urn:li:dataset:raw", DownstreamURN: "urn:li:dataset:clean"},
+		LineageEdgeInput{
+			UpstreamURN:   BuildSchemaFieldURN("urn:li:dataset:raw", "amount"),
+			DownstreamURN: BuildSchemaFieldURN("urn:li:dataset:clean", "total"),
+		},
+	)
+	if err != nil {
+		t.Fatalf("AddLineage() unexpected error: %v", err)
+	}
+
+	input, ok := vars["input"].(map[string]any)
+	if !ok {
+		t.Fatalf("input variable missing: %v", vars)
+	}
+	added, _ := input["edgesToAdd"].([]any)
+	if len(added) != 2 {
+		t.Fatalf("edgesToAdd = %v, want 2 edges", input["edgesToAdd"])
+	}
+	first, _ := added[0].(map[string]any)
+	if first["upstreamUrn"] != "urn:li:dataset:raw" || first["downstreamUrn"] != "urn:li:dataset:clean" {
+		t.Errorf("first edge = %v", first)
+	}
+	if removed, ok := input["edgesToRemove"].([]any); !ok || len(removed) != 0 {
+		t.Errorf("edgesToRemove = %v, want an empty list", input["edgesToRemove"])
+	}
+}
+
+func TestRemoveLineage(t *testing.T) {
+	var vars map[string]any
+	c := newGraphQLTestClient(t, map[string]any{"updateLineage": true}, &vars)
+
+	err := c.RemoveLineage(context.Background(), LineageEdgeInput{UpstreamURN: "urn:li:dataset:raw", DownstreamURN: "urn:li:dataJob:load"})
+	if err != nil {
+		t.Fatalf("RemoveLineage() unexpected error: %v", err)
+	}
+
+	input, _ := vars["input"].(map[string]any)
+	if removed, _ := input["edgesToRemove"].([]any); len(removed) != 1 {
+		t.Errorf("edgesToRemove = %v, want 1 edge", input["edgesToRemove"])
+	}
+	if added, ok := input["edgesToAdd"].([]any); !ok || len(added) != 0 {
+		t.Errorf("edgesToAdd = %v, want an empty list", input["edgesToAdd"])
+	}
+}
+
+func TestUpdateLineage_NotApplied(t *testing.T) {
+	c := newGraphQLTestClient(t, map[string]any{"updateLineage": false}, nil)
+
+	err := c.AddLineage(context.Background(), LineageEdgeInput{UpstreamURN: "urn:li:dataset:a", DownstreamURN: "urn:li:dataset:b"})
+	if err == nil || !strings.Contains(err.Error(), "AddLineage: lineage update was not applied") {
+		t.Errorf("AddLineage() error = %v, want not applied", err)
+	}
+}
+
+func TestUpdateLineage_Validation(t *testing.T) {
+	c := &Client{logger: NopLogger{}}
+	field := BuildSchemaFieldURN("urn:li:dataset:a", "id")
+
+	tests := []struct {
+		name    string
+		edges   []LineageEdgeInput
+		wantErr string
+	}{
+		{name: "no edges", wantErr: "at least one edge"},
+		{name: "missing upstream", edges: []LineageEdgeInput{{DownstreamURN: "urn:li:dataset:b"}}, wantErr: "are required"},
+		{name: "self edge", edges: []LineageEdgeInput{{UpstreamURN: "urn:li:dataset:a", DownstreamURN: "urn:li:dataset:a"}}, wantErr: "itself"},
+		{
+			name:    "column to dataset",
+			edges:   []LineageEdgeInput{{UpstreamURN: field, DownstreamURN: "urn:li:dataset:b"}},
+			wantErr: "two schema fields",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := c.RemoveLineage(context.Background(), tt.edges...)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) || !strings.HasPrefix(err.Error(), "RemoveLineage: ") {
+				t.Errorf("RemoveLineage() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -23,11 +23,24 @@ type ServerConfig struct {
 
 // DataHubConfig configures the DataHub connection.
 type DataHubConfig struct {
-	URL            string   `json:"url" yaml:"url"`
-	Token          string   `json:"token" yaml:"token"`
-	Timeout        Duration `json:"timeout" yaml:"timeout"`
-	ConnectionName string   `json:"connection_name" yaml:"connection_name"`
-	WriteEnabled   *bool    `json:"write_enabled" yaml:"write_enabled"`
+	URL            string           `json:"url" yaml:"url"`
+	Token          string           `json:"token" yaml:"token"`
+	TokenFile      string           `json:"token_file" yaml:"token_file"`
+	TokenCommand   []string         `json:"token_command" yaml:"token_command"`
+	AuthMode       string           `json:"auth_mode" yaml:"auth_mode"`
+	OAuth2         OAuth2FileConfig `json:"oauth2" yaml:"oauth2"`
+	Timeout        Duration         `json:"timeout" yaml:"timeout"`
+	ConnectionName string           `json:"connection_name" yaml:"connection_name"`
+	WriteEnabled   *bool            `json:"write_enabled" yaml:"write_enabled"`
+}
+
+// OAuth2FileConfig configures the OAuth2 client-credentials grant when auth_mode is "oauth2".
+type OAuth2FileConfig struct {
+	ClientID     string   `json:"client_id" yaml:"client_id"`
+	ClientSecret string   `json:"client_secret" yaml:"client_secret"`
+	TokenURL     string   `json:"token_url" yaml:"token_url"`
+	Scopes       []string `json:"scopes" yaml:"scopes"`
+	Audience     string   `json:"audience" yaml:"audience"`
 }
 
 // ToolkitConfig configures toolkit behavior.
@@ -166,6 +179,9 @@ func LoadConfig(path string) (ServerConfig, error) {
 	if v := os.Getenv("DATAHUB_TOKEN"); v != "" {
 		cfg.DataHub.Token = v
 	}
+	if v := os.Getenv("DATAHUB_OAUTH2_CLIENT_SECRET"); v != "" {
+		cfg.DataHub.OAuth2.ClientSecret = v
+	}
 	if v := os.Getenv("DATAHUB_TIMEOUT"); v != "" {
 		dur, parseErr := time.ParseDuration(v)
 		if parseErr != nil {
@@ -181,8 +197,12 @@ func LoadConfig(path string) (ServerConfig, error) {
 		cfg.DataHub.WriteEnabled = &b
 	}
 
-	// Expand environment variables in token (for $VAR or ${VAR} patterns)
-	cfg.DataHub.Token = os.ExpandEnv(cfg.DataHub.Token)
+	// Expand environment variables in token (for $VAR or ${VAR} patterns).
+	// ${env:VAR} and ${file:/path} references are resolved by the client instead.
+	if !client.IsTokenRef(cfg.DataHub.Token) {
+		cfg.DataHub.Token = os.ExpandEnv(cfg.DataHub.Token)
+	}
+	cfg.DataHub.OAuth2.ClientSecret = os.ExpandEnv(cfg.DataHub.OAuth2.ClientSecret)
 
 	return cfg, nil
 }
@@ -196,6 +216,14 @@ func (sc *ServerConfig) ClientConfig() client.Config {
 	if sc.DataHub.Token != "" {
 		cfg.Token = sc.DataHub.Token
 	}
+	if sc.DataHub.TokenFile != "" {
+		cfg.TokenProvider = client.NewFileTokenProvider(sc.DataHub.TokenFile)
+	}
+	if len(sc.DataHub.TokenCommand) > 0 {
+		cfg.TokenProvider = client.NewCommandTokenProvider(sc.DataHub.TokenCommand, 0)
+	}
+	cfg.AuthMode = strings.ToLower(sc.DataHub.AuthMode)
+	cfg.OAuth2 = sc.DataHub.OAuth2.clientConfig()
 	if sc.DataHub.Timeout.Duration > 0 {
 		cfg.Timeout = sc.DataHub.Timeout.Duration
 	}
@@ -211,6 +239,20 @@ func (sc *ServerConfig) ClientConfig() client.Config {
 	return cfg
 }
 
+// clientConfig converts the file's OAuth2 settings to a client.OAuth2Config.
+func (oc OAuth2FileConfig) clientConfig() client.OAuth2Config {
+	cfg := client.OAuth2Config{
+		ClientID:     oc.ClientID,
+		ClientSecret: oc.ClientSecret,
+		TokenURL:     oc.TokenURL,
+		Scopes:       oc.Scopes,
+	}
+	if oc.Audience != "" {
+		cfg.EndpointParams = map[string][]string{"audience": {oc.Audience}}
+	}
+	return cfg
+}
+
 // ToolsConfig converts the file config to a tools.Config.
 func (sc *ServerConfig) ToolsConfig() tools.Config {
 	cfg := tools.DefaultConfig()
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/txn2/mcp-datahub/pkg/client"
 	"github.com/txn2/mcp-datahub/pkg/tools"
 )
 
@@ -369,6 +370,83 @@ datahub:
 	}
 }
 
+func TestLoadConfig_TokenRefNotExpanded(t *testing.T) {
+	yamlPath := filepath.Join(t.TempDir(), "config.yaml")
+	yamlData := []byte(`
+datahub:
+  url: https://test.datahub.io
+  token: "${file:/run/secrets/datahub}"
+`)
+	if err := os.WriteFile(yamlPath, yamlData, 0o600); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+	t.Setenv("DATAHUB_TOKEN", "")
+
+	cfg, err := LoadConfig(yamlPath)
+	if err != nil {
+		t.Fatalf("LoadConfig() error: %v", err)
+	}
+	if cfg.DataHub.Token != "${file:/run/secrets/datahub}" {
+		t.Errorf("Token = %q, want reference preserved for the client", cfg.DataHub.Token)
+	}
+}
+
+func TestClientConfig_TokenProviders(t *testing.T) {
+	sc := ServerConfig{DataHub: DataHubConfig{URL: "https://test.datahub.io", TokenFile: "/run/secrets/datahub"}}
+	if _, ok := sc.ClientConfig().TokenProvider.(*client.FileTokenProvider); !ok {
+		t.Error("token_file should configure a FileTokenProvider")
+	}
+
+	sc.DataHub.TokenCommand = []string{"vault", "read", "-field=token", "secret/datahub"}
+	if _, ok := sc.ClientConfig().TokenProvider.(*client.CommandTokenProvider); !ok {
+		t.Error("token_command should configure a CommandTokenProvider")
+	}
+}
+
+func TestClientConfig_OAuth2(t *testing.T) {
+	t.Setenv("DATAHUB_URL", "")
+	t.Setenv("DATAHUB_TOKEN", "")
+	t.Setenv("DATAHUB_OAUTH2_CLIENT_SECRET", "")
+	t.Setenv("OAUTH_SECRET", "s3cret")
+	yamlPath := filepath.Join(t.TempDir(), "config.yaml")
+	content := `datahub:
+  url: https://datahub.example.com
+  auth_mode: OAuth2
+  oauth2:
+    client_id: mcp
+    client_secret: "${OAUTH_SECRET}"
+    token_url: https://sso.example.com/token
+    scopes: [datahub.read, datahub.write]
+    audience: datahub
+`
+	if err := os.WriteFile(yamlPath, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	sc, err := LoadConfig(yamlPath)
+	if err != nil {
+		t.Fatalf("LoadConfig() error: %v", err)
+	}
+	cfg := sc.ClientConfig()
+	if cfg.AuthMode != client.AuthModeOAuth2 {
+		t.Errorf("AuthMode = %q, want %q", cfg.AuthMode, client.AuthModeOAuth2)
+	}
+	if cfg.OAuth2.ClientID != "mcp" || cfg.OAuth2.ClientSecret != "s3cret" || cfg.OAuth2.TokenURL != "https://sso.example.com/token" {
+		t.Errorf("OAuth2 = %+v", cfg.OAuth2)
+	}
+	if len(cfg.OAuth2.Scopes) != 2 || cfg.OAuth2.EndpointParams["audience"][0] != "datahub" {
+		t.Errorf("OAuth2 scopes/params = %v/%v", cfg.OAuth2.Scopes, cfg.OAuth2.EndpointParams)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("Validate() should accept OAuth2 without a token: %v", err)
+	}
+
+	t.Setenv("DATAHUB_OAUTH2_CLIENT_SECRET", "from-env")
+	if sc, _ = LoadConfig(yamlPath); sc.DataHub.OAuth2.ClientSecret != "from-env" {
+		t.Errorf("ClientSecret = %q, want the environment override", sc.DataHub.OAuth2.ClientSecret)
+	}
+}
+
 func TestClientConfig(t *testing.T) {
 	sc := ServerConfig{
 		DataHub: DataHubConfig{
@@ -0,0 +1,173 @@
+package render
+
+import (
+	"strings"
+
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+// FromLineage builds a graph of the entities in a lineage result, with the start
+// entity marked as the root. Edges produced by a data job that is not itself in
+// the graph are labeled with the job, and edges added or removed in a time window
+// with the change.
+func FromLineage(result *types.LineageResult) *Graph {
+	g := &Graph{Name: "lineage"}
+	if result == nil {
+		return g
+	}
+
+	seen := map[string]bool{result.Start: true}
+	g.Nodes = append(g.Nodes, Node{ID: result.Start, Label: shortName(result.Start), Type: urnType(result.Start), Root: true})
+	for _, n := range result.Nodes {
+		if seen[n.URN] {
+			continue
+		}
+		seen[n.URN] = true
+		label := n.Name
+		if label == "" {
+			label = shortName(n.URN)
+		}
+		g.Nodes = append(g.Nodes, Node{ID: n.URN, Label: label, Type: n.Type, Platform: n.Platform})
+	}
+
+	for _, e := range result.Edges {
+		var labels []string
+		if e.Via != "" && !seen[e.Via] {
+			labels = append(labels, "via "+shortName(e.Via))
+		}
+		if e.Change != "" {
+			labels = append(labels, strings.ToLower(e.Change))
+		}
+		g.Edges = append(g.Edges, Edge{Source: e.Source, Target: e.Target, Label: strings.Join(labels, ", ")})
+	}
+	return g
+}
+
+// FromColumnLineage builds a graph of one dataset's fine-grained lineage: each
+// upstream column points at the column derived from it, labeled with the transform.
+// Columns are grouped by dataset, and the queried dataset's columns are roots.
+func FromColumnLineage(lineage *types.ColumnLineage) *Graph {
+	g := &Graph{Name: "column_lineage"}
+	if lineage == nil {
+		return g
+	}
+
+	b := newColumnGraph(g)
+	for _, m := range lineage.Mappings {
+		up := b.column(types.ColumnRef{URN: m.UpstreamDataset, Type: "DATASET", Field: m.UpstreamColumn}, false)
+		down := b.column(types.ColumnRef{URN: lineage.DatasetURN, Type: "DATASET", Field: m.DownstreamColumn}, true)
+		g.Edges = append(g.Edges, Edge{Source: up, Target: down, Label: m.Transform})
+	}
+	return g
+}
+
+// FromColumnTrace builds a graph of a multi-hop column trace, with the traced column
+// marked as the root. Columns are grouped by the dataset, chart or dashboard that
+// holds them.
+func FromColumnTrace(trace *types.ColumnTrace) *Graph {
+	g := &Graph{Name: "column_trace"}
+	if trace == nil {
+		return g
+	}
+
+	b := newColumnGraph(g)
+	b.column(types.ColumnRef{URN: trace.DatasetURN, Type: "DATASET", Field: trace.Column}, true)
+	for _, hop := range trace.Hops {
+		up := b.column(hop.Upstream, false)
+		down := b.column(hop.Downstream, false)
+		g.Edges = append(g.Edges, Edge{Source: up, Target: down, Label: hop.Transform})
+	}
+	return g
+}
+
+// columnGraph adds column nodes and their dataset groups to a graph once each.
+type columnGraph struct {
+	g      *Graph
+	nodes  map[string]int
+	groups map[string]bool
+}
+
+func newColumnGraph(g *Graph) *columnGraph {
+	return &columnGraph{g: g, nodes: make(map[string]int), groups: make(map[string]bool)}
+}
+
+// column adds ref if it is new and returns its node ID. Marking a column as the
+// root sticks even if it was added before.
+func (b *columnGraph) column(ref types.ColumnRef, root bool) string {
+	id := ref.URN + "#" + ref.Field
+	if i, ok := b.nodes[id]; ok {
+		b.g.Nodes[i].Root = b.g.Nodes[i].Root || root
+		return id
+	}
+	if !b.groups[ref.URN] {
+		b.groups[ref.URN] = true
+		b.g.Groups = append(b.g.Groups, Group{ID: ref.URN, Label: shortName(ref.URN)})
+	}
+	b.nodes[id] = len(b.g.Nodes)
+	b.g.Nodes = append(b.g.Nodes, Node{ID: id, Label: ref.Field, Type: ref.Type, Group: ref.URN, Root: root})
+	return id
+}
+
+// urnEntityTypes maps URN entity names to the entity types that select shapes.
+var urnEntityTypes = map[string]string{
+	"dataset":   "DATASET",
+	"dataJob":   "DATA_JOB",
+	"dataFlow":  "DATA_FLOW",
+	"chart":     "CHART",
+	"dashboard": "DASHBOARD",
+}
+
+// urnType returns the entity type of a URN, or "" for entities without a shape.
+func urnType(urn string) string {
+	rest, ok := strings.CutPrefix(urn, "urn:li:")
+	if !ok {
+		return ""
+	}
+	name, _, _ := strings.Cut(rest, ":")
+	return urnEntityTypes[name]
+}
+
+// shortName returns a readable name for a URN: the qualified name of a dataset,
+// the last key part of other tuple URNs, or the key of simple URNs.
+//
+//	urn:li:dataset:(urn:li:dataPlatform:snowflake,prod.sales.orders,PROD) -> prod.sales.orders
+//	urn:li:chart:(looker,revenue)                                        -> revenue
+//	urn:li:corpuser:jdoe                                                 -> jdoe
+func shortName(urn string) string {
+	rest := urn
+	if strings.HasPrefix(rest, "urn:li:") {
+		rest = rest[len("urn:li:"):]
+		if i := strings.Index(rest, ":"); i >= 0 {
+			rest = rest[i+1:]
+		}
+	}
+	if !strings.HasPrefix(rest, "(") || !strings.HasSuffix(rest, ")") {
+		return rest
+	}
+
+	parts := splitTopLevel(rest[1 : len(rest)-1])
+	if strings.HasPrefix(urn, "urn:li:dataset:") && len(parts) == 3 {
+		return parts[1]
+	}
+	return parts[len(parts)-1]
+}
+
+// splitTopLevel splits s on commas that are not inside parentheses.
+func splitTopLevel(s string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, s[start:])
+}
@@ -0,0 +1,143 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+func TestFromLineage(t *testing.T) {
+	g := FromLineage(&types.LineageResult{
+		Start: "urn:li:dataset:(urn:li:dataPlatform:snowflake,prod.sales.orders,PROD)",
+		Nodes: []types.LineageNode{
+			{URN: "urn:li:dashboard:(looker,sales)", Type: "DASHBOARD", Name: "Sales", Platform: "looker"},
+			{URN: "urn:li:dataJob:load", Type: "DATA_JOB"},
+			{URN: "urn:li:dataJob:load", Type: "DATA_JOB"},
+		},
+		Edges: []types.LineageEdge{
+			{Source: "urn:li:dataset:(urn:li:dataPlatform:snowflake,prod.sales.orders,PROD)", Target: "urn:li:dataJob:load"},
+			{
+				Source: "urn:li:dataset:(urn:li:dataPlatform:snowflake,prod.sales.orders,PROD)",
+				Target: "urn:li:dashboard:(looker,sales)",
+				Via:    "urn:li:dataJob:(urn:li:dataFlow:(airflow,etl,prod),publish)",
+			},
+			{Source: "urn:li:dataJob:load", Target: "urn:li:dashboard:(looker,sales)", Via: "urn:li:dataJob:load"},
+			{Source: "urn:li:dataJob:load", Target: "urn:li:dataset:old", Via: "urn:li:dataJob:gone", Change: types.LineageEdgeRemoved},
+		},
+	})
+
+	if len(g.Nodes) != 3 {
+		t.Fatalf("FromLineage() nodes = %+v, want start plus two unique nodes", g.Nodes)
+	}
+	if root := g.Nodes[0]; !root.Root || root.Label != "prod.sales.orders" || root.Type != "DATASET" {
+		t.Errorf("FromLineage() root = %+v", root)
+	}
+	if n := g.Nodes[1]; n.Label != "Sales" || n.Type != "DASHBOARD" || n.Platform != "looker" {
+		t.Errorf("FromLineage() node = %+v", n)
+	}
+	if g.Nodes[2].Label != "load" {
+		t.Errorf("FromLineage() unnamed node label = %q, want the URN key", g.Nodes[2].Label)
+	}
+	if g.Edges[1].Label != "via publish" {
+		t.Errorf("FromLineage() edge label = %q, want the job outside the graph", g.Edges[1].Label)
+	}
+	if g.Edges[2].Label != "" {
+		t.Errorf("FromLineage() edge label = %q, want none for a job drawn as a node", g.Edges[2].Label)
+	}
+	if g.Edges[3].Label != "via gone, removed" {
+		t.Errorf("FromLineage() edge label = %q, want the job and the change", g.Edges[3].Label)
+	}
+
+	if g := FromLineage(nil); len(g.Nodes) != 0 || g.Name != "lineage" {
+		t.Errorf("FromLineage(nil) = %+v", g)
+	}
+}
+
+func TestFromColumnLineage(t *testing.T) {
+	g := FromColumnLineage(&types.ColumnLineage{
+		DatasetURN: "urn:li:dataset:report",
+		Mappings: []types.ColumnLineageMapping{
+			{DownstreamColumn: "total", UpstreamDataset: "urn:li:dataset:raw", UpstreamColumn: "amount", Transform: "SUM"},
+			{DownstreamColumn: "total", UpstreamDataset: "urn:li:dataset:raw", UpstreamColumn: "tax"},
+		},
+	})
+
+	if len(g.Groups) != 2 || g.Groups[0].ID != "urn:li:dataset:raw" || g.Groups[1].Label != "report" {
+		t.Errorf("FromColumnLineage() groups = %+v", g.Groups)
+	}
+	if len(g.Nodes) != 3 {
+		t.Fatalf("FromColumnLineage() nodes = %+v, want amount, total and tax", g.Nodes)
+	}
+	if total := g.Nodes[1]; total.ID != "urn:li:dataset:report#total" || !total.Root || total.Group != "urn:li:dataset:report" {
+		t.Errorf("FromColumnLineage() downstream column = %+v", total)
+	}
+	if g.Nodes[0].Root {
+		t.Error("FromColumnLineage() upstream column should not be a root")
+	}
+	if len(g.Edges) != 2 || g.Edges[0].Label != "SUM" || g.Edges[1].Source != "urn:li:dataset:raw#tax" {
+		t.Errorf("FromColumnLineage() edges = %+v", g.Edges)
+	}
+
+	if g := FromColumnLineage(nil); len(g.Nodes) != 0 {
+		t.Errorf("FromColumnLineage(nil) = %+v", g)
+	}
+}
+
+func TestFromColumnTrace(t *testing.T) {
+	g := FromColumnTrace(&types.ColumnTrace{
+		DatasetURN: "urn:li:dataset:report",
+		Column:     "total",
+		Hops: []types.ColumnHop{
+			{
+				Upstream:   types.ColumnRef{URN: "urn:li:dataset:report", Type: "DATASET", Field: "total"},
+				Downstream: types.ColumnRef{URN: "urn:li:chart:(looker,revenue)", Type: "CHART", Field: "Revenue"},
+				Transform:  "IDENTITY",
+			},
+		},
+	})
+
+	if len(g.Nodes) != 2 || !g.Nodes[0].Root || g.Nodes[1].Type != "CHART" {
+		t.Errorf("FromColumnTrace() nodes = %+v", g.Nodes)
+	}
+	if len(g.Groups) != 2 || g.Groups[1].Label != "revenue" {
+		t.Errorf("FromColumnTrace() groups = %+v", g.Groups)
+	}
+	if len(g.Edges) != 1 || g.Edges[0].Label != "IDENTITY" {
+		t.Errorf("FromColumnTrace() edges = %+v", g.Edges)
+	}
+
+	if g := FromColumnTrace(nil); len(g.Nodes) != 0 {
+		t.Errorf("FromColumnTrace(nil) = %+v", g)
+	}
+}
+
+func TestURNType(t *testing.T) {
+	tests := map[string]string{
+		"urn:li:dataset:(urn:li:dataPlatform:hive,db.t,PROD)":      "DATASET",
+		"urn:li:dataJob:(urn:li:dataFlow:(airflow,etl,prod),load)": "DATA_JOB",
+		"urn:li:dashboard:(looker,sales)":                          "DASHBOARD",
+		"urn:li:corpuser:jdoe":                                     "",
+		"dataset":                                                  "",
+	}
+	for urn, want := range tests {
+		if got := urnType(urn); got != want {
+			t.Errorf("urnType(%q) = %q, want %q", urn, got, want)
+		}
+	}
+}
+
+func TestShortName(t *testing.T) {
+	tests := map[string]string{
+		"urn:li:dataset:(urn:li:dataPlatform:snowflake,prod.sales.orders,PROD)": "prod.sales.orders",
+		"urn:li:chart:(looker,revenue)":                                         "revenue",
+		"urn:li:dataJob:(urn:li:dataFlow:(airflow,etl,prod),load)":              "load",
+		"urn:li:corpuser:jdoe":                                                  "jdoe",
+		"not-a-urn":                                                             "not-a-urn",
+		"urn:li:dataset:(urn:li:dataPlatform:hive,db.t,PROD,extra)":             "extra",
+	}
+	for urn, want := range tests {
+		if got := shortName(urn); got != want {
+			t.Errorf("shortName(%q) = %q, want %q", urn, got, want)
+		}
+	}
+}
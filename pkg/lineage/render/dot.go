@@ -0,0 +1,84 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+)
+
+// dotShapes maps entity types to Graphviz node shapes. Other types are boxes.
+var dotShapes = map[string]string{
+	"DATASET":   "cylinder",
+	"DATA_JOB":  "component",
+	"DATA_FLOW": "component",
+	"CHART":     "note",
+	"DASHBOARD": "tab",
+}
+
+// DOT renders g as a Graphviz digraph laid out left to right. Groups become
+// clusters and the root is drawn bold.
+func DOT(g *Graph) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", dotQuote(graphName(g)))
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box, fontname=\"Helvetica\"];\n")
+	b.WriteString("  edge [fontname=\"Helvetica\", fontsize=10];\n")
+
+	_, members, loose := layout(g)
+	for i, group := range g.Groups {
+		nodes := members[group.ID]
+		if len(nodes) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "  subgraph %s {\n", dotQuote(fmt.Sprintf("cluster_%d", i)))
+		fmt.Fprintf(&b, "    label=%s;\n", dotQuote(group.Label))
+		for _, node := range nodes {
+			b.WriteString("    " + dotNode(node) + "\n")
+		}
+		b.WriteString("  }\n")
+	}
+	for _, node := range loose {
+		b.WriteString("  " + dotNode(node) + "\n")
+	}
+
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&b, "  %s -> %s", dotQuote(edge.Source), dotQuote(edge.Target))
+		if edge.Label != "" {
+			fmt.Fprintf(&b, " [label=%s]", dotQuote(edge.Label))
+		}
+		b.WriteString(";\n")
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// dotNode returns the statement declaring node.
+func dotNode(node Node) string {
+	attrs := []string{"label=" + dotQuote(node.Label)}
+	if shape, ok := dotShapes[node.Type]; ok {
+		attrs = append(attrs, "shape="+shape)
+	}
+	if node.Root {
+		attrs = append(attrs, "style=bold", "penwidth=2")
+	}
+	if node.Platform != "" {
+		attrs = append(attrs, "tooltip="+dotQuote(node.Platform))
+	}
+	return fmt.Sprintf("%s [%s];", dotQuote(node.ID), strings.Join(attrs, ", "))
+}
+
+// dotQuote returns s as a double-quoted DOT ID.
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
+
+// graphName returns the graph's name, defaulting to "lineage".
+func graphName(g *Graph) string {
+	if g.Name == "" {
+		return "lineage"
+	}
+	return g.Name
+}
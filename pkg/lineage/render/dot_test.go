@@ -0,0 +1,51 @@
+package render
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDOT(t *testing.T) {
+	out := DOT(sampleGraph())
+
+	for _, want := range []string{
+		"  rankdir=LR;\n",
+		`  "urn:li:dataset:orders" [label="orders", shape=cylinder, style=bold, penwidth=2, tooltip="snowflake"];`,
+		`  "urn:li:dataJob:load" [label="load", shape=component];`,
+		`  "urn:li:dataset:clean" [label="clean \"v2\"", shape=cylinder];`,
+		`  "urn:li:chart:(looker,revenue)" [label="revenue", shape=note];`,
+		`  "urn:li:dataJob:load" -> "urn:li:dataset:clean" [label="nightly"];`,
+		`  "urn:li:dataset:orders" -> "urn:li:dataJob:load";`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("DOT() missing %q in:\n%s", want, out)
+		}
+	}
+	if !strings.HasSuffix(out, "}\n") {
+		t.Errorf("DOT() should close the digraph:\n%s", out)
+	}
+}
+
+func TestDOTClusters(t *testing.T) {
+	g := &Graph{
+		Name:   "column_lineage",
+		Nodes:  []Node{{ID: "ds#a", Label: "a", Group: "ds"}},
+		Groups: []Group{{ID: "ds", Label: "orders"}, {ID: "empty", Label: "unused"}},
+	}
+	out := DOT(g)
+	if !strings.Contains(out, "  subgraph \"cluster_0\" {\n    label=\"orders\";\n    \"ds#a\" [label=\"a\"];\n  }\n") {
+		t.Errorf("DOT() cluster not rendered:\n%s", out)
+	}
+	if strings.Contains(out, "unused") {
+		t.Errorf("DOT() should skip empty groups:\n%s", out)
+	}
+}
+
+func TestDOTQuote(t *testing.T) {
+	if got := dotQuote("a\\b\"c\nd"); got != `"a\\b\"c\nd"` {
+		t.Errorf("dotQuote() = %s", got)
+	}
+	if got := graphName(&Graph{}); got != "lineage" {
+		t.Errorf("graphName() = %q, want the default", got)
+	}
+}
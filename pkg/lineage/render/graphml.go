@@ -0,0 +1,83 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+)
+
+// graphMLKeys declares the data attributes written for nodes and edges.
+const graphMLKeys = `  <key id="label" for="node" attr.name="label" attr.type="string"/>
+  <key id="type" for="node" attr.name="type" attr.type="string"/>
+  <key id="platform" for="node" attr.name="platform" attr.type="string"/>
+  <key id="group" for="node" attr.name="group" attr.type="string"/>
+  <key id="root" for="node" attr.name="root" attr.type="boolean">
+    <default>false</default>
+  </key>
+  <key id="edge_label" for="edge" attr.name="label" attr.type="string"/>
+`
+
+// GraphML renders g as a GraphML document. Node properties are written as data
+// attributes; a node's group is stored as the label of its group.
+func GraphML(g *Graph) string {
+	nodes, _, _ := layout(g)
+	groups := make(map[string]string, len(g.Groups))
+	for _, group := range g.Groups {
+		groups[group.ID] = group.Label
+	}
+
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	b.WriteString(`<graphml xmlns="http://graphml.graphdrawing.org/xmlns">` + "\n")
+	b.WriteString(graphMLKeys)
+	fmt.Fprintf(&b, "  <graph id=\"%s\" edgedefault=\"directed\">\n", xmlEscape(graphName(g)))
+
+	for _, node := range nodes {
+		fmt.Fprintf(&b, "    <node id=\"%s\">\n", xmlEscape(node.ID))
+		writeGraphMLData(&b, "label", node.Label)
+		writeGraphMLData(&b, "type", node.Type)
+		writeGraphMLData(&b, "platform", node.Platform)
+		writeGraphMLData(&b, "group", groups[node.Group])
+		if node.Root {
+			writeGraphMLData(&b, "root", "true")
+		}
+		b.WriteString("    </node>\n")
+	}
+
+	for i, edge := range g.Edges {
+		fmt.Fprintf(&b, "    <edge id=\"e%d\" source=\"%s\" target=\"%s\"", i, xmlEscape(edge.Source), xmlEscape(edge.Target))
+		if edge.Label == "" {
+			b.WriteString("/>\n")
+			continue
+		}
+		b.WriteString(">\n")
+		writeGraphMLData(&b, "edge_label", edge.Label)
+		b.WriteString("    </edge>\n")
+	}
+
+	b.WriteString("  </graph>\n")
+	b.WriteString("</graphml>\n")
+	return b.String()
+}
+
+// writeGraphMLData writes a data element, skipping empty values.
+func writeGraphMLData(b *strings.Builder, key, value string) {
+	if value == "" {
+		return
+	}
+	fmt.Fprintf(b, "      <data key=\"%s\">%s</data>\n", key, xmlEscape(value))
+}
+
+// xmlEscaper escapes text for use in XML content and double-quoted attributes.
+var xmlEscaper = strings.NewReplacer(
+	"&", "&amp;",
+	"<", "&lt;",
+	">", "&gt;",
+	`"`, "&quot;",
+	"'", "&apos;",
+	"\n", "&#xA;",
+)
+
+// xmlEscape returns s escaped for XML.
+func xmlEscape(s string) string {
+	return xmlEscaper.Replace(s)
+}
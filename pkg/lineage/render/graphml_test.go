@@ -0,0 +1,58 @@
+package render
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+func TestGraphML(t *testing.T) {
+	g := sampleGraph()
+	g.Nodes[1].Group = "jobs"
+	g.Groups = []Group{{ID: "jobs", Label: "Airflow & co"}}
+	out := GraphML(g)
+
+	for _, want := range []string{
+		`<graph id="lineage" edgedefault="directed">`,
+		"    <node id=\"urn:li:dataset:orders\">\n" +
+			"      <data key=\"label\">orders</data>\n" +
+			"      <data key=\"type\">DATASET</data>\n" +
+			"      <data key=\"platform\">snowflake</data>\n" +
+			"      <data key=\"root\">true</data>\n" +
+			"    </node>\n",
+		`<data key="group">Airflow &amp; co</data>`,
+		`<data key="label">clean &quot;v2&quot;</data>`,
+		`<node id="urn:li:chart:(looker,revenue)">`,
+		`<edge id="e0" source="urn:li:dataset:orders" target="urn:li:dataJob:load"/>`,
+		"<edge id=\"e1\" source=\"urn:li:dataJob:load\" target=\"urn:li:dataset:clean\">\n" +
+			"      <data key=\"edge_label\">nightly</data>\n" +
+			"    </edge>\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("GraphML() missing %q in:\n%s", want, out)
+		}
+	}
+
+	var doc struct {
+		Graph struct {
+			Nodes []struct {
+				ID string `xml:"id,attr"`
+			} `xml:"node"`
+			Edges []struct {
+				Source string `xml:"source,attr"`
+			} `xml:"edge"`
+		} `xml:"graph"`
+	}
+	if err := xml.Unmarshal([]byte(out), &doc); err != nil {
+		t.Fatalf("GraphML() is not well-formed XML: %v", err)
+	}
+	if len(doc.Graph.Nodes) != 4 || len(doc.Graph.Edges) != 3 {
+		t.Errorf("GraphML() parsed %d nodes and %d edges, want 4 and 3", len(doc.Graph.Nodes), len(doc.Graph.Edges))
+	}
+}
+
+func TestXMLEscape(t *testing.T) {
+	if got := xmlEscape(`<a href='x'>"&"` + "\n"); got != "&lt;a href=&apos;x&apos;&gt;&quot;&amp;&quot;&#xA;" {
+		t.Errorf("xmlEscape() = %s", got)
+	}
+}
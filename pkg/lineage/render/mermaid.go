@@ -0,0 +1,83 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+)
+
+// mermaidShapes maps entity types to Mermaid node shapes as opening and closing
+// delimiters. Other types are rectangles.
+var mermaidShapes = map[string][2]string{
+	"DATASET":   {"[(", ")]"},
+	"DATA_JOB":  {"[[", "]]"},
+	"DATA_FLOW": {"[[", "]]"},
+	"CHART":     {"[/", "/]"},
+	"DASHBOARD": {"[/", "/]"},
+}
+
+// Mermaid renders g as a left-to-right Mermaid flowchart. Node IDs are replaced
+// by short generated ones, since URNs are not valid Mermaid IDs; groups become
+// subgraphs and the root gets the "root" class.
+func Mermaid(g *Graph) string {
+	nodes, members, loose := layout(g)
+	ids := make(map[string]string, len(nodes))
+	for i, node := range nodes {
+		ids[node.ID] = fmt.Sprintf("n%d", i)
+	}
+
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+
+	for i, group := range g.Groups {
+		nodes := members[group.ID]
+		if len(nodes) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "  subgraph g%d [%s]\n", i, mermaidQuote(group.Label))
+		for _, node := range nodes {
+			b.WriteString("    " + mermaidNode(ids[node.ID], node) + "\n")
+		}
+		b.WriteString("  end\n")
+	}
+	for _, node := range loose {
+		b.WriteString("  " + mermaidNode(ids[node.ID], node) + "\n")
+	}
+
+	for _, edge := range g.Edges {
+		source, target := ids[edge.Source], ids[edge.Target]
+		if edge.Label != "" {
+			fmt.Fprintf(&b, "  %s -->|%s| %s\n", source, mermaidQuote(edge.Label), target)
+		} else {
+			fmt.Fprintf(&b, "  %s --> %s\n", source, target)
+		}
+	}
+
+	var roots []string
+	for _, node := range nodes {
+		if node.Root {
+			roots = append(roots, ids[node.ID])
+		}
+	}
+	if len(roots) > 0 {
+		b.WriteString("  classDef root stroke-width:3px\n")
+		fmt.Fprintf(&b, "  class %s root\n", strings.Join(roots, ","))
+	}
+	return b.String()
+}
+
+// mermaidNode returns the statement declaring node under id.
+func mermaidNode(id string, node Node) string {
+	shape, ok := mermaidShapes[node.Type]
+	if !ok {
+		shape = [2]string{"[", "]"}
+	}
+	return id + shape[0] + mermaidQuote(node.Label) + shape[1]
+}
+
+// mermaidQuote returns s as a double-quoted Mermaid label. Quotes are written as
+// entity codes, which is the only escape Mermaid labels support.
+func mermaidQuote(s string) string {
+	s = strings.ReplaceAll(s, `"`, "#quot;")
+	s = strings.ReplaceAll(s, "\n", " ")
+	return `"` + s + `"`
+}
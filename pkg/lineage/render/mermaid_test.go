@@ -0,0 +1,54 @@
+package render
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMermaid(t *testing.T) {
+	out := Mermaid(sampleGraph())
+
+	want := `flowchart LR
+  n0[("orders")]
+  n1[["load"]]
+  n2[("clean #quot;v2#quot;")]
+  n3[/"revenue"/]
+  n0 --> n1
+  n1 -->|"nightly"| n2
+  n2 --> n3
+  classDef root stroke-width:3px
+  class n0 root
+`
+	if out != want {
+		t.Errorf("Mermaid() =\n%s\nwant:\n%s", out, want)
+	}
+}
+
+func TestMermaidSubgraphs(t *testing.T) {
+	g := &Graph{
+		Nodes: []Node{
+			{ID: "raw#amount", Label: "amount", Type: "DATASET", Group: "raw"},
+			{ID: "report#total", Label: "total", Type: "CHART", Group: "report", Root: true},
+		},
+		Edges:  []Edge{{Source: "raw#amount", Target: "report#total", Label: "SUM"}},
+		Groups: []Group{{ID: "raw", Label: "raw"}, {ID: "report", Label: "report"}},
+	}
+	out := Mermaid(g)
+	for _, want := range []string{
+		"  subgraph g0 [\"raw\"]\n    n0[(\"amount\")]\n  end\n",
+		"  subgraph g1 [\"report\"]\n    n1[/\"total\"/]\n  end\n",
+		"  n0 -->|\"SUM\"| n1\n",
+		"  class n1 root\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Mermaid() missing %q in:\n%s", want, out)
+		}
+	}
+}
+
+func TestMermaidNoRoot(t *testing.T) {
+	out := Mermaid(&Graph{Nodes: []Node{{ID: "a", Label: "a\nb"}}})
+	if out != "flowchart LR\n  n0[\"a b\"]\n" {
+		t.Errorf("Mermaid() = %q", out)
+	}
+}
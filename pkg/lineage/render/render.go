@@ -0,0 +1,151 @@
+// Package render draws DataHub lineage as Graphviz DOT, Mermaid flowcharts or
+// GraphML, so that it can be pasted into documents and pull requests or rendered
+// inline by MCP clients.
+//
+// Build a [Graph] from entity lineage with [FromLineage], or from column lineage
+// with [FromColumnLineage] or [FromColumnTrace], then render it:
+//
+//	graph := render.FromLineage(result)
+//	text, err := render.Render(graph, render.FormatMermaid)
+package render
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Output formats accepted by [Render] and [ParseFormat].
+const (
+	// FormatJSON leaves lineage as JSON; [Render] does not produce it.
+	FormatJSON = "json"
+
+	// FormatDOT is a Graphviz digraph.
+	FormatDOT = "dot"
+
+	// FormatMermaid is a Mermaid flowchart.
+	FormatMermaid = "mermaid"
+
+	// FormatGraphML is a GraphML document.
+	FormatGraphML = "graphml"
+)
+
+// Graph is a directed lineage graph ready to render. Edges point in the direction
+// data flows.
+type Graph struct {
+	// Name identifies the graph in formats that name graphs.
+	Name string
+
+	// Nodes are the entities or columns in the graph.
+	Nodes []Node
+
+	// Edges connect nodes by ID.
+	Edges []Edge
+
+	// Groups cluster nodes, such as the columns of one dataset.
+	Groups []Group
+}
+
+// Node is a vertex of a lineage graph.
+type Node struct {
+	// ID uniquely identifies the node, e.g. an entity URN.
+	ID string
+
+	// Label is the text shown for the node.
+	Label string
+
+	// Type is the DataHub entity type (DATASET, DATA_JOB, CHART, ...) and selects the shape.
+	Type string
+
+	// Platform is the data platform, when known.
+	Platform string
+
+	// Group is the ID of the group the node belongs to, if any.
+	Group string
+
+	// Root marks the entity or column the lineage was queried for.
+	Root bool
+}
+
+// Edge is a directed lineage relationship.
+type Edge struct {
+	// Source is the ID of the node data flows from.
+	Source string
+
+	// Target is the ID of the node data flows to.
+	Target string
+
+	// Label is optional text shown on the edge, such as a transform.
+	Label string
+}
+
+// Group is a cluster of nodes.
+type Group struct {
+	// ID identifies the group.
+	ID string
+
+	// Label is the text shown for the group.
+	Label string
+}
+
+// ParseFormat normalizes a format name. An empty name is FormatJSON.
+func ParseFormat(format string) (string, error) {
+	switch f := strings.ToLower(strings.TrimSpace(format)); f {
+	case "":
+		return FormatJSON, nil
+	case FormatJSON, FormatDOT, FormatMermaid, FormatGraphML:
+		return f, nil
+	default:
+		return "", fmt.Errorf("unknown format %q: use json, dot, mermaid or graphml", format)
+	}
+}
+
+// Render draws g in the given format: FormatDOT, FormatMermaid or FormatGraphML.
+func Render(g *Graph, format string) (string, error) {
+	f, err := ParseFormat(format)
+	if err != nil {
+		return "", err
+	}
+	switch f {
+	case FormatDOT:
+		return DOT(g), nil
+	case FormatMermaid:
+		return Mermaid(g), nil
+	case FormatGraphML:
+		return GraphML(g), nil
+	default:
+		return "", fmt.Errorf("format %q is not a graph format", f)
+	}
+}
+
+// layout returns every node to draw, including a plain node for each edge end
+// that is not one of g's nodes, split into the members of each group and the
+// nodes outside any known group.
+func layout(g *Graph) (nodes []Node, members map[string][]Node, loose []Node) {
+	nodes = append(nodes, g.Nodes...)
+	declared := make(map[string]bool, len(g.Nodes))
+	for _, node := range g.Nodes {
+		declared[node.ID] = true
+	}
+	for _, edge := range g.Edges {
+		for _, end := range []string{edge.Source, edge.Target} {
+			if !declared[end] {
+				declared[end] = true
+				nodes = append(nodes, Node{ID: end, Label: shortName(end), Type: urnType(end)})
+			}
+		}
+	}
+
+	known := make(map[string]bool, len(g.Groups))
+	for _, group := range g.Groups {
+		known[group.ID] = true
+	}
+	members = make(map[string][]Node)
+	for _, node := range nodes {
+		if node.Group != "" && known[node.Group] {
+			members[node.Group] = append(members[node.Group], node)
+		} else {
+			loose = append(loose, node)
+		}
+	}
+	return nodes, members, loose
+}
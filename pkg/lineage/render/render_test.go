@@ -0,0 +1,84 @@
+package render
+
+import (
+	"strings"
+	"testing"
+)
+
+// sampleGraph is orders -> load job -> clean, with orders as the root and an
+// edge into an entity that is not a node.
+func sampleGraph() *Graph {
+	return &Graph{
+		Name: "lineage",
+		Nodes: []Node{
+			{ID: "urn:li:dataset:orders", Label: "orders", Type: "DATASET", Platform: "snowflake", Root: true},
+			{ID: "urn:li:dataJob:load", Label: "load", Type: "DATA_JOB"},
+			{ID: "urn:li:dataset:clean", Label: `clean "v2"`, Type: "DATASET"},
+		},
+		Edges: []Edge{
+			{Source: "urn:li:dataset:orders", Target: "urn:li:dataJob:load"},
+			{Source: "urn:li:dataJob:load", Target: "urn:li:dataset:clean", Label: "nightly"},
+			{Source: "urn:li:dataset:clean", Target: "urn:li:chart:(looker,revenue)"},
+		},
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "", want: FormatJSON},
+		{in: "json", want: FormatJSON},
+		{in: " Mermaid ", want: FormatMermaid},
+		{in: "DOT", want: FormatDOT},
+		{in: "graphml", want: FormatGraphML},
+		{in: "svg", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseFormat(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseFormat(%q) = %q, %v; want %q, error %v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	g := sampleGraph()
+	for format, prefix := range map[string]string{
+		FormatDOT:     `digraph "lineage" {`,
+		FormatMermaid: "flowchart LR",
+		FormatGraphML: "<?xml",
+	} {
+		out, err := Render(g, format)
+		if err != nil || !strings.HasPrefix(out, prefix) {
+			t.Errorf("Render(%s) = %q, %v; want prefix %q", format, out, err, prefix)
+		}
+	}
+
+	if _, err := Render(g, FormatJSON); err == nil || !strings.Contains(err.Error(), "not a graph format") {
+		t.Errorf("Render(json) error = %v", err)
+	}
+	if _, err := Render(g, "png"); err == nil {
+		t.Error("Render(png) should fail")
+	}
+}
+
+func TestLayout(t *testing.T) {
+	g := &Graph{
+		Nodes:  []Node{{ID: "a", Group: "ds"}, {ID: "b", Group: "missing"}},
+		Edges:  []Edge{{Source: "a", Target: "urn:li:corpuser:jdoe"}},
+		Groups: []Group{{ID: "ds", Label: "Dataset"}},
+	}
+	nodes, members, loose := layout(g)
+	if len(nodes) != 3 || nodes[2].ID != "urn:li:corpuser:jdoe" || nodes[2].Label != "jdoe" {
+		t.Errorf("layout() nodes = %+v, want the edge end added with a short label", nodes)
+	}
+	if len(members["ds"]) != 1 || members["ds"][0].ID != "a" {
+		t.Errorf("layout() members = %+v", members)
+	}
+	if len(loose) != 2 || loose[0].ID != "b" {
+		t.Errorf("layout() loose = %+v, want the node with an unknown group and the edge end", loose)
+	}
+}
@@ -20,6 +20,7 @@ type ConnectionConfig struct {
 	URL string `json:"url"`
 
 	// Token is the personal access token. Inherits from primary if empty.
+	// May be a ${env:VAR} or ${file:/path} reference to keep secrets out of the JSON.
 	Token string `json:"token,omitempty"`
 
 	// Timeout is the request timeout in seconds. Inherits from primary if zero.
@@ -62,7 +63,7 @@ type Config struct {
 // Primary server configuration comes from standard DATAHUB_* variables.
 // Additional servers come from DATAHUB_ADDITIONAL_SERVERS as JSON:
 //
-//	{"staging": {"url": "https://staging.datahub.example.com", "token": "xxx"}}
+//	{"staging": {"url": "https://staging.datahub.example.com", "token": "${file:/secrets/staging}"}}
 //
 // The primary connection name can be customized via DATAHUB_CONNECTION_NAME
 // (defaults to "datahub").
@@ -124,6 +125,9 @@ func (c Config) ClientConfig(name string) (client.Config, error) {
 	}
 	if conn.Token != "" {
 		cfg.Token = conn.Token
+		// The primary's provider or OAuth2 mode must not shadow an explicit token
+		cfg.TokenProvider = nil
+		cfg.AuthMode = client.AuthModeToken
 	}
 	if conn.Timeout > 0 {
 		cfg.Timeout = time.Duration(conn.Timeout) * time.Second
@@ -160,6 +164,33 @@ func (c Config) ConnectionCount() int {
 	return 1 + len(c.Connections)
 }
 
+// WriteEnabled reports whether write operations are permitted on the named connection.
+// Connections without an explicit WriteEnabled override (including the primary)
+// inherit the given value, which is normally the toolkit-level setting.
+func (c Config) WriteEnabled(name string, inherited bool) bool {
+	if name == "" || name == c.Default {
+		return inherited
+	}
+	conn, ok := c.Connections[name]
+	if !ok || conn.WriteEnabled == nil {
+		return inherited
+	}
+	return *conn.WriteEnabled
+}
+
+// AnyWriteEnabled returns true if write operations are permitted on at least one connection.
+func (c Config) AnyWriteEnabled(inherited bool) bool {
+	if inherited {
+		return true
+	}
+	for _, conn := range c.Connections {
+		if conn.WriteEnabled != nil && *conn.WriteEnabled {
+			return true
+		}
+	}
+	return false
+}
+
 // ConnectionInfo holds display information about a connection.
 type ConnectionInfo struct {
 	Name      string `json:"name"`
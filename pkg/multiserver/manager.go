@@ -1,23 +1,62 @@
 package multiserver
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"reflect"
 	"sync"
 
 	"github.com/txn2/mcp-datahub/pkg/client"
 )
 
+// Sentinel errors for connection registration.
+var (
+	// ErrConnectionExists indicates a connection with the given name is already registered.
+	ErrConnectionExists = errors.New("connection already exists")
+
+	// ErrConnectionNotFound indicates no connection with the given name is registered.
+	ErrConnectionNotFound = errors.New("connection not found")
+
+	// ErrDefaultConnection indicates the operation is not permitted on the primary connection.
+	ErrDefaultConnection = errors.New("operation not permitted on the default connection")
+
+	// ErrWriteToolsUnavailable indicates a connection enables writes but no write tools are registered.
+	ErrWriteToolsUnavailable = errors.New("write tools are not registered")
+)
+
+// ConnectionSource provides connection definitions from an external registry.
+// Implementations return the complete set of additional (non-primary) connections;
+// Manager.Sync reconciles the manager against it.
+type ConnectionSource interface {
+	Connections(ctx context.Context) (map[string]ConnectionConfig, error)
+}
+
+// ConnectionSourceFunc is a function adapter for ConnectionSource.
+type ConnectionSourceFunc func(ctx context.Context) (map[string]ConnectionConfig, error)
+
+// Connections implements ConnectionSource.
+func (f ConnectionSourceFunc) Connections(ctx context.Context) (map[string]ConnectionConfig, error) {
+	return f(ctx)
+}
+
 // Manager manages connections to multiple DataHub servers.
-// It lazily creates client connections on first use.
+// It lazily creates client connections on first use. Connections may be added,
+// updated and removed at runtime; all methods are safe for concurrent use.
 type Manager struct {
-	config  Config
-	clients map[string]*client.Client
-	mu      sync.RWMutex
+	config       Config
+	clients      map[string]*client.Client
+	rejectWrites bool
+	mu           sync.RWMutex
 }
 
 // NewManager creates a new connection manager with the given configuration.
 // Clients are created lazily on first access, not at construction time.
+// The Connections map is copied, so later changes through AddConnection,
+// UpdateConnection, RemoveConnection and Sync never touch the caller's map.
 func NewManager(cfg Config) *Manager {
+	cfg.Connections = maps.Clone(cfg.Connections)
 	return &Manager{
 		config:  cfg,
 		clients: make(map[string]*client.Client),
@@ -37,13 +76,12 @@ func NewManagerFromEnv() (*Manager, error) {
 // If name is empty, returns the primary connection's client.
 // Clients are created lazily and cached for reuse.
 func (m *Manager) Client(name string) (*client.Client, error) {
+	// Check cache first (read lock)
+	m.mu.RLock()
 	// Normalize empty to default
 	if name == "" {
 		name = m.config.Default
 	}
-
-	// Check cache first (read lock)
-	m.mu.RLock()
 	if c, ok := m.clients[name]; ok {
 		m.mu.RUnlock()
 		return c, nil
@@ -82,26 +120,49 @@ func (m *Manager) Client(name string) (*client.Client, error) {
 
 // DefaultClient returns the default (primary) connection's client.
 func (m *Manager) DefaultClient() (*client.Client, error) {
-	return m.Client(m.config.Default)
+	return m.Client("")
 }
 
 // Connections returns the names of all configured connections.
 func (m *Manager) Connections() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	return m.config.ConnectionNames()
 }
 
 // ConnectionInfos returns information about all configured connections.
 func (m *Manager) ConnectionInfos() []ConnectionInfo {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	return m.config.ConnectionInfos()
 }
 
 // ConnectionCount returns the number of configured connections.
 func (m *Manager) ConnectionCount() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	return m.config.ConnectionCount()
 }
 
+// WriteEnabled reports whether write operations are permitted on the named connection.
+// Connections without an explicit override inherit the given value.
+func (m *Manager) WriteEnabled(name string, inherited bool) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.config.WriteEnabled(name, inherited)
+}
+
+// AnyWriteEnabled returns true if write operations are permitted on at least one connection.
+func (m *Manager) AnyWriteEnabled(inherited bool) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.config.AnyWriteEnabled(inherited)
+}
+
 // HasConnection returns true if the named connection exists.
 func (m *Manager) HasConnection(name string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	if name == "" || name == m.config.Default {
 		return true
 	}
@@ -109,9 +170,189 @@ func (m *Manager) HasConnection(name string) bool {
 	return ok
 }
 
-// Config returns the manager's configuration.
+// Config returns a snapshot of the manager's configuration.
+// The returned Connections map is a copy and may be modified freely.
 func (m *Manager) Config() Config {
-	return m.config
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	cfg := m.config
+	cfg.Connections = maps.Clone(m.config.Connections)
+	if cfg.Connections == nil {
+		cfg.Connections = make(map[string]ConnectionConfig)
+	}
+	return cfg
+}
+
+// RejectWriteConnections controls whether AddConnection, UpdateConnection and Sync
+// refuse connections that enable write operations. Toolkits turn it on when they
+// register no write tools, so a connection added later cannot enable writes that
+// no tool exposes.
+func (m *Manager) RejectWriteConnections(reject bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.rejectWrites = reject
+}
+
+// AddConnection registers a new additional connection.
+// The client is created lazily on first use, like statically configured connections.
+// Returns ErrConnectionExists if the name is already registered, or
+// ErrWriteToolsUnavailable if conn enables writes while RejectWriteConnections is on.
+func (m *Manager) AddConnection(name string, conn ConnectionConfig) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.checkMutableLocked(name); err != nil {
+		return err
+	}
+	if _, ok := m.config.Connections[name]; ok {
+		return fmt.Errorf("adding connection %q: %w", name, ErrConnectionExists)
+	}
+	if err := m.validateLocked(name, conn); err != nil {
+		return err
+	}
+
+	m.setConnectionLocked(name, conn)
+	return nil
+}
+
+// UpdateConnection replaces the configuration of an existing additional connection.
+// Any cached client is closed and a new one is created on next use.
+// Returns ErrConnectionNotFound if the name is not registered, or
+// ErrWriteToolsUnavailable if conn enables writes while RejectWriteConnections is on.
+func (m *Manager) UpdateConnection(name string, conn ConnectionConfig) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.checkMutableLocked(name); err != nil {
+		return err
+	}
+	if _, ok := m.config.Connections[name]; !ok {
+		return fmt.Errorf("updating connection %q: %w", name, ErrConnectionNotFound)
+	}
+	if err := m.validateLocked(name, conn); err != nil {
+		return err
+	}
+
+	m.setConnectionLocked(name, conn)
+	return m.closeClientLocked(name)
+}
+
+// RemoveConnection removes an additional connection and closes its cached client.
+// Requests already in flight on the removed client are allowed to complete.
+// Returns ErrConnectionNotFound if the name is not registered.
+func (m *Manager) RemoveConnection(name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.checkMutableLocked(name); err != nil {
+		return err
+	}
+	if _, ok := m.config.Connections[name]; !ok {
+		return fmt.Errorf("removing connection %q: %w", name, ErrConnectionNotFound)
+	}
+
+	delete(m.config.Connections, name)
+	return m.closeClientLocked(name)
+}
+
+// Sync reconciles the additional connections with those provided by src.
+// Connections missing from the source are removed, new ones are added and
+// changed ones are updated. The primary connection is never touched.
+// Invalid definitions are skipped and reported in the returned error, while
+// the remaining connections are still applied. As with AddConnection, connections
+// that enable writes are rejected while RejectWriteConnections is on.
+func (m *Manager) Sync(ctx context.Context, src ConnectionSource) error {
+	desired, err := src.Connections(ctx)
+	if err != nil {
+		return fmt.Errorf("loading connections from source: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var errs []error
+	for name := range m.config.Connections {
+		if _, ok := desired[name]; ok {
+			continue
+		}
+		delete(m.config.Connections, name)
+		if closeErr := m.closeClientLocked(name); closeErr != nil {
+			errs = append(errs, closeErr)
+		}
+	}
+
+	for name, conn := range desired {
+		if name == m.config.Default {
+			errs = append(errs, fmt.Errorf("connection %q: %w", name, ErrDefaultConnection))
+			continue
+		}
+		if validateErr := m.validateLocked(name, conn); validateErr != nil {
+			errs = append(errs, validateErr)
+			continue
+		}
+		existing, ok := m.config.Connections[name]
+		if ok && reflect.DeepEqual(existing, conn) {
+			continue
+		}
+		m.setConnectionLocked(name, conn)
+		if ok {
+			if closeErr := m.closeClientLocked(name); closeErr != nil {
+				errs = append(errs, closeErr)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// checkMutableLocked returns an error if the named connection cannot be changed at runtime.
+// The caller must hold m.mu.
+func (m *Manager) checkMutableLocked(name string) error {
+	if name == "" || name == m.config.Default {
+		return fmt.Errorf("connection %q: %w", name, ErrDefaultConnection)
+	}
+	return nil
+}
+
+// validateLocked checks that conn, merged with the primary configuration, is usable.
+// The caller must hold m.mu.
+func (m *Manager) validateLocked(name string, conn ConnectionConfig) error {
+	if m.rejectWrites && conn.WriteEnabled != nil && *conn.WriteEnabled {
+		return fmt.Errorf("connection %q: %w", name, ErrWriteToolsUnavailable)
+	}
+	cfg := m.config
+	cfg.Connections = map[string]ConnectionConfig{name: conn}
+	clientCfg, err := cfg.ClientConfig(name)
+	if err != nil {
+		return err
+	}
+	if validateErr := clientCfg.Validate(); validateErr != nil {
+		return fmt.Errorf("invalid config for connection %q: %w", name, validateErr)
+	}
+	return nil
+}
+
+// setConnectionLocked stores the connection configuration.
+// The caller must hold m.mu.
+func (m *Manager) setConnectionLocked(name string, conn ConnectionConfig) {
+	if m.config.Connections == nil {
+		m.config.Connections = make(map[string]ConnectionConfig)
+	}
+	m.config.Connections[name] = conn
+}
+
+// closeClientLocked closes and evicts the cached client for the named connection, if any.
+// The caller must hold m.mu.
+func (m *Manager) closeClientLocked(name string) error {
+	c, ok := m.clients[name]
+	if !ok {
+		return nil
+	}
+	delete(m.clients, name)
+	if err := c.Close(); err != nil {
+		return fmt.Errorf("closing connection %q: %w", name, err)
+	}
+	return nil
 }
 
 // Close closes all open client connections.
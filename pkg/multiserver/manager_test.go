@@ -1,6 +1,10 @@
 package multiserver
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -197,6 +201,72 @@ func TestConfig_ClientConfig(t *testing.T) {
 	}
 }
 
+func TestConfig_ClientConfig_TokenOverrideClearsProvider(t *testing.T) {
+	cfg := Config{
+		Default: "default",
+		Primary: client.Config{
+			URL:           "https://prod.datahub.example.com",
+			TokenProvider: client.NewFileTokenProvider("/run/secrets/prod"),
+		},
+		Connections: map[string]ConnectionConfig{
+			"staging": {URL: "https://staging.datahub.example.com", Token: "${file:/run/secrets/staging}"},
+			"dev":     {URL: "https://dev.datahub.example.com"},
+		},
+	}
+
+	staging, err := cfg.ClientConfig("staging")
+	if err != nil {
+		t.Fatalf("ClientConfig(staging) error: %v", err)
+	}
+	if staging.TokenProvider != nil {
+		t.Error("explicit token should replace the primary's TokenProvider")
+	}
+	if staging.Token != "${file:/run/secrets/staging}" {
+		t.Errorf("Token = %q, want reference", staging.Token)
+	}
+
+	dev, err := cfg.ClientConfig("dev")
+	if err != nil {
+		t.Fatalf("ClientConfig(dev) error: %v", err)
+	}
+	if dev.TokenProvider == nil {
+		t.Error("connection without a token should inherit the primary's TokenProvider")
+	}
+}
+
+func TestConfig_ClientConfig_TokenOverrideClearsOAuth2(t *testing.T) {
+	cfg := Config{
+		Default: "default",
+		Primary: client.Config{
+			URL:      "https://prod.datahub.example.com",
+			AuthMode: client.AuthModeOAuth2,
+			OAuth2: client.OAuth2Config{
+				ClientID: "id", ClientSecret: "secret", TokenURL: "https://sso.example.com/token",
+			},
+		},
+		Connections: map[string]ConnectionConfig{
+			"staging": {URL: "https://staging.datahub.example.com", Token: "staging-token"},
+			"dev":     {URL: "https://dev.datahub.example.com"},
+		},
+	}
+
+	staging, err := cfg.ClientConfig("staging")
+	if err != nil {
+		t.Fatalf("ClientConfig(staging) error: %v", err)
+	}
+	if staging.AuthMode != client.AuthModeToken {
+		t.Errorf("AuthMode = %q, explicit token should switch to token mode", staging.AuthMode)
+	}
+
+	dev, err := cfg.ClientConfig("dev")
+	if err != nil {
+		t.Fatalf("ClientConfig(dev) error: %v", err)
+	}
+	if dev.AuthMode != client.AuthModeOAuth2 {
+		t.Errorf("AuthMode = %q, connection without a token should inherit oauth2", dev.AuthMode)
+	}
+}
+
 func TestConfig_ConnectionNames(t *testing.T) {
 	cfg := Config{
 		Default: "default",
@@ -252,6 +322,53 @@ func TestConfig_ConnectionInfos(t *testing.T) {
 	}
 }
 
+func TestConfig_WriteEnabled(t *testing.T) {
+	enabled, disabled := true, false
+	cfg := Config{
+		Default: "prod",
+		Primary: client.Config{URL: "https://prod.example.com", Token: "token"},
+		Connections: map[string]ConnectionConfig{
+			"staging": {URL: "https://staging.example.com", WriteEnabled: &enabled},
+			"archive": {URL: "https://archive.example.com", WriteEnabled: &disabled},
+			"dev":     {URL: "https://dev.example.com"},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		inherited bool
+		want      bool
+	}{
+		{"", false, false},
+		{"prod", true, true},
+		{"staging", false, true},
+		{"archive", true, false},
+		{"dev", false, false},
+		{"dev", true, true},
+		{"unknown", true, true},
+	}
+
+	for _, tt := range tests {
+		if got := cfg.WriteEnabled(tt.name, tt.inherited); got != tt.want {
+			t.Errorf("WriteEnabled(%q, %v) = %v, want %v", tt.name, tt.inherited, got, tt.want)
+		}
+	}
+
+	if !cfg.AnyWriteEnabled(false) {
+		t.Error("AnyWriteEnabled(false) should be true when a connection enables writes")
+	}
+
+	cfg.Connections = map[string]ConnectionConfig{
+		"archive": {URL: "https://archive.example.com", WriteEnabled: &disabled},
+	}
+	if cfg.AnyWriteEnabled(false) {
+		t.Error("AnyWriteEnabled(false) should be false when no connection enables writes")
+	}
+	if !cfg.AnyWriteEnabled(true) {
+		t.Error("AnyWriteEnabled(true) should be true")
+	}
+}
+
 func TestManager_HasConnection(t *testing.T) {
 	cfg := Config{
 		Default: "default",
@@ -613,3 +730,256 @@ func TestManager_Client_InheritsFromPrimary(t *testing.T) {
 		t.Errorf("unexpected error: %v - staging should inherit from primary", err)
 	}
 }
+
+func newDynamicTestManager() *Manager {
+	return NewManager(Config{
+		Default: "default",
+		Primary: client.Config{
+			URL:   "https://datahub.example.com",
+			Token: "test-token",
+		},
+		Connections: map[string]ConnectionConfig{
+			"staging": {URL: "https://staging.example.com"},
+		},
+	})
+}
+
+func TestManager_AddConnection(t *testing.T) {
+	mgr := newDynamicTestManager()
+	defer func() { _ = mgr.Close() }()
+
+	if err := mgr.AddConnection("tenant-a", ConnectionConfig{URL: "https://a.example.com", Token: "a-token"}); err != nil {
+		t.Fatalf("AddConnection() unexpected error: %v", err)
+	}
+	if !mgr.HasConnection("tenant-a") {
+		t.Error("expected tenant-a to be registered")
+	}
+	if got := mgr.ConnectionCount(); got != 3 {
+		t.Errorf("ConnectionCount() = %d, want 3", got)
+	}
+
+	c, err := mgr.Client("tenant-a")
+	if err != nil {
+		t.Fatalf("Client(tenant-a) unexpected error: %v", err)
+	}
+	if c.Config().URL != "https://a.example.com" {
+		t.Errorf("client URL = %q, want %q", c.Config().URL, "https://a.example.com")
+	}
+
+	if err := mgr.AddConnection("tenant-a", ConnectionConfig{URL: "https://a.example.com"}); !errors.Is(err, ErrConnectionExists) {
+		t.Errorf("AddConnection() duplicate error = %v, want ErrConnectionExists", err)
+	}
+	if err := mgr.AddConnection("default", ConnectionConfig{URL: "https://x.example.com"}); !errors.Is(err, ErrDefaultConnection) {
+		t.Errorf("AddConnection() default error = %v, want ErrDefaultConnection", err)
+	}
+}
+
+func TestManager_AddConnection_Invalid(t *testing.T) {
+	mgr := NewManager(Config{
+		Default: "default",
+		Primary: client.Config{URL: "https://datahub.example.com"},
+	})
+
+	err := mgr.AddConnection("tenant-a", ConnectionConfig{URL: "https://a.example.com"})
+	if err == nil {
+		t.Fatal("AddConnection() should fail when no token is available")
+	}
+	if mgr.HasConnection("tenant-a") {
+		t.Error("invalid connection should not be registered")
+	}
+}
+
+func TestManager_UpdateConnection(t *testing.T) {
+	mgr := newDynamicTestManager()
+	defer func() { _ = mgr.Close() }()
+
+	before, err := mgr.Client("staging")
+	if err != nil {
+		t.Fatalf("Client(staging) unexpected error: %v", err)
+	}
+
+	if err := mgr.UpdateConnection("staging", ConnectionConfig{URL: "https://staging2.example.com"}); err != nil {
+		t.Fatalf("UpdateConnection() unexpected error: %v", err)
+	}
+
+	after, err := mgr.Client("staging")
+	if err != nil {
+		t.Fatalf("Client(staging) unexpected error: %v", err)
+	}
+	if after == before {
+		t.Error("expected a new client after update")
+	}
+	if after.Config().URL != "https://staging2.example.com" {
+		t.Errorf("client URL = %q, want updated URL", after.Config().URL)
+	}
+
+	if err := mgr.UpdateConnection("missing", ConnectionConfig{URL: "https://x.example.com"}); !errors.Is(err, ErrConnectionNotFound) {
+		t.Errorf("UpdateConnection() missing error = %v, want ErrConnectionNotFound", err)
+	}
+}
+
+func TestManager_RemoveConnection(t *testing.T) {
+	mgr := newDynamicTestManager()
+	defer func() { _ = mgr.Close() }()
+
+	if _, err := mgr.Client("staging"); err != nil {
+		t.Fatalf("Client(staging) unexpected error: %v", err)
+	}
+
+	if err := mgr.RemoveConnection("staging"); err != nil {
+		t.Fatalf("RemoveConnection() unexpected error: %v", err)
+	}
+	if mgr.HasConnection("staging") {
+		t.Error("staging should be removed")
+	}
+	if _, err := mgr.Client("staging"); err == nil {
+		t.Error("Client(staging) should fail after removal")
+	}
+
+	if err := mgr.RemoveConnection("staging"); !errors.Is(err, ErrConnectionNotFound) {
+		t.Errorf("RemoveConnection() missing error = %v, want ErrConnectionNotFound", err)
+	}
+	if err := mgr.RemoveConnection(""); !errors.Is(err, ErrDefaultConnection) {
+		t.Errorf("RemoveConnection(\"\") error = %v, want ErrDefaultConnection", err)
+	}
+}
+
+func TestManager_Config_ReturnsCopy(t *testing.T) {
+	mgr := newDynamicTestManager()
+
+	cfg := mgr.Config()
+	cfg.Connections["injected"] = ConnectionConfig{URL: "https://x.example.com"}
+
+	if mgr.HasConnection("injected") {
+		t.Error("modifying the returned config should not affect the manager")
+	}
+}
+
+func TestNewManager_CopiesConnections(t *testing.T) {
+	connections := map[string]ConnectionConfig{"staging": {URL: "https://staging.example.com"}}
+	mgr := NewManager(Config{
+		Default:     "default",
+		Primary:     client.Config{URL: "https://datahub.example.com", Token: "token"},
+		Connections: connections,
+	})
+
+	if err := mgr.AddConnection("tenant-a", ConnectionConfig{URL: "https://a.example.com"}); err != nil {
+		t.Fatalf("AddConnection() unexpected error: %v", err)
+	}
+	if err := mgr.RemoveConnection("staging"); err != nil {
+		t.Fatalf("RemoveConnection() unexpected error: %v", err)
+	}
+	if _, ok := connections["staging"]; !ok || len(connections) != 1 {
+		t.Errorf("caller's Connections = %v, want it unchanged", connections)
+	}
+}
+
+func TestManager_RejectWriteConnections(t *testing.T) {
+	mgr := newDynamicTestManager()
+	defer func() { _ = mgr.Close() }()
+
+	enabled, disabled := true, false
+	mgr.RejectWriteConnections(true)
+
+	err := mgr.AddConnection("tenant-a", ConnectionConfig{URL: "https://a.example.com", WriteEnabled: &enabled})
+	if !errors.Is(err, ErrWriteToolsUnavailable) {
+		t.Errorf("AddConnection() write-enabled error = %v, want ErrWriteToolsUnavailable", err)
+	}
+	if err := mgr.AddConnection("tenant-b", ConnectionConfig{URL: "https://b.example.com", WriteEnabled: &disabled}); err != nil {
+		t.Errorf("AddConnection() read-only unexpected error: %v", err)
+	}
+	err = mgr.UpdateConnection("tenant-b", ConnectionConfig{URL: "https://b.example.com", WriteEnabled: &enabled})
+	if !errors.Is(err, ErrWriteToolsUnavailable) {
+		t.Errorf("UpdateConnection() write-enabled error = %v, want ErrWriteToolsUnavailable", err)
+	}
+
+	mgr.RejectWriteConnections(false)
+	if err := mgr.AddConnection("tenant-a", ConnectionConfig{URL: "https://a.example.com", WriteEnabled: &enabled}); err != nil {
+		t.Errorf("AddConnection() after allowing writes: %v", err)
+	}
+}
+
+func TestManager_Sync(t *testing.T) {
+	mgr := newDynamicTestManager()
+	defer func() { _ = mgr.Close() }()
+
+	stagingBefore, err := mgr.Client("staging")
+	if err != nil {
+		t.Fatalf("Client(staging) unexpected error: %v", err)
+	}
+
+	src := ConnectionSourceFunc(func(_ context.Context) (map[string]ConnectionConfig, error) {
+		return map[string]ConnectionConfig{
+			"staging":  {URL: "https://staging.example.com"},
+			"tenant-b": {URL: "https://b.example.com"},
+			"default":  {URL: "https://hijack.example.com"},
+		}, nil
+	})
+
+	err = mgr.Sync(context.Background(), src)
+	if !errors.Is(err, ErrDefaultConnection) {
+		t.Errorf("Sync() error = %v, want ErrDefaultConnection for default entry", err)
+	}
+	if !mgr.HasConnection("tenant-b") {
+		t.Error("tenant-b should be added by Sync")
+	}
+
+	stagingAfter, err := mgr.Client("staging")
+	if err != nil {
+		t.Fatalf("Client(staging) unexpected error: %v", err)
+	}
+	if stagingAfter != stagingBefore {
+		t.Error("unchanged connection should keep its cached client")
+	}
+
+	src = ConnectionSourceFunc(func(_ context.Context) (map[string]ConnectionConfig, error) {
+		return map[string]ConnectionConfig{}, nil
+	})
+	if err := mgr.Sync(context.Background(), src); err != nil {
+		t.Fatalf("Sync() unexpected error: %v", err)
+	}
+	if got := mgr.ConnectionCount(); got != 1 {
+		t.Errorf("ConnectionCount() after empty sync = %d, want 1", got)
+	}
+	if got := mgr.Config().Primary.URL; got != "https://datahub.example.com" {
+		t.Errorf("primary URL changed by Sync: %q", got)
+	}
+}
+
+func TestManager_Sync_SourceError(t *testing.T) {
+	mgr := newDynamicTestManager()
+
+	src := ConnectionSourceFunc(func(_ context.Context) (map[string]ConnectionConfig, error) {
+		return nil, errors.New("registry unavailable")
+	})
+	if err := mgr.Sync(context.Background(), src); err == nil {
+		t.Error("Sync() should return source error")
+	}
+	if !mgr.HasConnection("staging") {
+		t.Error("connections should be unchanged when the source fails")
+	}
+}
+
+func TestManager_DynamicConcurrentAccess(t *testing.T) {
+	mgr := newDynamicTestManager()
+	defer func() { _ = mgr.Close() }()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			name := fmt.Sprintf("tenant-%d", i%5)
+			_ = mgr.AddConnection(name, ConnectionConfig{URL: "https://t.example.com"})
+			_ = mgr.UpdateConnection(name, ConnectionConfig{URL: "https://t2.example.com"})
+			_ = mgr.RemoveConnection(name)
+		}(i)
+		go func(i int) {
+			defer wg.Done()
+			_, _ = mgr.Client(fmt.Sprintf("tenant-%d", i%5))
+			_ = mgr.ConnectionInfos()
+			_ = mgr.WriteEnabled("staging", false)
+		}(i)
+	}
+	wg.Wait()
+}
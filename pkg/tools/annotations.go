@@ -15,18 +15,38 @@ func boolPtr(b bool) *bool {
 //   - OpenWorldHint (*bool, default true): tool interacts with external entities
 var defaultAnnotations = map[ToolName]*mcp.ToolAnnotations{
 	// Read-only tools
-	ToolSearch:           {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
-	ToolGetEntity:        {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
-	ToolGetSchema:        {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
-	ToolGetLineage:       {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
-	ToolGetColumnLineage: {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
-	ToolGetQueries:       {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
-	ToolGetGlossaryTerm:  {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
-	ToolListTags:         {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
-	ToolListDomains:      {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
-	ToolListDataProducts: {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
-	ToolGetDataProduct:   {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
-	ToolListConnections:  {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolSearch:            {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolGetEntity:         {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolGetSchema:         {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolGetLineage:        {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolGetColumnLineage:  {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolGetQueries:        {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolGetGlossaryTerm:   {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolListTags:          {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolListDomains:       {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolListDataProducts:  {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolGetDataProduct:    {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolGetDatasetProfile: {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolGetUsageStats:     {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolGetAssertions:     {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolListIncidents:     {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolGetDataContract:   {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolBrowse:            {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolGetContainer:      {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolGetDashboard:      {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolGetChart:          {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolGetDataJob:        {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolGetDataFlow:       {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolGetUser:           {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolGetGroup:          {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolListOwnedEntities: {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolListGlossary:      {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolImpactAnalysis:    {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolTraceColumn:       {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolFindLineagePath:   {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolCheckFreshness:    {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolFederatedSearch:   {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolListConnections:   {ReadOnlyHint: true, IdempotentHint: true, OpenWorldHint: boolPtr(true)},
 
 	// Write tools
 	ToolUpdateDescription:  {DestructiveHint: boolPtr(false), IdempotentHint: true, OpenWorldHint: boolPtr(true)},
@@ -36,6 +56,10 @@ var defaultAnnotations = map[ToolName]*mcp.ToolAnnotations{
 	ToolRemoveGlossaryTerm: {DestructiveHint: boolPtr(false), IdempotentHint: true, OpenWorldHint: boolPtr(true)},
 	ToolAddLink:            {DestructiveHint: boolPtr(false), IdempotentHint: true, OpenWorldHint: boolPtr(true)},
 	ToolRemoveLink:         {DestructiveHint: boolPtr(false), IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolRaiseIncident:      {DestructiveHint: boolPtr(false), IdempotentHint: false, OpenWorldHint: boolPtr(true)},
+	ToolResolveIncident:    {DestructiveHint: boolPtr(false), IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolAddLineage:         {DestructiveHint: boolPtr(false), IdempotentHint: true, OpenWorldHint: boolPtr(true)},
+	ToolRemoveLineage:      {DestructiveHint: boolPtr(false), IdempotentHint: true, OpenWorldHint: boolPtr(true)},
 }
 
 // DefaultAnnotations returns the default annotations for a tool.
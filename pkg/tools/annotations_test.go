@@ -22,6 +22,26 @@ func TestDefaultAnnotations(t *testing.T) {
 		{ToolListDomains, false},
 		{ToolListDataProducts, false},
 		{ToolGetDataProduct, false},
+		{ToolGetDatasetProfile, false},
+		{ToolGetUsageStats, false},
+		{ToolGetAssertions, false},
+		{ToolListIncidents, false},
+		{ToolGetDataContract, false},
+		{ToolBrowse, false},
+		{ToolGetContainer, false},
+		{ToolGetDashboard, false},
+		{ToolGetChart, false},
+		{ToolGetDataJob, false},
+		{ToolGetDataFlow, false},
+		{ToolGetUser, false},
+		{ToolGetGroup, false},
+		{ToolListOwnedEntities, false},
+		{ToolListGlossary, false},
+		{ToolImpactAnalysis, false},
+		{ToolTraceColumn, false},
+		{ToolFindLineagePath, false},
+		{ToolCheckFreshness, false},
+		{ToolFederatedSearch, false},
 		{ToolListConnections, false},
 		{ToolUpdateDescription, false},
 		{ToolAddTag, false},
@@ -30,6 +50,10 @@ func TestDefaultAnnotations(t *testing.T) {
 		{ToolRemoveGlossaryTerm, false},
 		{ToolAddLink, false},
 		{ToolRemoveLink, false},
+		{ToolRaiseIncident, false},
+		{ToolResolveIncident, false},
+		{ToolAddLineage, false},
+		{ToolRemoveLineage, false},
 		{ToolName("unknown_tool"), true},
 	}
 
@@ -60,6 +84,14 @@ func TestDefaultAnnotations_ReadOnlyTools(t *testing.T) {
 		ToolGetColumnLineage, ToolGetQueries, ToolGetGlossaryTerm,
 		ToolListTags, ToolListDomains, ToolListDataProducts,
 		ToolGetDataProduct, ToolListConnections,
+		ToolGetDatasetProfile, ToolGetUsageStats,
+		ToolGetAssertions, ToolListIncidents, ToolGetDataContract,
+		ToolBrowse, ToolGetContainer, ToolGetDashboard,
+		ToolGetChart, ToolGetDataJob, ToolGetDataFlow,
+		ToolGetUser, ToolGetGroup, ToolListOwnedEntities,
+		ToolListGlossary, ToolImpactAnalysis, ToolTraceColumn,
+		ToolFindLineagePath, ToolCheckFreshness,
+		ToolFederatedSearch,
 	}
 
 	for _, name := range readOnlyTools {
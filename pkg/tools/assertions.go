@@ -0,0 +1,109 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+// GetAssertionsInput is the input for the get_assertions tool.
+type GetAssertionsInput struct {
+	URN      string `json:"urn" jsonschema_description:"The DataHub URN of the dataset"`
+	RunLimit int    `json:"run_limit,omitempty" jsonschema_description:"Maximum number of recent runs to return per assertion (default: 10)"`
+	// Connection is the named connection to use. Empty uses the default connection.
+	Connection string `json:"connection,omitempty" jsonschema_description:"Named connection to use (see datahub_list_connections)"`
+}
+
+func (t *Toolkit) registerGetAssertionsTool(server *mcp.Server, cfg *toolConfig) {
+	baseHandler := func(ctx context.Context, req *mcp.CallToolRequest, input any) (*mcp.CallToolResult, any, error) {
+		assertionsInput, ok := input.(GetAssertionsInput)
+		if !ok {
+			return ErrorResult("internal error: invalid input type"), nil, nil
+		}
+		return t.handleGetAssertions(ctx, req, assertionsInput)
+	}
+
+	wrappedHandler := t.wrapHandler(ToolGetAssertions, baseHandler, cfg)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:         string(ToolGetAssertions),
+		Description:  t.getDescription(ToolGetAssertions, cfg),
+		Annotations:  t.getAnnotations(ToolGetAssertions, cfg),
+		Icons:        t.getIcons(ToolGetAssertions, cfg),
+		Title:        t.getTitle(ToolGetAssertions, cfg),
+		OutputSchema: t.getOutputSchema(ToolGetAssertions, cfg),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, input GetAssertionsInput) (*mcp.CallToolResult, any, error) {
+		return wrappedHandler(ctx, req, input)
+	})
+}
+
+func (t *Toolkit) handleGetAssertions(
+	ctx context.Context,
+	_ *mcp.CallToolRequest,
+	input GetAssertionsInput,
+) (*mcp.CallToolResult, any, error) {
+	if input.URN == "" {
+		return ErrorResult("urn parameter is required"), nil, nil
+	}
+
+	var opts []client.AssertionOption
+	if input.RunLimit > 0 {
+		opts = append(opts, client.WithAssertionRunLimit(input.RunLimit))
+	}
+
+	datahubClient, err := t.getClient(input.Connection)
+	if err != nil {
+		return ErrorResult("Connection error: " + err.Error()), nil, nil
+	}
+
+	assertions, err := datahubClient.GetAssertions(ctx, input.URN, opts...)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return ErrorResult("Dataset not found: " + input.URN), nil, nil
+		}
+		return ErrorResult(err.Error()), nil, nil
+	}
+
+	return formatJSONResult(summarizeAssertions(input.URN, assertions))
+}
+
+// summarizeAssertions counts assertions by their latest result and sets a warning
+// when any of them is currently failing or erroring.
+func summarizeAssertions(urn string, assertions []types.Assertion) GetAssertionsOutput {
+	output := GetAssertionsOutput{
+		URN:        urn,
+		Total:      len(assertions),
+		Assertions: assertions,
+	}
+
+	for _, a := range assertions {
+		if a.LatestRun == nil {
+			output.NotRun++
+			continue
+		}
+		switch a.LatestRun.Result {
+		case types.AssertionResultSuccess:
+			output.Passing++
+		case types.AssertionResultFailure:
+			output.Failing++
+		case types.AssertionResultError:
+			output.Erroring++
+		default:
+			output.NotRun++
+		}
+	}
+
+	if output.Failing > 0 || output.Erroring > 0 {
+		output.Warning = fmt.Sprintf(
+			"%d of %d assertions are failing and %d are erroring on their latest run; "+
+				"verify data quality before relying on this dataset",
+			output.Failing, output.Total, output.Erroring)
+	}
+
+	return output
+}
@@ -0,0 +1,120 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+func TestHandleGetAssertions(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      GetAssertionsInput
+		mockErr    error
+		wantErr    bool
+		wantErrMsg string
+		wantOpts   int
+	}{
+		{
+			name:  "default run limit",
+			input: GetAssertionsInput{URN: "urn:li:dataset:test"},
+		},
+		{
+			name:     "with run limit",
+			input:    GetAssertionsInput{URN: "urn:li:dataset:test", RunLimit: 3},
+			wantOpts: 1,
+		},
+		{
+			name:       "empty URN",
+			input:      GetAssertionsInput{},
+			wantErr:    true,
+			wantErrMsg: "urn parameter is required",
+		},
+		{
+			name:       "dataset not found",
+			input:      GetAssertionsInput{URN: "urn:li:dataset:missing"},
+			mockErr:    fmt.Errorf("GetAssertions: %w", client.ErrNotFound),
+			wantErr:    true,
+			wantErrMsg: "Dataset not found",
+		},
+		{
+			name:    "client error",
+			input:   GetAssertionsInput{URN: "urn:li:dataset:test"},
+			mockErr: errors.New("assertions unavailable"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotOpts int
+			mock := &mockClient{
+				getAssertionsFunc: func(_ context.Context, _ string, opts ...client.AssertionOption) ([]types.Assertion, error) {
+					gotOpts = len(opts)
+					if tt.mockErr != nil {
+						return nil, tt.mockErr
+					}
+					return []types.Assertion{{URN: "urn:li:assertion:a"}}, nil
+				},
+			}
+			toolkit := NewToolkit(mock, DefaultConfig())
+
+			result, out, err := toolkit.handleGetAssertions(context.Background(), nil, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.IsError != tt.wantErr {
+				t.Fatalf("IsError = %v, want %v (%s)", result.IsError, tt.wantErr, resultText(result))
+			}
+			if tt.wantErr {
+				if tt.wantErrMsg != "" && !strings.Contains(resultText(result), tt.wantErrMsg) {
+					t.Errorf("error %q does not mention %q", resultText(result), tt.wantErrMsg)
+				}
+				return
+			}
+			if gotOpts != tt.wantOpts {
+				t.Errorf("passed %d options, want %d", gotOpts, tt.wantOpts)
+			}
+			summary, ok := out.(GetAssertionsOutput)
+			if !ok || summary.Total != 1 || summary.NotRun != 1 {
+				t.Errorf("unexpected output: %#v", out)
+			}
+		})
+	}
+}
+
+func TestSummarizeAssertions(t *testing.T) {
+	run := func(result string) *types.AssertionRun {
+		return &types.AssertionRun{Result: result}
+	}
+
+	t.Run("failing assertions produce a warning", func(t *testing.T) {
+		out := summarizeAssertions("urn:li:dataset:test", []types.Assertion{
+			{URN: "a", LatestRun: run(types.AssertionResultSuccess)},
+			{URN: "b", LatestRun: run(types.AssertionResultFailure)},
+			{URN: "c", LatestRun: run(types.AssertionResultError)},
+			{URN: "d", LatestRun: run(types.AssertionResultInit)},
+			{URN: "e"},
+		})
+		if out.Total != 5 || out.Passing != 1 || out.Failing != 1 || out.Erroring != 1 || out.NotRun != 2 {
+			t.Errorf("unexpected counts: %+v", out)
+		}
+		if !strings.Contains(out.Warning, "1 of 5 assertions are failing") {
+			t.Errorf("Warning = %q", out.Warning)
+		}
+	})
+
+	t.Run("healthy dataset has no warning", func(t *testing.T) {
+		out := summarizeAssertions("urn:li:dataset:test", []types.Assertion{
+			{URN: "a", LatestRun: run(types.AssertionResultSuccess)},
+		})
+		if out.Warning != "" || out.Passing != 1 {
+			t.Errorf("unexpected summary: %+v", out)
+		}
+	})
+}
@@ -46,6 +46,60 @@ type DataHubClient interface {
 	// GetDataProduct retrieves a data product by URN.
 	GetDataProduct(ctx context.Context, urn string) (*types.DataProduct, error)
 
+	// GetDatasetProfile retrieves the latest profile of a dataset.
+	GetDatasetProfile(ctx context.Context, urn string, opts ...client.ProfileOption) (*types.DatasetProfile, error)
+
+	// GetUsageStats retrieves aggregated usage statistics for a dataset.
+	GetUsageStats(ctx context.Context, urn string, opts ...client.UsageOption) (*types.UsageStats, error)
+
+	// GetAssertions retrieves data quality assertions and their recent runs for a dataset.
+	GetAssertions(ctx context.Context, urn string, opts ...client.AssertionOption) ([]types.Assertion, error)
+
+	// ListIncidents retrieves incidents raised on an entity.
+	ListIncidents(ctx context.Context, urn string, opts ...client.IncidentOption) (*types.IncidentList, error)
+
+	// GetDataContract retrieves the data contract on a dataset with its assertion results.
+	GetDataContract(ctx context.Context, urn string, opts ...client.AssertionOption) (*types.DataContract, error)
+
+	// GetContainer retrieves a container with its browse path.
+	GetContainer(ctx context.Context, urn string) (*types.Container, error)
+
+	// GetBrowsePath retrieves the container path of an entity.
+	GetBrowsePath(ctx context.Context, urn string) ([]types.PathEntry, error)
+
+	// Browse lists the direct children of a container, platform or platform instance.
+	Browse(ctx context.Context, urn string, opts ...client.BrowseOption) (*types.BrowseResult, error)
+
+	// GetDashboard retrieves a dashboard with its charts and their inputs.
+	GetDashboard(ctx context.Context, urn string) (*types.Dashboard, error)
+
+	// GetChart retrieves a chart with its query, inputs and dashboards.
+	GetChart(ctx context.Context, urn string) (*types.Chart, error)
+
+	// GetDataJob retrieves a data job with its inputs, outputs and recent runs.
+	GetDataJob(ctx context.Context, urn string, opts ...client.DataJobOption) (*types.Pipeline, error)
+
+	// GetDataFlow retrieves a data flow with its jobs and their latest runs.
+	GetDataFlow(ctx context.Context, urn string) (*types.DataFlow, error)
+
+	// GetUser retrieves a user's profile, contact details and groups.
+	GetUser(ctx context.Context, urn string) (*types.User, error)
+
+	// GetGroup retrieves a group with its contact details, owners and members.
+	GetGroup(ctx context.Context, urn string) (*types.Group, error)
+
+	// ListOwnedEntities lists the entities a user or group directly owns.
+	ListOwnedEntities(ctx context.Context, ownerURN string, opts ...client.BrowseOption) (*types.BrowseResult, error)
+
+	// ListGlossary lists the glossary nodes and terms under a node, or at the root.
+	ListGlossary(ctx context.Context, parentURN string, opts ...client.BrowseOption) (*types.GlossaryListing, error)
+
+	// TraceColumn follows one column's lineage across hops, upstream or downstream.
+	TraceColumn(ctx context.Context, datasetURN, column string, opts ...client.LineageOption) (*types.ColumnTrace, error)
+
+	// GetDatasetFreshness reports when a dataset's data last changed.
+	GetDatasetFreshness(ctx context.Context, urn string) (*types.Freshness, error)
+
 	// Ping tests the connection.
 	Ping(ctx context.Context) error
 
@@ -74,4 +128,16 @@ type DataHubClient interface {
 
 	// RemoveLink removes a link from an entity by URL.
 	RemoveLink(ctx context.Context, urn, linkURL string) error
+
+	// RaiseIncident raises a new incident on an entity and returns its URN.
+	RaiseIncident(ctx context.Context, input client.RaiseIncidentInput) (string, error)
+
+	// ResolveIncident marks an incident as resolved.
+	ResolveIncident(ctx context.Context, urn, message string) error
+
+	// AddLineage adds manual lineage edges.
+	AddLineage(ctx context.Context, edges ...client.LineageEdgeInput) error
+
+	// RemoveLineage removes lineage edges.
+	RemoveLineage(ctx context.Context, edges ...client.LineageEdgeInput) error
 }
@@ -4,11 +4,26 @@ import (
 	"context"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+	"github.com/txn2/mcp-datahub/pkg/lineage/render"
 )
 
 // GetColumnLineageInput is the input for the get_column_lineage tool.
 type GetColumnLineageInput struct {
-	URN string `json:"urn" jsonschema_description:"The DataHub URN of the dataset"`
+	URN    string `json:"urn" jsonschema_description:"The DataHub URN of the dataset"`
+	Format string `json:"format,omitempty" jsonschema_description:"Output format: json (default), dot, mermaid or graphml"`
+	// Connection is the named connection to use. Empty uses the default connection.
+	Connection string `json:"connection,omitempty" jsonschema_description:"Named connection to use (see datahub_list_connections)"`
+}
+
+// TraceColumnInput is the input for the trace_column tool.
+type TraceColumnInput struct {
+	URN       string `json:"urn" jsonschema_description:"The DataHub URN of the dataset that holds the column"`
+	Column    string `json:"column" jsonschema_description:"The column's field path (e.g. revenue_usd)"`
+	Direction string `json:"direction,omitempty" jsonschema_description:"UPSTREAM for sources (default) or DOWNSTREAM for dependents"`
+	Depth     int    `json:"depth,omitempty" jsonschema_description:"Maximum number of hops to trace (default and max: 5)"`
+	Format    string `json:"format,omitempty" jsonschema_description:"Output format: json (default), dot, mermaid or graphml"`
 	// Connection is the named connection to use. Empty uses the default connection.
 	Connection string `json:"connection,omitempty" jsonschema_description:"Named connection to use (see datahub_list_connections)"`
 }
@@ -44,6 +59,10 @@ func (t *Toolkit) handleGetColumnLineage(
 	if input.URN == "" {
 		return ErrorResult("urn parameter is required"), nil, nil
 	}
+	format, err := render.ParseFormat(input.Format)
+	if err != nil {
+		return ErrorResult(err.Error()), nil, nil
+	}
 
 	// Get client for the specified connection
 	datahubClient, err := t.getClient(input.Connection)
@@ -55,6 +74,9 @@ func (t *Toolkit) handleGetColumnLineage(
 	if err != nil {
 		return ErrorResult(err.Error()), nil, nil
 	}
+	if format != render.FormatJSON {
+		return formatGraphResult(render.FromColumnLineage(columnLineage), format, "")
+	}
 
 	jsonResult, err := JSONResult(columnLineage)
 	if err != nil {
@@ -63,3 +85,62 @@ func (t *Toolkit) handleGetColumnLineage(
 
 	return jsonResult, columnLineage, nil
 }
+
+func (t *Toolkit) registerTraceColumnTool(server *mcp.Server, cfg *toolConfig) {
+	baseHandler := func(ctx context.Context, req *mcp.CallToolRequest, input any) (*mcp.CallToolResult, any, error) {
+		traceInput, ok := input.(TraceColumnInput)
+		if !ok {
+			return ErrorResult("internal error: invalid input type"), nil, nil
+		}
+		return t.handleTraceColumn(ctx, req, traceInput)
+	}
+
+	wrappedHandler := t.wrapHandler(ToolTraceColumn, baseHandler, cfg)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:         string(ToolTraceColumn),
+		Description:  t.getDescription(ToolTraceColumn, cfg),
+		Annotations:  t.getAnnotations(ToolTraceColumn, cfg),
+		Icons:        t.getIcons(ToolTraceColumn, cfg),
+		Title:        t.getTitle(ToolTraceColumn, cfg),
+		OutputSchema: t.getOutputSchema(ToolTraceColumn, cfg),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, input TraceColumnInput) (*mcp.CallToolResult, any, error) {
+		return wrappedHandler(ctx, req, input)
+	})
+}
+
+func (t *Toolkit) handleTraceColumn(ctx context.Context, _ *mcp.CallToolRequest, input TraceColumnInput) (*mcp.CallToolResult, any, error) {
+	if input.URN == "" {
+		return ErrorResult("urn parameter is required"), nil, nil
+	}
+	if input.Column == "" {
+		return ErrorResult("column parameter is required"), nil, nil
+	}
+	format, err := render.ParseFormat(input.Format)
+	if err != nil {
+		return ErrorResult(err.Error()), nil, nil
+	}
+
+	datahubClient, err := t.getClient(input.Connection)
+	if err != nil {
+		return ErrorResult("Connection error: " + err.Error()), nil, nil
+	}
+
+	var opts []client.LineageOption
+	if input.Direction != "" {
+		opts = append(opts, client.WithDirection(input.Direction))
+	}
+	if input.Depth > 0 {
+		opts = append(opts, client.WithDepth(input.Depth))
+	}
+
+	trace, err := datahubClient.TraceColumn(ctx, input.URN, input.Column, opts...)
+	if err != nil {
+		return ErrorResult(err.Error()), nil, nil
+	}
+	if format != render.FormatJSON {
+		return formatGraphResult(render.FromColumnTrace(trace), format, "")
+	}
+
+	return formatJSONResult(trace)
+}
@@ -3,6 +3,7 @@ package tools
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/txn2/mcp-datahub/pkg/client"
@@ -134,3 +135,123 @@ func TestHandleGetColumnLineage_ConnectionError(t *testing.T) {
 		t.Error("handleGetColumnLineage() should return error for unknown connection")
 	}
 }
+
+func TestHandleTraceColumn(t *testing.T) {
+	var gotOpts int
+	mock := &mockClient{
+		traceColumnFunc: func(_ context.Context, datasetURN, column string, opts ...client.LineageOption) (*types.ColumnTrace, error) {
+			gotOpts = len(opts)
+			return &types.ColumnTrace{
+				DatasetURN: datasetURN,
+				Column:     column,
+				Hops: []types.ColumnHop{{
+					Level:      1,
+					Upstream:   types.ColumnRef{URN: "urn:li:dataset:source", Type: "DATASET", Field: "amount"},
+					Downstream: types.ColumnRef{URN: datasetURN, Type: "DATASET", Field: column},
+					SQL:        "SELECT amount AS revenue_usd FROM source",
+				}},
+				Endpoints: []types.ColumnRef{{URN: "urn:li:dataset:source", Type: "DATASET", Field: "amount"}},
+			}, nil
+		},
+	}
+
+	toolkit := NewToolkit(mock, DefaultConfig())
+	result, _, _ := toolkit.handleTraceColumn(context.Background(), nil, TraceColumnInput{
+		URN:       "urn:li:dataset:report",
+		Column:    "revenue_usd",
+		Direction: "DOWNSTREAM",
+		Depth:     2,
+	})
+	if result.IsError {
+		t.Fatalf("handleTraceColumn() error: %s", resultText(result))
+	}
+	if gotOpts != 2 {
+		t.Errorf("handleTraceColumn() passed %d options, want direction and depth", gotOpts)
+	}
+	if text := resultText(result); !strings.Contains(text, "revenue_usd") || !strings.Contains(text, "SELECT amount") {
+		t.Errorf("handleTraceColumn() result = %s", text)
+	}
+
+	result, _, _ = toolkit.handleTraceColumn(context.Background(), nil, TraceColumnInput{URN: "urn:li:dataset:report", Column: "revenue_usd"})
+	if result.IsError || gotOpts != 0 {
+		t.Errorf("handleTraceColumn() defaults: error=%v opts=%d, want no options", result.IsError, gotOpts)
+	}
+}
+
+func TestHandleColumnLineageFormat(t *testing.T) {
+	mock := &mockClient{
+		getColumnLineageFunc: func(_ context.Context, urn string) (*types.ColumnLineage, error) {
+			return &types.ColumnLineage{DatasetURN: urn, Mappings: []types.ColumnLineageMapping{
+				{DownstreamColumn: "total", UpstreamDataset: "urn:li:dataset:raw", UpstreamColumn: "amount", Transform: "SUM"},
+			}}, nil
+		},
+		traceColumnFunc: func(_ context.Context, datasetURN, column string, _ ...client.LineageOption) (*types.ColumnTrace, error) {
+			return &types.ColumnTrace{DatasetURN: datasetURN, Column: column, Hops: []types.ColumnHop{{
+				Level:      1,
+				Upstream:   types.ColumnRef{URN: "urn:li:dataset:raw", Type: "DATASET", Field: "amount"},
+				Downstream: types.ColumnRef{URN: datasetURN, Type: "DATASET", Field: column},
+			}}}, nil
+		},
+	}
+	toolkit := NewToolkit(mock, DefaultConfig())
+
+	report := "urn:li:dataset:report"
+	result, _, _ := toolkit.handleGetColumnLineage(context.Background(), nil, GetColumnLineageInput{URN: report, Format: "dot"})
+	want := `"urn:li:dataset:raw#amount" -> "urn:li:dataset:report#total" [label="SUM"];`
+	if text := resultText(result); result.IsError || !strings.Contains(text, want) {
+		t.Errorf("handleGetColumnLineage() dot = %s", text)
+	}
+
+	result, out, _ := toolkit.handleTraceColumn(context.Background(), nil, TraceColumnInput{
+		URN:    report,
+		Column: "total",
+		Format: "graphml",
+	})
+	if result.IsError || !strings.Contains(resultText(result), "```xml\n<?xml") {
+		t.Errorf("handleTraceColumn() graphml = %s", resultText(result))
+	}
+	if rendered, ok := out.(RenderedLineageOutput); !ok || !strings.Contains(rendered.Graph, `<data key="root">true</data>`) {
+		t.Errorf("handleTraceColumn() output = %#v", out)
+	}
+
+	result, _, _ = toolkit.handleGetColumnLineage(context.Background(), nil, GetColumnLineageInput{URN: report, Format: "svg"})
+	if !result.IsError || !strings.Contains(resultText(result), "unknown format") {
+		t.Errorf("handleGetColumnLineage() with bad format = %s", resultText(result))
+	}
+	result, _, _ = toolkit.handleTraceColumn(context.Background(), nil, TraceColumnInput{URN: report, Column: "total", Format: "svg"})
+	if !result.IsError || !strings.Contains(resultText(result), "unknown format") {
+		t.Errorf("handleTraceColumn() with bad format = %s", resultText(result))
+	}
+}
+
+func TestHandleTraceColumnErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      TraceColumnInput
+		mockErr    error
+		wantErrMsg string
+	}{
+		{name: "empty URN", input: TraceColumnInput{Column: "id"}, wantErrMsg: "urn parameter is required"},
+		{name: "empty column", input: TraceColumnInput{URN: "urn:li:dataset:test"}, wantErrMsg: "column parameter is required"},
+		{
+			name:       "client error",
+			input:      TraceColumnInput{URN: "urn:li:dataset:test", Column: "id"},
+			mockErr:    errors.New("direction must be UPSTREAM or DOWNSTREAM"),
+			wantErrMsg: "direction must be",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockClient{
+				traceColumnFunc: func(_ context.Context, _, _ string, _ ...client.LineageOption) (*types.ColumnTrace, error) {
+					return nil, tt.mockErr
+				},
+			}
+			toolkit := NewToolkit(mock, DefaultConfig())
+			result, _, _ := toolkit.handleTraceColumn(context.Background(), nil, tt.input)
+			if !result.IsError || !strings.Contains(resultText(result), tt.wantErrMsg) {
+				t.Errorf("handleTraceColumn() = %s, want error %q", resultText(result), tt.wantErrMsg)
+			}
+		})
+	}
+}
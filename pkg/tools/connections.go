@@ -19,9 +19,10 @@ type ListConnectionsOutput struct {
 
 // ConnectionInfoOutput provides information about a single connection.
 type ConnectionInfoOutput struct {
-	Name      string `json:"name"`
-	URL       string `json:"url"`
-	IsDefault bool   `json:"is_default"`
+	Name         string `json:"name"`
+	URL          string `json:"url"`
+	IsDefault    bool   `json:"is_default"`
+	WriteEnabled bool   `json:"write_enabled"`
 }
 
 // registerListConnectionsTool adds the datahub_list_connections tool to the server.
@@ -61,9 +62,10 @@ func (t *Toolkit) handleListConnections(_ context.Context, _ *mcp.CallToolReques
 
 	for i, info := range infos {
 		output.Connections[i] = ConnectionInfoOutput{
-			Name:      info.Name,
-			URL:       info.URL,
-			IsDefault: info.IsDefault,
+			Name:         info.Name,
+			URL:          info.URL,
+			IsDefault:    info.IsDefault,
+			WriteEnabled: t.isConnectionWriteEnabled(info.Name),
 		}
 	}
 
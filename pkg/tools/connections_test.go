@@ -109,6 +109,38 @@ func TestHandleListConnections_MultiServer(t *testing.T) {
 	}
 }
 
+func TestHandleListConnections_WriteEnabled(t *testing.T) {
+	enabled := true
+	mgr := multiserver.NewManager(multiserver.Config{
+		Default: "prod",
+		Primary: client.Config{URL: "https://prod.datahub.example.com", Token: "prod-token"},
+		Connections: map[string]multiserver.ConnectionConfig{
+			"staging": {URL: "https://staging.datahub.example.com", WriteEnabled: &enabled},
+		},
+	})
+	defer func() {
+		_ = mgr.Close()
+	}()
+
+	toolkit := NewToolkitWithManager(mgr, DefaultConfig())
+
+	_, out, err := toolkit.handleListConnections(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	output, ok := out.(*ListConnectionsOutput)
+	if !ok {
+		t.Fatalf("expected *ListConnectionsOutput, got %T", out)
+	}
+
+	want := map[string]bool{"prod": false, "staging": true}
+	for _, conn := range output.Connections {
+		if conn.WriteEnabled != want[conn.Name] {
+			t.Errorf("connection %q WriteEnabled = %v, want %v", conn.Name, conn.WriteEnabled, want[conn.Name])
+		}
+	}
+}
+
 func TestRegisterListConnectionsTool(t *testing.T) {
 	mock := &mockClient{}
 	toolkit := NewToolkit(mock, DefaultConfig())
@@ -0,0 +1,165 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+// BrowseInput is the input for the browse tool.
+type BrowseInput struct {
+	URN    string `json:"urn" jsonschema_description:"URN of a container, data platform or platform instance to list the children of"`
+	Limit  int    `json:"limit,omitempty" jsonschema_description:"Maximum number of children (default: 10, max: 100)"`
+	Offset int    `json:"offset,omitempty" jsonschema_description:"Result offset for pagination"`
+	// Connection is the named connection to use. Empty uses the default connection.
+	Connection string `json:"connection,omitempty" jsonschema_description:"Named connection to use (see datahub_list_connections)"`
+}
+
+// GetContainerInput is the input for the get_container tool.
+type GetContainerInput struct {
+	URN string `json:"urn" jsonschema_description:"The DataHub URN of a container, or of any entity to find the container it lives in"`
+	// Connection is the named connection to use. Empty uses the default connection.
+	Connection string `json:"connection,omitempty" jsonschema_description:"Named connection to use (see datahub_list_connections)"`
+}
+
+func (t *Toolkit) registerBrowseTool(server *mcp.Server, cfg *toolConfig) {
+	baseHandler := func(ctx context.Context, req *mcp.CallToolRequest, input any) (*mcp.CallToolResult, any, error) {
+		browseInput, ok := input.(BrowseInput)
+		if !ok {
+			return ErrorResult("internal error: invalid input type"), nil, nil
+		}
+		return t.handleBrowse(ctx, req, browseInput)
+	}
+
+	wrappedHandler := t.wrapHandler(ToolBrowse, baseHandler, cfg)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:         string(ToolBrowse),
+		Description:  t.getDescription(ToolBrowse, cfg),
+		Annotations:  t.getAnnotations(ToolBrowse, cfg),
+		Icons:        t.getIcons(ToolBrowse, cfg),
+		Title:        t.getTitle(ToolBrowse, cfg),
+		OutputSchema: t.getOutputSchema(ToolBrowse, cfg),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, input BrowseInput) (*mcp.CallToolResult, any, error) {
+		return wrappedHandler(ctx, req, input)
+	})
+}
+
+func (t *Toolkit) registerGetContainerTool(server *mcp.Server, cfg *toolConfig) {
+	baseHandler := func(ctx context.Context, req *mcp.CallToolRequest, input any) (*mcp.CallToolResult, any, error) {
+		containerInput, ok := input.(GetContainerInput)
+		if !ok {
+			return ErrorResult("internal error: invalid input type"), nil, nil
+		}
+		return t.handleGetContainer(ctx, req, containerInput)
+	}
+
+	wrappedHandler := t.wrapHandler(ToolGetContainer, baseHandler, cfg)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:         string(ToolGetContainer),
+		Description:  t.getDescription(ToolGetContainer, cfg),
+		Annotations:  t.getAnnotations(ToolGetContainer, cfg),
+		Icons:        t.getIcons(ToolGetContainer, cfg),
+		Title:        t.getTitle(ToolGetContainer, cfg),
+		OutputSchema: t.getOutputSchema(ToolGetContainer, cfg),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, input GetContainerInput) (*mcp.CallToolResult, any, error) {
+		return wrappedHandler(ctx, req, input)
+	})
+}
+
+func (t *Toolkit) handleBrowse(ctx context.Context, _ *mcp.CallToolRequest, input BrowseInput) (*mcp.CallToolResult, any, error) {
+	if input.URN == "" {
+		return ErrorResult("urn parameter is required"), nil, nil
+	}
+
+	var opts []client.BrowseOption
+	if input.Limit > 0 {
+		opts = append(opts, client.WithBrowseLimit(input.Limit))
+	}
+	if input.Offset > 0 {
+		opts = append(opts, client.WithBrowseOffset(input.Offset))
+	}
+
+	datahubClient, err := t.getClient(input.Connection)
+	if err != nil {
+		return ErrorResult("Connection error: " + err.Error()), nil, nil
+	}
+
+	result, err := datahubClient.Browse(ctx, input.URN, opts...)
+	if err != nil {
+		return ErrorResult(err.Error()), nil, nil
+	}
+
+	return formatJSONResult(result)
+}
+
+func (t *Toolkit) handleGetContainer(
+	ctx context.Context,
+	_ *mcp.CallToolRequest,
+	input GetContainerInput,
+) (*mcp.CallToolResult, any, error) {
+	if input.URN == "" {
+		return ErrorResult("urn parameter is required"), nil, nil
+	}
+
+	datahubClient, err := t.getClient(input.Connection)
+	if err != nil {
+		return ErrorResult("Connection error: " + err.Error()), nil, nil
+	}
+
+	output := GetContainerOutput{URN: input.URN}
+
+	if strings.HasPrefix(input.URN, "urn:li:container:") {
+		container, err := datahubClient.GetContainer(ctx, input.URN)
+		if err != nil {
+			return containerError(input.URN, err)
+		}
+		output.Container = container
+		output.Path = container.Path
+		if output.Path == nil {
+			output.Path = []types.PathEntry{}
+		}
+		return formatJSONResult(output)
+	}
+
+	path, err := datahubClient.GetBrowsePath(ctx, input.URN)
+	if err != nil {
+		return containerError(input.URN, err)
+	}
+	output.Path = path
+
+	// Resolve the innermost container the entity lives in.
+	if parent := innermostContainer(path); parent != "" {
+		container, err := datahubClient.GetContainer(ctx, parent)
+		if err != nil {
+			return containerError(parent, err)
+		}
+		output.Container = container
+	}
+
+	return formatJSONResult(output)
+}
+
+// innermostContainer returns the URN of the last container in a browse path, if any.
+func innermostContainer(path []types.PathEntry) string {
+	for i := len(path) - 1; i >= 0; i-- {
+		if strings.HasPrefix(path[i].URN, "urn:li:container:") {
+			return path[i].URN
+		}
+	}
+	return ""
+}
+
+// containerError maps a container lookup failure to a tool error result.
+func containerError(urn string, err error) (*mcp.CallToolResult, any, error) {
+	if errors.Is(err, client.ErrNotFound) {
+		return ErrorResult("Entity not found: " + urn), nil, nil
+	}
+	return ErrorResult(err.Error()), nil, nil
+}
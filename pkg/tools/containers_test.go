@@ -0,0 +1,154 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+func TestHandleBrowse(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    BrowseInput
+		mockErr  error
+		wantErr  bool
+		wantOpts int
+	}{
+		{name: "container", input: BrowseInput{URN: "urn:li:container:sales"}},
+		{name: "paging", input: BrowseInput{URN: "urn:li:dataPlatform:snowflake", Limit: 5, Offset: 10}, wantOpts: 2},
+		{name: "empty URN", input: BrowseInput{}, wantErr: true},
+		{name: "client error", input: BrowseInput{URN: "urn:li:dataset:x"}, mockErr: client.ErrInvalidURN, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotOpts int
+			mock := &mockClient{
+				browseFunc: func(_ context.Context, urn string, opts ...client.BrowseOption) (*types.BrowseResult, error) {
+					gotOpts = len(opts)
+					if tt.mockErr != nil {
+						return nil, tt.mockErr
+					}
+					return &types.BrowseResult{URN: urn, Total: 1, Entries: []types.BrowseEntry{{URN: "urn:li:container:child"}}}, nil
+				},
+			}
+			toolkit := NewToolkit(mock, DefaultConfig())
+
+			result, out, err := toolkit.handleBrowse(context.Background(), nil, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.IsError != tt.wantErr {
+				t.Fatalf("IsError = %v, want %v (%s)", result.IsError, tt.wantErr, resultText(result))
+			}
+			if tt.wantErr {
+				return
+			}
+			if gotOpts != tt.wantOpts {
+				t.Errorf("passed %d options, want %d", gotOpts, tt.wantOpts)
+			}
+			if browse, ok := out.(*types.BrowseResult); !ok || browse.Total != 1 {
+				t.Errorf("unexpected output: %#v", out)
+			}
+		})
+	}
+}
+
+func TestHandleGetContainer(t *testing.T) {
+	schemaPath := []types.PathEntry{
+		{Name: "PROD", URN: "urn:li:container:db", Type: "CONTAINER"},
+		{Name: "SALES", URN: "urn:li:container:schema", Type: "CONTAINER"},
+	}
+
+	t.Run("container URN", func(t *testing.T) {
+		mock := &mockClient{
+			getContainerFunc: func(_ context.Context, urn string) (*types.Container, error) {
+				return &types.Container{URN: urn, Name: "SALES", Path: schemaPath[:1]}, nil
+			},
+		}
+		toolkit := NewToolkit(mock, DefaultConfig())
+
+		result, out, _ := toolkit.handleGetContainer(context.Background(), nil, GetContainerInput{URN: "urn:li:container:schema"})
+		if result.IsError {
+			t.Fatalf("unexpected error: %s", resultText(result))
+		}
+		output := out.(GetContainerOutput)
+		if output.Container == nil || output.Container.Name != "SALES" || len(output.Path) != 1 {
+			t.Errorf("unexpected output: %+v", output)
+		}
+	})
+
+	t.Run("dataset resolves innermost container", func(t *testing.T) {
+		var requested string
+		mock := &mockClient{
+			getBrowsePathFunc: func(_ context.Context, _ string) ([]types.PathEntry, error) {
+				return schemaPath, nil
+			},
+			getContainerFunc: func(_ context.Context, urn string) (*types.Container, error) {
+				requested = urn
+				return &types.Container{URN: urn, Name: "SALES"}, nil
+			},
+		}
+		toolkit := NewToolkit(mock, DefaultConfig())
+
+		result, out, _ := toolkit.handleGetContainer(context.Background(), nil, GetContainerInput{URN: "urn:li:dataset:orders"})
+		if result.IsError {
+			t.Fatalf("unexpected error: %s", resultText(result))
+		}
+		if requested != "urn:li:container:schema" {
+			t.Errorf("requested container %q, want innermost", requested)
+		}
+		output := out.(GetContainerOutput)
+		if len(output.Path) != 2 || output.Container == nil {
+			t.Errorf("unexpected output: %+v", output)
+		}
+	})
+
+	t.Run("entity outside any container", func(t *testing.T) {
+		mock := &mockClient{
+			getContainerFunc: func(_ context.Context, _ string) (*types.Container, error) {
+				t.Error("GetContainer should not be called")
+				return nil, nil
+			},
+		}
+		toolkit := NewToolkit(mock, DefaultConfig())
+
+		result, out, _ := toolkit.handleGetContainer(context.Background(), nil, GetContainerInput{URN: "urn:li:dashboard:(looker,1)"})
+		if result.IsError {
+			t.Fatalf("unexpected error: %s", resultText(result))
+		}
+		if output := out.(GetContainerOutput); output.Container != nil {
+			t.Errorf("expected no container, got %+v", output.Container)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		mock := &mockClient{
+			getBrowsePathFunc: func(_ context.Context, urn string) ([]types.PathEntry, error) {
+				return nil, fmt.Errorf("GetBrowsePath(%s): %w", urn, client.ErrNotFound)
+			},
+			getContainerFunc: func(_ context.Context, _ string) (*types.Container, error) {
+				return nil, errors.New("boom")
+			},
+		}
+		toolkit := NewToolkit(mock, DefaultConfig())
+
+		result, _, _ := toolkit.handleGetContainer(context.Background(), nil, GetContainerInput{})
+		if !result.IsError {
+			t.Error("expected error for empty URN")
+		}
+		result, _, _ = toolkit.handleGetContainer(context.Background(), nil, GetContainerInput{URN: "urn:li:dataset:missing"})
+		if !result.IsError || !strings.Contains(resultText(result), "Entity not found") {
+			t.Errorf("expected not found, got %s", resultText(result))
+		}
+		result, _, _ = toolkit.handleGetContainer(context.Background(), nil, GetContainerInput{URN: "urn:li:container:x"})
+		if !result.IsError || !strings.Contains(resultText(result), "boom") {
+			t.Errorf("expected client error, got %s", resultText(result))
+		}
+	})
+}
@@ -0,0 +1,71 @@
+package tools
+
+import (
+	"context"
+	"errors"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+)
+
+// GetDataContractInput is the input for the get_data_contract tool.
+type GetDataContractInput struct {
+	URN      string `json:"urn" jsonschema_description:"The DataHub URN of the dataset"`
+	RunLimit int    `json:"run_limit,omitempty" jsonschema_description:"Maximum number of recent runs to return per assertion (default: 10)"`
+	// Connection is the named connection to use. Empty uses the default connection.
+	Connection string `json:"connection,omitempty" jsonschema_description:"Named connection to use (see datahub_list_connections)"`
+}
+
+func (t *Toolkit) registerGetDataContractTool(server *mcp.Server, cfg *toolConfig) {
+	baseHandler := func(ctx context.Context, req *mcp.CallToolRequest, input any) (*mcp.CallToolResult, any, error) {
+		contractInput, ok := input.(GetDataContractInput)
+		if !ok {
+			return ErrorResult("internal error: invalid input type"), nil, nil
+		}
+		return t.handleGetDataContract(ctx, req, contractInput)
+	}
+
+	wrappedHandler := t.wrapHandler(ToolGetDataContract, baseHandler, cfg)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:         string(ToolGetDataContract),
+		Description:  t.getDescription(ToolGetDataContract, cfg),
+		Annotations:  t.getAnnotations(ToolGetDataContract, cfg),
+		Icons:        t.getIcons(ToolGetDataContract, cfg),
+		Title:        t.getTitle(ToolGetDataContract, cfg),
+		OutputSchema: t.getOutputSchema(ToolGetDataContract, cfg),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, input GetDataContractInput) (*mcp.CallToolResult, any, error) {
+		return wrappedHandler(ctx, req, input)
+	})
+}
+
+func (t *Toolkit) handleGetDataContract(
+	ctx context.Context,
+	_ *mcp.CallToolRequest,
+	input GetDataContractInput,
+) (*mcp.CallToolResult, any, error) {
+	if input.URN == "" {
+		return ErrorResult("urn parameter is required"), nil, nil
+	}
+
+	var opts []client.AssertionOption
+	if input.RunLimit > 0 {
+		opts = append(opts, client.WithAssertionRunLimit(input.RunLimit))
+	}
+
+	datahubClient, err := t.getClient(input.Connection)
+	if err != nil {
+		return ErrorResult("Connection error: " + err.Error()), nil, nil
+	}
+
+	contract, err := datahubClient.GetDataContract(ctx, input.URN, opts...)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return ErrorResult("No data contract found for dataset: " + input.URN), nil, nil
+		}
+		return ErrorResult(err.Error()), nil, nil
+	}
+
+	return formatJSONResult(contract)
+}
@@ -0,0 +1,89 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+func TestHandleGetDataContract(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      GetDataContractInput
+		mockErr    error
+		wantErr    bool
+		wantErrMsg string
+		wantOpts   int
+	}{
+		{
+			name:  "default run limit",
+			input: GetDataContractInput{URN: "urn:li:dataset:test"},
+		},
+		{
+			name:     "with run limit",
+			input:    GetDataContractInput{URN: "urn:li:dataset:test", RunLimit: 1},
+			wantOpts: 1,
+		},
+		{
+			name:       "empty URN",
+			input:      GetDataContractInput{},
+			wantErr:    true,
+			wantErrMsg: "urn parameter is required",
+		},
+		{
+			name:       "no contract",
+			input:      GetDataContractInput{URN: "urn:li:dataset:test"},
+			mockErr:    fmt.Errorf("GetDataContract: %w", client.ErrNotFound),
+			wantErr:    true,
+			wantErrMsg: "No data contract found",
+		},
+		{
+			name:    "client error",
+			input:   GetDataContractInput{URN: "urn:li:dataset:test"},
+			mockErr: errors.New("contracts unavailable"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotOpts int
+			mock := &mockClient{
+				getDataContractFunc: func(_ context.Context, urn string, opts ...client.AssertionOption) (*types.DataContract, error) {
+					gotOpts = len(opts)
+					if tt.mockErr != nil {
+						return nil, tt.mockErr
+					}
+					return &types.DataContract{URN: "urn:li:dataContract:c", EntityURN: urn, Result: types.DataContractResultPassing}, nil
+				},
+			}
+			toolkit := NewToolkit(mock, DefaultConfig())
+
+			result, out, err := toolkit.handleGetDataContract(context.Background(), nil, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.IsError != tt.wantErr {
+				t.Fatalf("IsError = %v, want %v (%s)", result.IsError, tt.wantErr, resultText(result))
+			}
+			if tt.wantErr {
+				if tt.wantErrMsg != "" && !strings.Contains(resultText(result), tt.wantErrMsg) {
+					t.Errorf("error %q does not mention %q", resultText(result), tt.wantErrMsg)
+				}
+				return
+			}
+			if gotOpts != tt.wantOpts {
+				t.Errorf("passed %d options, want %d", gotOpts, tt.wantOpts)
+			}
+			contract, ok := out.(*types.DataContract)
+			if !ok || contract.Result != types.DataContractResultPassing {
+				t.Errorf("unexpected output: %#v", out)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package tools
+
+import (
+	"context"
+	"errors"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+)
+
+// GetDashboardInput is the input for the get_dashboard tool.
+type GetDashboardInput struct {
+	URN string `json:"urn" jsonschema_description:"The DataHub URN of the dashboard"`
+	// Connection is the named connection to use. Empty uses the default connection.
+	Connection string `json:"connection,omitempty" jsonschema_description:"Named connection to use (see datahub_list_connections)"`
+}
+
+// GetChartInput is the input for the get_chart tool.
+type GetChartInput struct {
+	URN string `json:"urn" jsonschema_description:"The DataHub URN of the chart"`
+	// Connection is the named connection to use. Empty uses the default connection.
+	Connection string `json:"connection,omitempty" jsonschema_description:"Named connection to use (see datahub_list_connections)"`
+}
+
+func (t *Toolkit) registerGetDashboardTool(server *mcp.Server, cfg *toolConfig) {
+	baseHandler := func(ctx context.Context, req *mcp.CallToolRequest, input any) (*mcp.CallToolResult, any, error) {
+		dashboardInput, ok := input.(GetDashboardInput)
+		if !ok {
+			return ErrorResult("internal error: invalid input type"), nil, nil
+		}
+		return t.handleGetDashboard(ctx, req, dashboardInput)
+	}
+
+	wrappedHandler := t.wrapHandler(ToolGetDashboard, baseHandler, cfg)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:         string(ToolGetDashboard),
+		Description:  t.getDescription(ToolGetDashboard, cfg),
+		Annotations:  t.getAnnotations(ToolGetDashboard, cfg),
+		Icons:        t.getIcons(ToolGetDashboard, cfg),
+		Title:        t.getTitle(ToolGetDashboard, cfg),
+		OutputSchema: t.getOutputSchema(ToolGetDashboard, cfg),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, input GetDashboardInput) (*mcp.CallToolResult, any, error) {
+		return wrappedHandler(ctx, req, input)
+	})
+}
+
+func (t *Toolkit) registerGetChartTool(server *mcp.Server, cfg *toolConfig) {
+	baseHandler := func(ctx context.Context, req *mcp.CallToolRequest, input any) (*mcp.CallToolResult, any, error) {
+		chartInput, ok := input.(GetChartInput)
+		if !ok {
+			return ErrorResult("internal error: invalid input type"), nil, nil
+		}
+		return t.handleGetChart(ctx, req, chartInput)
+	}
+
+	wrappedHandler := t.wrapHandler(ToolGetChart, baseHandler, cfg)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:         string(ToolGetChart),
+		Description:  t.getDescription(ToolGetChart, cfg),
+		Annotations:  t.getAnnotations(ToolGetChart, cfg),
+		Icons:        t.getIcons(ToolGetChart, cfg),
+		Title:        t.getTitle(ToolGetChart, cfg),
+		OutputSchema: t.getOutputSchema(ToolGetChart, cfg),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, input GetChartInput) (*mcp.CallToolResult, any, error) {
+		return wrappedHandler(ctx, req, input)
+	})
+}
+
+func (t *Toolkit) handleGetDashboard(
+	ctx context.Context,
+	_ *mcp.CallToolRequest,
+	input GetDashboardInput,
+) (*mcp.CallToolResult, any, error) {
+	if input.URN == "" {
+		return ErrorResult("urn parameter is required"), nil, nil
+	}
+
+	datahubClient, err := t.getClient(input.Connection)
+	if err != nil {
+		return ErrorResult("Connection error: " + err.Error()), nil, nil
+	}
+
+	dashboard, err := datahubClient.GetDashboard(ctx, input.URN)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return ErrorResult("Dashboard not found: " + input.URN), nil, nil
+		}
+		return ErrorResult(err.Error()), nil, nil
+	}
+
+	return formatJSONResult(dashboard)
+}
+
+func (t *Toolkit) handleGetChart(ctx context.Context, _ *mcp.CallToolRequest, input GetChartInput) (*mcp.CallToolResult, any, error) {
+	if input.URN == "" {
+		return ErrorResult("urn parameter is required"), nil, nil
+	}
+
+	datahubClient, err := t.getClient(input.Connection)
+	if err != nil {
+		return ErrorResult("Connection error: " + err.Error()), nil, nil
+	}
+
+	chart, err := datahubClient.GetChart(ctx, input.URN)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return ErrorResult("Chart not found: " + input.URN), nil, nil
+		}
+		return ErrorResult(err.Error()), nil, nil
+	}
+
+	return formatJSONResult(chart)
+}
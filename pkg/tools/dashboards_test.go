@@ -0,0 +1,123 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+func TestHandleGetDashboard(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      GetDashboardInput
+		mockErr    error
+		wantErr    bool
+		wantErrMsg string
+	}{
+		{name: "success", input: GetDashboardInput{URN: "urn:li:dashboard:(looker,42)"}},
+		{name: "empty URN", input: GetDashboardInput{}, wantErr: true, wantErrMsg: "urn parameter is required"},
+		{
+			name:       "not found",
+			input:      GetDashboardInput{URN: "urn:li:dashboard:(looker,missing)"},
+			mockErr:    fmt.Errorf("GetDashboard: %w", client.ErrNotFound),
+			wantErr:    true,
+			wantErrMsg: "Dashboard not found",
+		},
+		{name: "client error", input: GetDashboardInput{URN: "urn:li:dashboard:(looker,42)"}, mockErr: errors.New("boom"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockClient{
+				getDashboardFunc: func(_ context.Context, urn string) (*types.Dashboard, error) {
+					if tt.mockErr != nil {
+						return nil, tt.mockErr
+					}
+					return &types.Dashboard{
+						Entity:      types.Entity{URN: urn, Name: "Sales Overview"},
+						TotalCharts: 1,
+						Charts:      []types.Chart{{Entity: types.Entity{URN: "urn:li:chart:(looker,7)"}}},
+					}, nil
+				},
+			}
+			toolkit := NewToolkit(mock, DefaultConfig())
+
+			result, out, err := toolkit.handleGetDashboard(context.Background(), nil, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.IsError != tt.wantErr {
+				t.Fatalf("IsError = %v, want %v (%s)", result.IsError, tt.wantErr, resultText(result))
+			}
+			if tt.wantErr {
+				if tt.wantErrMsg != "" && !strings.Contains(resultText(result), tt.wantErrMsg) {
+					t.Errorf("error %q does not mention %q", resultText(result), tt.wantErrMsg)
+				}
+				return
+			}
+			if dashboard, ok := out.(*types.Dashboard); !ok || len(dashboard.Charts) != 1 {
+				t.Errorf("unexpected output: %#v", out)
+			}
+		})
+	}
+}
+
+func TestHandleGetChart(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      GetChartInput
+		mockErr    error
+		wantErr    bool
+		wantErrMsg string
+	}{
+		{name: "success", input: GetChartInput{URN: "urn:li:chart:(looker,7)"}},
+		{name: "empty URN", input: GetChartInput{}, wantErr: true, wantErrMsg: "urn parameter is required"},
+		{
+			name:       "not found",
+			input:      GetChartInput{URN: "urn:li:chart:(looker,missing)"},
+			mockErr:    fmt.Errorf("GetChart: %w", client.ErrNotFound),
+			wantErr:    true,
+			wantErrMsg: "Chart not found",
+		},
+		{name: "client error", input: GetChartInput{URN: "urn:li:chart:(looker,7)"}, mockErr: errors.New("boom"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockClient{
+				getChartFunc: func(_ context.Context, urn string) (*types.Chart, error) {
+					if tt.mockErr != nil {
+						return nil, tt.mockErr
+					}
+					return &types.Chart{
+						Entity: types.Entity{URN: urn},
+						Query:  &types.ChartQuery{Type: "SQL", RawQuery: "SELECT 1"},
+					}, nil
+				},
+			}
+			toolkit := NewToolkit(mock, DefaultConfig())
+
+			result, out, err := toolkit.handleGetChart(context.Background(), nil, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.IsError != tt.wantErr {
+				t.Fatalf("IsError = %v, want %v (%s)", result.IsError, tt.wantErr, resultText(result))
+			}
+			if tt.wantErr {
+				if tt.wantErrMsg != "" && !strings.Contains(resultText(result), tt.wantErrMsg) {
+					t.Errorf("error %q does not mention %q", resultText(result), tt.wantErrMsg)
+				}
+				return
+			}
+			if chart, ok := out.(*types.Chart); !ok || chart.Query == nil {
+				t.Errorf("unexpected output: %#v", out)
+			}
+		})
+	}
+}
@@ -22,6 +22,11 @@ var defaultDescriptions = map[ToolName]string{
 		"For row counts and query examples, use datahub_get_entity instead.",
 
 	ToolGetLineage: "Get upstream or downstream lineage for a DataHub entity. " +
+		"Use direction BOTH to get the full context in one call; upstream nodes then have negative levels. " +
+		"For hub tables, narrow with entity_types, platforms or a time window; truncated results include a warning. " +
+		"Set as_of_millis for lineage as it stood at a point in time; with a time window, " +
+		"edges added or removed in it are flagged. " +
+		"Set format to mermaid, dot or graphml to get a diagram instead of JSON. " +
 		"When a QueryProvider is configured, includes execution_context " +
 		"mapping URNs to query engine tables.",
 
@@ -29,17 +34,18 @@ var defaultDescriptions = map[ToolName]string{
 		"column. Use this when a user asks \"where does this column come from?\" or when you " +
 		"need to trace a specific metric through transformations. More precise than " +
 		"datahub_get_lineage which shows dataset-level relationships. Essential for debugging " +
-		"data quality issues in derived tables and views.",
+		"data quality issues in derived tables and views. Set format to mermaid, dot or graphml for a diagram.",
 
 	ToolGetQueries: "Get saved SQL queries linked to a dataset — including view definitions, common query " +
 		"patterns, and example queries. For database views (v_* prefix), this returns the " +
 		"actual view SQL showing all joins and transformations. Essential for understanding " +
 		"how derived data is built. Also useful for showing users example query patterns.",
 
-	ToolGetGlossaryTerm: "Get the full definition of a business glossary term and all datasets/columns linked " +
-		"to it. Use when enrichment surfaces a glossary_term URN and you need the detailed " +
-		"definition, or when a user asks \"what does [business term] mean?\" Returns the " +
-		"canonical business definition plus all tables and columns that use this term.",
+	ToolGetGlossaryTerm: "Get the full definition of a business glossary term. Use when enrichment surfaces a " +
+		"glossary_term URN and you need the detailed definition, or when a user asks \"what does " +
+		"[business term] mean?\" Returns the canonical business definition, the glossary nodes above " +
+		"the term, related terms (IsA, HasA, HasValue, IsRelatedTo), owners and the number of entities " +
+		"the term is applied to.",
 
 	ToolListTags:         "List available tags in the DataHub catalog",
 	ToolListDomains:      "List data domains in the DataHub catalog",
@@ -50,6 +56,97 @@ var defaultDescriptions = map[ToolName]string{
 		"Use after datahub_list_data_products to drill into a specific product and discover " +
 		"all its member datasets. Useful for answering \"what data do we have about [topic]?\"",
 
+	ToolGetDatasetProfile: "Get the latest statistical profile of a dataset: row count, column count, size, and " +
+		"per-column null counts, distinct counts, min/max/mean and sample values. Use this before " +
+		"writing SQL to check whether a table is populated and how its columns are distributed. " +
+		"Earlier profiles in the requested time range are returned as history to show growth trends. " +
+		"Profiles exist only for datasets that DataHub ingestion has profiled.",
+
+	ToolGetUsageStats: "Get aggregated usage of a dataset over a time window: total queries, unique users, " +
+		"the top users by query count, per-column usage counts, and a daily time series. " +
+		"Use this to answer \"who uses this table and which columns matter?\" before deprecating " +
+		"or changing a dataset, or to pick the most popular of several candidate tables. " +
+		"Requires usage ingestion to be configured in DataHub.",
+
+	ToolGetAssertions: "Get the data quality assertions (checks) defined on a dataset with each assertion's definition, " +
+		"latest result, and recent pass/fail history, plus a summary of how many are passing, failing or erroring. " +
+		"Call this before recommending or building on a dataset: if the response includes a warning, " +
+		"tell the user which checks are failing before they rely on the data.",
+
+	ToolListIncidents: "List the incidents raised on a dataset, data job, data flow, dashboard or chart, " +
+		"with type, priority, state (ACTIVE or RESOLVED), assignees and the latest status message. " +
+		"Check for active incidents before recommending a dataset, and mention any to the user.",
+
+	ToolGetDataContract: "Get the data contract on a dataset: the freshness, schema and data quality guarantees its producer " +
+		"has committed to, each with the current status of the underlying assertion, plus an overall result " +
+		"(PASSING, FAILING or UNKNOWN). Use this before depending on a dataset to learn what is guaranteed " +
+		"and whether those guarantees are currently met.",
+
+	ToolBrowse: "List the direct children of a container (database, schema, project, ...), a data platform " +
+		"(e.g. urn:li:dataPlatform:snowflake) or a platform instance, with paging. " +
+		"Use this to walk a warehouse as a tree: start from a platform, then browse into the returned " +
+		"CONTAINER entries until you reach datasets.",
+
+	ToolGetContainer: "Get the container path (e.g. platform instance > database > schema) of any entity, " +
+		"plus details of the container itself or of the innermost container the entity lives in: " +
+		"name, type, platform and number of children. Pass a container URN to datahub_browse to list its contents.",
+
+	ToolGetDashboard: "Get a dashboard with its charts. Each chart includes its query, the datasets and fields it reads, " +
+		"its external URL and when it was last refreshed. Use this to answer BI-impact questions such as " +
+		"which dashboards read a given table or column.",
+
+	ToolGetChart: "Get a chart with its query, the datasets and fields it reads, its external URL, " +
+		"when it was last refreshed and the dashboards that contain it.",
+
+	ToolGetDataJob: "Get a data job (a pipeline task, e.g. an Airflow task) with its input and output datasets, " +
+		"parent data flow, orchestrator URL, owners and recent runs with status, result and duration. " +
+		"Use this to check whether the job that feeds a table succeeded on its latest run.",
+
+	ToolGetDataFlow: "Get a data flow (a pipeline such as an Airflow DAG) with its orchestrator, owners and jobs. " +
+		"Each job includes its input and output datasets and its latest run. " +
+		"Use datahub_get_data_job for a job's full run history.",
+
+	ToolGetUser: "Get a DataHub user by URN or username: display name, email, title, department, teams, " +
+		"Slack handle, manager, group memberships and the first page of entities they own. " +
+		"Use this to tell someone who an owner is and how to reach them.",
+
+	ToolGetGroup: "Get a DataHub group by URN or name: display name, description, email, Slack channel, owners, " +
+		"members with their contact details and the first page of entities the group owns.",
+
+	ToolListOwnedEntities: "List the entities (datasets, dashboards, pipelines, glossary terms, etc.) that a user or group directly owns. " +
+		"Entities owned only through a group the user belongs to are not included.",
+
+	ToolListGlossary: "Browse the business glossary one level at a time. Without a parent, lists the root glossary nodes " +
+		"(term groups) and root terms; with a glossary node URN as parent, lists the nodes and terms under it. " +
+		"Nodes report how many child nodes and terms they contain. Use this to discover business vocabulary " +
+		"without knowing URNs, then datahub_get_glossary_term for a term's definition and related terms.",
+
+	ToolImpactAnalysis: "Assess the blast radius of changing an entity: every downstream dataset, dashboard, chart and job " +
+		"up to N hops, each with owners, domain, tags and usage, grouped into a per-owner notification list " +
+		"(most critical first) and per-domain summary. Use this before a schema change, deprecation or migration " +
+		"instead of walking lineage and looking up each entity one call at a time. At most 100 downstream " +
+		"entities (one lineage page) are analyzed; a warning says when more were left out.",
+
+	ToolTraceColumn: "Trace one column across multiple hops of column-level lineage. UPSTREAM follows it back to the " +
+		"source columns it is ultimately derived from (root cause); DOWNSTREAM finds every dependent column, " +
+		"including chart and dashboard fields (impact). Each hop includes the transformation, SQL and producing " +
+		"job where known. Use this for questions like \"where does revenue_usd come from?\" instead of calling " +
+		"datahub_get_column_lineage once per dataset. Set format to mermaid, dot or graphml for a diagram.",
+
+	ToolFindLineagePath: "Find how data flows between two entities: the shortest lineage path (or up to max_paths paths) " +
+		"from source to target, with the data jobs and queries on each hop. Checks downstream first and then " +
+		"upstream, so direction tells which way the data flows; found is false when no path exists within depth. " +
+		"Use this to answer questions like \"does table A feed dashboard B?\".",
+
+	ToolCheckFreshness: "Check whether stale upstream data explains out-of-date numbers: walks upstream lineage, finds when each " +
+		"dataset, job, chart and dashboard last updated, and returns the upstream entities older than max_age_hours " +
+		"plus the lineage path from the oldest source. Use this for \"why is my dashboard showing yesterday's numbers\" " +
+		"instead of checking each upstream entity one call at a time.",
+
+	ToolFederatedSearch: "Search several DataHub connections at once and merge the results by URN. Each result lists the " +
+		"connections it was found on, and connections that fail are reported as warnings. Use this when you " +
+		"don't know which DataHub instance holds an asset; omit connections to search all of them.",
+
 	ToolListConnections: "List all configured DataHub server connections. " +
 		"Use this to discover available connections before querying specific servers. " +
 		"Pass the connection name to other tools via the 'connection' parameter.",
@@ -62,6 +159,10 @@ var defaultDescriptions = map[ToolName]string{
 	ToolRemoveGlossaryTerm: "Remove a glossary term from a DataHub entity",
 	ToolAddLink:            "Add a link to a DataHub entity",
 	ToolRemoveLink:         "Remove a link from a DataHub entity",
+	ToolRaiseIncident:      "Raise an incident on a DataHub entity and return its URN",
+	ToolResolveIncident:    "Resolve an incident on a DataHub entity",
+	ToolAddLineage:         "Add a manual lineage edge between two DataHub entities, or between two dataset columns",
+	ToolRemoveLineage:      "Remove a lineage edge between two DataHub entities, or between two dataset columns",
 }
 
 // DefaultDescription returns the default description for a tool.
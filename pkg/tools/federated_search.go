@@ -0,0 +1,236 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+// AllConnections is the connection value that fans a search out to every configured connection.
+const AllConnections = "*"
+
+// FederatedSearchInput is the input for the federated search tool.
+type FederatedSearchInput struct {
+	Query      string `json:"query" jsonschema_description:"Search query string"`
+	EntityType string `json:"entity_type,omitempty" jsonschema_description:"Entity type to search. Defaults to DATASET."`
+	Limit      int    `json:"limit,omitempty" jsonschema_description:"Maximum number of results per connection (default: 10, max: 100)"`
+	Offset     int    `json:"offset,omitempty" jsonschema_description:"Result offset for pagination, applied on each connection"`
+	Container  string `json:"container,omitempty" jsonschema_description:"Only return direct children of this container URN"`
+	// Connections lists the connections to search. Empty searches every configured connection.
+	Connections []string `json:"connections,omitempty" jsonschema_description:"Connections to search; empty searches every connection"`
+}
+
+// FederatedSearchEntity is a search result tagged with the connections it was found on.
+type FederatedSearchEntity struct {
+	types.SearchEntity
+
+	// Connections lists every connection that returned this URN, in query order.
+	Connections []string `json:"connections"`
+}
+
+// FederatedSearchOutput is the output of a search spanning multiple connections.
+type FederatedSearchOutput struct {
+	// Entities are the merged results, deduplicated by URN.
+	Entities []FederatedSearchEntity `json:"entities"`
+
+	// Total is the sum of per-connection totals. Entities present on several
+	// connections are counted once per connection.
+	Total int `json:"total"`
+
+	// Connections lists the connections that were queried.
+	Connections []string `json:"connections"`
+
+	// Offset and Limit echo the paging applied on each connection.
+	Offset int `json:"offset"`
+	Limit  int `json:"limit"`
+
+	// Warnings describes connections that failed; their results are omitted.
+	Warnings []string `json:"warnings,omitempty"`
+
+	// QueryContext is query engine availability per entity URN (optional).
+	QueryContext map[string]any `json:"query_context,omitempty"`
+}
+
+// connectionSearchResult holds the outcome of searching a single connection.
+type connectionSearchResult struct {
+	connection string
+	result     *types.SearchResult
+	err        error
+}
+
+func (t *Toolkit) registerFederatedSearchTool(server *mcp.Server, cfg *toolConfig) {
+	baseHandler := func(ctx context.Context, req *mcp.CallToolRequest, input any) (*mcp.CallToolResult, any, error) {
+		searchInput, ok := input.(FederatedSearchInput)
+		if !ok {
+			return ErrorResult("internal error: invalid input type"), nil, nil
+		}
+		return t.handleFederatedSearch(ctx, req, searchInput)
+	}
+
+	wrappedHandler := t.wrapHandler(ToolFederatedSearch, baseHandler, cfg)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:         string(ToolFederatedSearch),
+		Description:  t.getDescription(ToolFederatedSearch, cfg),
+		Annotations:  t.getAnnotations(ToolFederatedSearch, cfg),
+		Icons:        t.getIcons(ToolFederatedSearch, cfg),
+		Title:        t.getTitle(ToolFederatedSearch, cfg),
+		OutputSchema: t.getOutputSchema(ToolFederatedSearch, cfg),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, input FederatedSearchInput) (*mcp.CallToolResult, any, error) {
+		return wrappedHandler(ctx, req, input)
+	})
+}
+
+// federatedSearchInput converts a search with connection "*" into a federated search of every connection.
+func federatedSearchInput(input SearchInput) FederatedSearchInput {
+	return FederatedSearchInput{
+		Query:      input.Query,
+		EntityType: input.EntityType,
+		Limit:      input.Limit,
+		Offset:     input.Offset,
+		Container:  input.Container,
+	}
+}
+
+// federatedConnections returns the connection names a federated search should query.
+// Without an explicit list, the default connection comes first and the remaining
+// connections are sorted by name.
+func (t *Toolkit) federatedConnections(connections []string) []string {
+	if len(connections) > 0 {
+		names := make([]string, 0, len(connections))
+		seen := make(map[string]bool, len(connections))
+		for _, name := range connections {
+			name = t.connectionName(name)
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+		return names
+	}
+	if t.manager == nil {
+		return []string{t.connectionName("")}
+	}
+	names := t.manager.Connections()
+	if len(names) > 1 {
+		sort.Strings(names[1:])
+	}
+	return names
+}
+
+// handleFederatedSearch runs the search against every requested connection concurrently
+// and merges the results. Failures on individual connections are reported as warnings;
+// an error result is returned only when every connection fails.
+func (t *Toolkit) handleFederatedSearch(
+	ctx context.Context,
+	_ *mcp.CallToolRequest,
+	input FederatedSearchInput,
+) (*mcp.CallToolResult, any, error) {
+	if input.Query == "" {
+		return ErrorResult("query parameter is required"), nil, nil
+	}
+
+	opts := buildSearchOptions(SearchInput{
+		EntityType: input.EntityType,
+		Limit:      input.Limit,
+		Offset:     input.Offset,
+		Container:  input.Container,
+	})
+	names := t.federatedConnections(input.Connections)
+	results := t.searchConnections(ctx, names, input.Query, opts, t.getClient)
+
+	output := mergeFederatedResults(results)
+	if len(output.Warnings) == len(names) {
+		return ErrorResult("Search failed on all connections: " + strings.Join(output.Warnings, "; ")), nil, nil
+	}
+
+	merged := &types.SearchResult{Entities: make([]types.SearchEntity, len(output.Entities))}
+	for i, e := range output.Entities {
+		merged.Entities[i] = e.SearchEntity
+	}
+	if queryContext := t.buildQueryContext(ctx, merged); len(queryContext) > 0 {
+		output.QueryContext = queryContext
+	}
+
+	return formatJSONResult(output)
+}
+
+// searchConnections searches each named connection concurrently.
+// Results are returned in the same order as names.
+func (t *Toolkit) searchConnections(
+	ctx context.Context, names []string, query string, opts []client.SearchOption,
+	lookup func(string) (DataHubClient, error),
+) []connectionSearchResult {
+	results := make([]connectionSearchResult, len(names))
+
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			results[i].connection = name
+
+			datahubClient, err := lookup(name)
+			if err != nil {
+				results[i].err = err
+				return
+			}
+			results[i].result, results[i].err = datahubClient.Search(ctx, query, opts...)
+		}(i, name)
+	}
+	wg.Wait()
+
+	for _, r := range results {
+		if r.err != nil {
+			t.log().Warn("federated search failed on connection",
+				"connection", r.connection,
+				"error", r.err.Error())
+		}
+	}
+	return results
+}
+
+// mergeFederatedResults merges per-connection results, deduplicating entities by URN.
+// The first connection to return a URN supplies its metadata, and the first
+// successful result supplies the paging echoed in the output.
+func mergeFederatedResults(results []connectionSearchResult) FederatedSearchOutput {
+	output := FederatedSearchOutput{
+		Entities:    []FederatedSearchEntity{},
+		Connections: make([]string, 0, len(results)),
+	}
+	index := make(map[string]int)
+
+	for _, r := range results {
+		output.Connections = append(output.Connections, r.connection)
+		if r.err != nil {
+			output.Warnings = append(output.Warnings, fmt.Sprintf("connection %q: %v", r.connection, r.err))
+			continue
+		}
+		if r.result == nil {
+			continue
+		}
+		if output.Limit == 0 {
+			output.Offset, output.Limit = r.result.Offset, r.result.Limit
+		}
+		output.Total += r.result.Total
+		for _, entity := range r.result.Entities {
+			if i, ok := index[entity.URN]; ok {
+				output.Entities[i].Connections = append(output.Entities[i].Connections, r.connection)
+				continue
+			}
+			index[entity.URN] = len(output.Entities)
+			output.Entities = append(output.Entities, FederatedSearchEntity{
+				SearchEntity: entity,
+				Connections:  []string{r.connection},
+			})
+		}
+	}
+	return output
+}
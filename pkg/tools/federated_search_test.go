@@ -0,0 +1,188 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+	"github.com/txn2/mcp-datahub/pkg/multiserver"
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+func TestFederatedConnections(t *testing.T) {
+	mgr := multiserver.NewManager(multiserver.Config{
+		Default: "prod",
+		Primary: client.Config{URL: "https://prod.example.com", Token: "token"},
+		Connections: map[string]multiserver.ConnectionConfig{
+			"us": {URL: "https://us.example.com"},
+			"eu": {URL: "https://eu.example.com"},
+		},
+	})
+	toolkit := NewToolkitWithManager(mgr, DefaultConfig())
+
+	got := toolkit.federatedConnections(nil)
+	want := []string{"prod", "eu", "us"}
+	if len(got) != len(want) {
+		t.Fatalf("federatedConnections() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("federatedConnections()[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+
+	got = toolkit.federatedConnections([]string{"eu", "", "eu", "prod"})
+	if len(got) != 2 || got[0] != "eu" || got[1] != "prod" {
+		t.Errorf("federatedConnections() with list = %v, want [eu prod]", got)
+	}
+}
+
+func TestSearchConnections_MergeAndDedupe(t *testing.T) {
+	clients := map[string]DataHubClient{
+		"us": &mockClient{
+			searchFunc: func(_ context.Context, _ string, _ ...client.SearchOption) (*types.SearchResult, error) {
+				return &types.SearchResult{
+					Total:  2,
+					Offset: 5,
+					Limit:  10,
+					Entities: []types.SearchEntity{
+						{URN: "urn:li:dataset:orders", Name: "orders"},
+						{URN: "urn:li:dataset:shared", Name: "shared-us"},
+					},
+				}, nil
+			},
+		},
+		"eu": &mockClient{
+			searchFunc: func(_ context.Context, _ string, _ ...client.SearchOption) (*types.SearchResult, error) {
+				return &types.SearchResult{
+					Total: 2,
+					Entities: []types.SearchEntity{
+						{URN: "urn:li:dataset:shared", Name: "shared-eu"},
+						{URN: "urn:li:dataset:customers", Name: "customers"},
+					},
+				}, nil
+			},
+		},
+		"apac": &mockClient{
+			searchFunc: func(_ context.Context, _ string, _ ...client.SearchOption) (*types.SearchResult, error) {
+				return nil, errors.New("connection refused")
+			},
+		},
+	}
+	lookup := func(name string) (DataHubClient, error) {
+		c, ok := clients[name]
+		if !ok {
+			return nil, errors.New("unknown connection")
+		}
+		return c, nil
+	}
+
+	toolkit := NewToolkit(&mockClient{}, DefaultConfig())
+	results := toolkit.searchConnections(context.Background(),
+		[]string{"us", "eu", "apac", "missing"}, "orders", nil, lookup)
+	output := mergeFederatedResults(results)
+
+	if output.Total != 4 || output.Offset != 5 || output.Limit != 10 {
+		t.Errorf("Total/Offset/Limit = %d/%d/%d, want 4/5/10", output.Total, output.Offset, output.Limit)
+	}
+	if len(output.Entities) != 3 {
+		t.Fatalf("expected 3 deduplicated entities, got %d", len(output.Entities))
+	}
+	shared := output.Entities[1]
+	if shared.URN != "urn:li:dataset:shared" || shared.Name != "shared-us" {
+		t.Errorf("expected first connection to supply shared entity, got %+v", shared.SearchEntity)
+	}
+	if len(shared.Connections) != 2 || shared.Connections[0] != "us" || shared.Connections[1] != "eu" {
+		t.Errorf("shared entity Connections = %v, want [us eu]", shared.Connections)
+	}
+	if len(output.Warnings) != 2 {
+		t.Errorf("expected 2 warnings, got %v", output.Warnings)
+	}
+	if len(output.Connections) != 4 {
+		t.Errorf("expected 4 queried connections, got %v", output.Connections)
+	}
+}
+
+func TestHandleSearch_Federated(t *testing.T) {
+	mock := &mockClient{
+		searchFunc: func(_ context.Context, _ string, _ ...client.SearchOption) (*types.SearchResult, error) {
+			return &types.SearchResult{
+				Total:    1,
+				Entities: []types.SearchEntity{{URN: "urn:li:dataset:test", Name: "test"}},
+			}, nil
+		},
+	}
+	toolkit := NewToolkit(mock, DefaultConfig())
+
+	result, out, err := toolkit.handleSearch(context.Background(), nil, SearchInput{
+		Query:      "test",
+		Connection: AllConnections,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatal("expected success result")
+	}
+	output, ok := out.(FederatedSearchOutput)
+	if !ok {
+		t.Fatalf("expected FederatedSearchOutput, got %T", out)
+	}
+	if len(output.Entities) != 1 || output.Entities[0].Connections[0] != "default" {
+		t.Errorf("unexpected entities: %+v", output.Entities)
+	}
+}
+
+func TestHandleSearch_FederatedAllFail(t *testing.T) {
+	mock := &mockClient{
+		searchFunc: func(_ context.Context, _ string, _ ...client.SearchOption) (*types.SearchResult, error) {
+			return nil, errors.New("boom")
+		},
+	}
+	toolkit := NewToolkit(mock, DefaultConfig())
+
+	result, _, _ := toolkit.handleFederatedSearch(context.Background(), nil, FederatedSearchInput{
+		Query:       "test",
+		Connections: []string{"a", "b"},
+	})
+	if !result.IsError {
+		t.Error("expected error result when every connection fails")
+	}
+}
+
+func TestHandleFederatedSearch(t *testing.T) {
+	var gotOpts []client.SearchOption
+	mock := &mockClient{
+		searchFunc: func(_ context.Context, _ string, opts ...client.SearchOption) (*types.SearchResult, error) {
+			gotOpts = opts
+			return &types.SearchResult{Total: 1, Offset: 20, Limit: 5, Entities: []types.SearchEntity{{URN: "urn:li:dataset:test"}}}, nil
+		},
+	}
+	toolkit := NewToolkit(mock, DefaultConfig())
+
+	result, _, _ := toolkit.handleFederatedSearch(context.Background(), nil, FederatedSearchInput{})
+	if !result.IsError || resultText(result) != "query parameter is required" {
+		t.Errorf("handleFederatedSearch() without query = %s", resultText(result))
+	}
+
+	result, out, _ := toolkit.handleFederatedSearch(context.Background(), nil, FederatedSearchInput{
+		Query:      "test",
+		EntityType: "DASHBOARD",
+		Limit:      5,
+		Offset:     20,
+	})
+	if result.IsError {
+		t.Fatalf("handleFederatedSearch() error: %s", resultText(result))
+	}
+	output, ok := out.(FederatedSearchOutput)
+	if !ok {
+		t.Fatalf("expected FederatedSearchOutput, got %T", out)
+	}
+	if output.Offset != 20 || output.Limit != 5 || len(output.Connections) != 1 || output.Connections[0] != "default" {
+		t.Errorf("handleFederatedSearch() output = %+v", output)
+	}
+	if len(gotOpts) != 3 {
+		t.Errorf("handleFederatedSearch() passed %d search options, want 3", len(gotOpts))
+	}
+}
@@ -0,0 +1,322 @@
+package tools
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+// defaultFreshnessDepth is the number of upstream hops checked when no depth is given.
+const defaultFreshnessDepth = 3
+
+// defaultMaxAgeHours is the age past which an entity is stale when no threshold is given.
+const defaultMaxAgeHours = 24
+
+// freshnessConcurrency bounds the number of freshness lookups in flight at once.
+const freshnessConcurrency = 8
+
+// freshnessRunLimit is the number of recent data job runs searched for the latest success.
+const freshnessRunLimit = 10
+
+// CheckFreshnessInput is the input for the check_freshness tool.
+type CheckFreshnessInput struct {
+	URN   string `json:"urn" jsonschema_description:"The DataHub URN of the entity whose data looks out of date"`
+	Depth int    `json:"depth,omitempty" jsonschema_description:"Number of upstream hops to check (default: 3, max: 5)"`
+	// MaxAgeHours is the age past which an entity counts as stale.
+	MaxAgeHours int `json:"max_age_hours,omitempty" jsonschema_description:"Hours after the last update when data is stale (default: 24)"`
+	// Connection is the named connection to use. Empty uses the default connection.
+	Connection string `json:"connection,omitempty" jsonschema_description:"Named connection to use (see datahub_list_connections)"`
+}
+
+func (t *Toolkit) registerCheckFreshnessTool(server *mcp.Server, cfg *toolConfig) {
+	baseHandler := func(ctx context.Context, req *mcp.CallToolRequest, input any) (*mcp.CallToolResult, any, error) {
+		freshnessInput, ok := input.(CheckFreshnessInput)
+		if !ok {
+			return ErrorResult("internal error: invalid input type"), nil, nil
+		}
+		return t.handleCheckFreshness(ctx, req, freshnessInput)
+	}
+
+	wrappedHandler := t.wrapHandler(ToolCheckFreshness, baseHandler, cfg)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:         string(ToolCheckFreshness),
+		Description:  t.getDescription(ToolCheckFreshness, cfg),
+		Annotations:  t.getAnnotations(ToolCheckFreshness, cfg),
+		Icons:        t.getIcons(ToolCheckFreshness, cfg),
+		Title:        t.getTitle(ToolCheckFreshness, cfg),
+		OutputSchema: t.getOutputSchema(ToolCheckFreshness, cfg),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, input CheckFreshnessInput) (*mcp.CallToolResult, any, error) {
+		return wrappedHandler(ctx, req, input)
+	})
+}
+
+func (t *Toolkit) handleCheckFreshness(
+	ctx context.Context,
+	_ *mcp.CallToolRequest,
+	input CheckFreshnessInput,
+) (*mcp.CallToolResult, any, error) {
+	if input.URN == "" {
+		return ErrorResult("urn parameter is required"), nil, nil
+	}
+	depth := input.Depth
+	if depth <= 0 {
+		depth = defaultFreshnessDepth
+	}
+	maxAgeHours := input.MaxAgeHours
+	if maxAgeHours <= 0 {
+		maxAgeHours = defaultMaxAgeHours
+	}
+
+	datahubClient, err := t.getClient(input.Connection)
+	if err != nil {
+		return ErrorResult("Connection error: " + err.Error()), nil, nil
+	}
+
+	lineage, err := datahubClient.GetLineage(ctx, input.URN,
+		client.WithDirection(client.LineageDirectionUpstream),
+		client.WithDepth(depth),
+	)
+	if err != nil {
+		return ErrorResult(err.Error()), nil, nil
+	}
+
+	urns := []string{input.URN}
+	for _, node := range lineage.Nodes {
+		urns = append(urns, node.URN)
+	}
+	freshness := t.lookupFreshness(ctx, datahubClient, urns)
+	output := buildFreshnessReport(lineage, freshness, maxAgeHours, time.Now())
+
+	return formatJSONResult(output)
+}
+
+// lookupFreshness finds the last update of each entity concurrently, keyed by URN.
+func (t *Toolkit) lookupFreshness(ctx context.Context, datahubClient DataHubClient, urns []string) map[string]types.Freshness {
+	results := make([]types.Freshness, len(urns))
+	sem := make(chan struct{}, freshnessConcurrency)
+
+	var wg sync.WaitGroup
+	for i, urn := range urns {
+		wg.Add(1)
+		go func(i int, urn string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			results[i] = t.entityFreshness(ctx, datahubClient, urn)
+		}(i, urn)
+	}
+	wg.Wait()
+
+	freshness := make(map[string]types.Freshness, len(urns))
+	for _, f := range results {
+		freshness[f.URN] = f
+	}
+	return freshness
+}
+
+// entityFreshness finds when an entity's data last changed: a dataset's latest
+// operation, last-modified time or query engine update, whichever is newest; the
+// end of a data job's latest successful run; or a chart or dashboard's last refresh.
+// Other entities, and failed lookups, have no update time.
+func (t *Toolkit) entityFreshness(ctx context.Context, datahubClient DataHubClient, urn string) types.Freshness {
+	freshness := types.Freshness{URN: urn}
+	var err error
+	switch kind, _, _ := strings.Cut(strings.TrimPrefix(urn, "urn:li:"), ":"); kind {
+	case "dataset":
+		freshness, err = t.datasetFreshness(ctx, datahubClient, urn)
+	case "dataJob":
+		var job *types.Pipeline
+		if job, err = datahubClient.GetDataJob(ctx, urn, client.WithDataJobRunLimit(freshnessRunLimit)); err == nil {
+			freshness = jobFreshness(job)
+		}
+	case "chart":
+		var chart *types.Chart
+		if chart, err = datahubClient.GetChart(ctx, urn); err == nil {
+			freshness = refreshFreshness(urn, chart.LastRefreshed, chart.LastModified)
+		}
+	case "dashboard":
+		var dashboard *types.Dashboard
+		if dashboard, err = datahubClient.GetDashboard(ctx, urn); err == nil {
+			freshness = refreshFreshness(urn, dashboard.LastRefreshed, dashboard.LastModified)
+		}
+	}
+	if err != nil {
+		t.log().Warn("freshness lookup failed", "urn", urn, "error", err.Error())
+	}
+	freshness.URN = urn
+	return freshness
+}
+
+// datasetFreshness combines what DataHub records about a dataset's last update with
+// the query engine's, when a QueryProvider is configured, keeping the newest.
+func (t *Toolkit) datasetFreshness(ctx context.Context, datahubClient DataHubClient, urn string) (types.Freshness, error) {
+	freshness := types.Freshness{URN: urn}
+	f, err := datahubClient.GetDatasetFreshness(ctx, urn)
+	if err == nil {
+		freshness = *f
+	}
+	if t.queryProvider == nil {
+		return freshness, err
+	}
+	avail, availErr := t.queryProvider.GetTableAvailability(ctx, urn)
+	if availErr == nil && avail != nil && avail.LastUpdated != nil && avail.LastUpdated.UnixMilli() > freshness.LastUpdated {
+		return types.Freshness{URN: urn, LastUpdated: avail.LastUpdated.UnixMilli(), Source: types.FreshnessSourceQueryEngine}, nil
+	}
+	return freshness, err
+}
+
+// jobFreshness returns the end of the job's latest successful run.
+func jobFreshness(job *types.Pipeline) types.Freshness {
+	freshness := types.Freshness{URN: job.URN}
+	for _, run := range job.Runs {
+		if run.Result == types.RunResultSuccess {
+			freshness.LastUpdated = run.StartTime + run.DurationMillis
+			freshness.Source = types.FreshnessSourceRun
+			break
+		}
+	}
+	return freshness
+}
+
+// refreshFreshness returns a chart or dashboard's last refresh, or its last
+// modification when the platform does not report refreshes.
+func refreshFreshness(urn string, lastRefreshed, lastModified int64) types.Freshness {
+	switch {
+	case lastRefreshed > 0:
+		return types.Freshness{URN: urn, LastUpdated: lastRefreshed, Source: types.FreshnessSourceRefresh}
+	case lastModified > 0:
+		return types.Freshness{URN: urn, LastUpdated: lastModified, Source: types.FreshnessSourceLastModified}
+	default:
+		return types.Freshness{URN: urn}
+	}
+}
+
+// buildFreshnessReport lists the upstream entities older than maxAgeHours, oldest
+// first, and the lineage path from the oldest upstream entity to the checked one,
+// which is where stale data most likely comes from.
+func buildFreshnessReport(
+	lineage *types.LineageResult, freshness map[string]types.Freshness, maxAgeHours int, now time.Time,
+) CheckFreshnessOutput {
+	maxAge := time.Duration(maxAgeHours) * time.Hour
+	entry := func(urn string) FreshnessEntity {
+		f := freshness[urn]
+		e := FreshnessEntity{URN: urn, LastUpdated: f.LastUpdated, Source: f.Source}
+		if f.LastUpdated > 0 {
+			age := now.Sub(time.UnixMilli(f.LastUpdated))
+			e.AgeHours = math.Round(age.Hours()*10) / 10
+			e.Stale = age > maxAge
+		}
+		return e
+	}
+
+	output := CheckFreshnessOutput{
+		URN:         lineage.Start,
+		Depth:       lineage.Depth,
+		MaxAgeHours: maxAgeHours,
+		CheckedAt:   now.UnixMilli(),
+		Truncated:   lineage.Truncated,
+		Entity:      entry(lineage.Start),
+		Stale:       []FreshnessEntity{},
+		StalestPath: []FreshnessEntity{},
+		Upstream:    []FreshnessEntity{},
+	}
+
+	upstream := make(map[string]FreshnessEntity, len(lineage.Nodes))
+	var stalest *FreshnessEntity
+	for _, node := range lineage.Nodes {
+		e := entry(node.URN)
+		e.Type, e.Name, e.Platform, e.Level = node.Type, node.Name, node.Platform, node.Level
+		upstream[node.URN] = e
+		output.Upstream = append(output.Upstream, e)
+		switch {
+		case e.LastUpdated == 0:
+			output.Unknown = append(output.Unknown, e.URN)
+		case stalest == nil || e.LastUpdated < stalest.LastUpdated:
+			oldest := e
+			stalest = &oldest
+		}
+		if e.Stale {
+			output.Stale = append(output.Stale, e)
+		}
+	}
+	sort.SliceStable(output.Stale, func(a, b int) bool {
+		return output.Stale[a].LastUpdated < output.Stale[b].LastUpdated
+	})
+
+	if stalest != nil {
+		for _, urn := range lineagePathFrom(lineage, stalest.URN) {
+			if e, ok := upstream[urn]; ok {
+				output.StalestPath = append(output.StalestPath, e)
+			} else {
+				output.StalestPath = append(output.StalestPath, output.Entity)
+			}
+		}
+	}
+
+	output.Summary = freshnessSummary(output, stalest)
+	if lineage.Truncated {
+		output.Warning = fmt.Sprintf("Only %d of %d upstream entities were checked; "+
+			"reduce depth or use datahub_get_lineage with filters to see the rest.",
+			len(lineage.Nodes), lineage.Total)
+	}
+	return output
+}
+
+// lineagePathFrom returns the shortest path in the direction data flows from an
+// upstream entity to the start of an upstream lineage result. When the edges do not
+// connect them, the path is just the two entities.
+func lineagePathFrom(lineage *types.LineageResult, urn string) []string {
+	next := map[string]string{lineage.Start: ""}
+	queue := []string{lineage.Start}
+	for len(queue) > 0 && queue[0] != urn {
+		current := queue[0]
+		queue = queue[1:]
+		for _, edge := range lineage.Edges {
+			if _, seen := next[edge.Source]; edge.Target == current && !seen {
+				next[edge.Source] = current
+				queue = append(queue, edge.Source)
+			}
+		}
+	}
+	if _, ok := next[urn]; !ok {
+		return []string{urn, lineage.Start}
+	}
+
+	path := []string{urn}
+	for current := urn; current != lineage.Start; {
+		current = next[current]
+		path = append(path, current)
+	}
+	return path
+}
+
+// freshnessSummary states in a sentence whether the checked entity or its upstream
+// sources are stale, naming the oldest source.
+func freshnessSummary(output CheckFreshnessOutput, stalest *FreshnessEntity) string {
+	if len(output.Upstream) == 0 {
+		return "No upstream lineage was found, so only the entity itself was checked."
+	}
+	if stalest == nil {
+		return fmt.Sprintf("None of the %d upstream entities has a recorded update time.", len(output.Upstream))
+	}
+	oldest := fmt.Sprintf("the oldest is %s, last updated %.1f hours ago, %d hop(s) upstream",
+		cmp.Or(stalest.Name, stalest.URN), stalest.AgeHours, stalest.Level)
+	if len(output.Stale) == 0 {
+		return fmt.Sprintf("All %d upstream entities with a recorded update time were updated within %d hours; %s.",
+			len(output.Upstream)-len(output.Unknown), output.MaxAgeHours, oldest)
+	}
+	return fmt.Sprintf("%d of %d upstream entities were last updated more than %d hours ago; %s.",
+		len(output.Stale), len(output.Upstream), output.MaxAgeHours, oldest)
+}
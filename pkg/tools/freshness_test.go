@@ -0,0 +1,214 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+	"github.com/txn2/mcp-datahub/pkg/integration"
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+// freshnessMock serves the upstream lineage of a dashboard fed by revenue, which a job
+// loads from events, with update times relative to now.
+func freshnessMock(now time.Time) *mockClient {
+	hoursAgo := func(h int) int64 { return now.Add(-time.Duration(h) * time.Hour).UnixMilli() }
+	datasets := map[string]int64{
+		"urn:li:dataset:revenue": hoursAgo(3),
+		"urn:li:dataset:events":  hoursAgo(52),
+	}
+
+	return &mockClient{
+		getLineageFunc: func(_ context.Context, urn string, _ ...client.LineageOption) (*types.LineageResult, error) {
+			return &types.LineageResult{
+				Start:     urn,
+				Direction: client.LineageDirectionUpstream,
+				Depth:     3,
+				Nodes: []types.LineageNode{
+					{URN: "urn:li:dataset:revenue", Type: "DATASET", Name: "revenue", Level: 1},
+					{URN: "urn:li:dataset:mystery", Type: "DATASET", Level: 1},
+					{URN: "urn:li:dataJob:load", Type: "DATA_JOB", Name: "load", Level: 2},
+					{URN: "urn:li:dataset:events", Type: "DATASET", Name: "events", Level: 3},
+				},
+				Edges: []types.LineageEdge{
+					{Source: "urn:li:dataset:revenue", Target: urn},
+					{Source: "urn:li:dataset:mystery", Target: urn},
+					{Source: "urn:li:dataJob:load", Target: "urn:li:dataset:revenue"},
+					{Source: "urn:li:dataset:events", Target: "urn:li:dataJob:load"},
+				},
+			}, nil
+		},
+		getDatasetFreshnessFunc: func(_ context.Context, urn string) (*types.Freshness, error) {
+			return &types.Freshness{URN: urn, LastUpdated: datasets[urn], Source: types.FreshnessSourceOperation}, nil
+		},
+		getDataJobFunc: func(_ context.Context, urn string, _ ...client.DataJobOption) (*types.Pipeline, error) {
+			return &types.Pipeline{
+				Entity: types.Entity{URN: urn},
+				Runs: []types.PipelineRun{
+					{Result: types.RunResultFailure, StartTime: hoursAgo(1)},
+					{Result: types.RunResultSuccess, StartTime: hoursAgo(51), DurationMillis: 60000},
+				},
+			}, nil
+		},
+		getDashboardFunc: func(_ context.Context, urn string) (*types.Dashboard, error) {
+			return &types.Dashboard{Entity: types.Entity{URN: urn, LastModified: hoursAgo(500)}, LastRefreshed: hoursAgo(1)}, nil
+		},
+	}
+}
+
+func TestHandleCheckFreshness(t *testing.T) {
+	toolkit := NewToolkit(freshnessMock(time.Now()), DefaultConfig())
+
+	result, _, err := toolkit.handleCheckFreshness(context.Background(), nil, CheckFreshnessInput{URN: "urn:li:dashboard:sales"})
+	if err != nil || result.IsError {
+		t.Fatalf("handleCheckFreshness() error: %v %s", err, resultText(result))
+	}
+	var out CheckFreshnessOutput
+	if err := json.Unmarshal([]byte(resultText(result)), &out); err != nil {
+		t.Fatalf("failed to parse result: %v", err)
+	}
+
+	if out.MaxAgeHours != defaultMaxAgeHours || out.Depth != 3 {
+		t.Errorf("handleCheckFreshness() max age/depth = %d/%d", out.MaxAgeHours, out.Depth)
+	}
+	if out.Entity.Source != types.FreshnessSourceRefresh || out.Entity.Stale || out.Entity.AgeHours != 1 {
+		t.Errorf("handleCheckFreshness() entity = %+v, want refreshed an hour ago", out.Entity)
+	}
+	if len(out.Stale) != 2 || out.Stale[0].URN != "urn:li:dataset:events" || out.Stale[1].URN != "urn:li:dataJob:load" {
+		t.Fatalf("handleCheckFreshness() stale = %+v, want events then load", out.Stale)
+	}
+	if out.Stale[1].Source != types.FreshnessSourceRun || out.Stale[1].AgeHours != 51 {
+		t.Errorf("handleCheckFreshness() job freshness = %+v, want the successful run's end", out.Stale[1])
+	}
+
+	var path []string
+	for _, e := range out.StalestPath {
+		path = append(path, e.URN)
+	}
+	want := "urn:li:dataset:events urn:li:dataJob:load urn:li:dataset:revenue urn:li:dashboard:sales"
+	if strings.Join(path, " ") != want {
+		t.Errorf("handleCheckFreshness() stalest path = %v, want %s", path, want)
+	}
+	if len(out.Unknown) != 1 || out.Unknown[0] != "urn:li:dataset:mystery" {
+		t.Errorf("handleCheckFreshness() unknown = %v", out.Unknown)
+	}
+	if len(out.Upstream) != 4 {
+		t.Errorf("handleCheckFreshness() upstream count = %d, want 4", len(out.Upstream))
+	}
+	if !strings.Contains(out.Summary, "2 of 4") || !strings.Contains(out.Summary, "events") {
+		t.Errorf("handleCheckFreshness() summary = %q", out.Summary)
+	}
+}
+
+func TestHandleCheckFreshnessThreshold(t *testing.T) {
+	toolkit := NewToolkit(freshnessMock(time.Now()), DefaultConfig())
+
+	result, _, _ := toolkit.handleCheckFreshness(context.Background(), nil, CheckFreshnessInput{
+		URN:         "urn:li:dashboard:sales",
+		MaxAgeHours: 72,
+	})
+	var out CheckFreshnessOutput
+	if err := json.Unmarshal([]byte(resultText(result)), &out); err != nil {
+		t.Fatalf("failed to parse result: %v", err)
+	}
+	if len(out.Stale) != 0 || len(out.StalestPath) != 4 {
+		t.Errorf("handleCheckFreshness() stale/path = %d/%d, want 0/4", len(out.Stale), len(out.StalestPath))
+	}
+	if !strings.HasPrefix(out.Summary, "All 3 upstream entities") {
+		t.Errorf("handleCheckFreshness() summary = %q", out.Summary)
+	}
+}
+
+func TestHandleCheckFreshnessQueryEngine(t *testing.T) {
+	now := time.Now()
+	engineUpdate := now.Add(-2 * time.Hour)
+	provider := &fullMockQueryProvider{
+		getTableAvailabilityFn: func(_ context.Context, urn string) (*integration.TableAvailability, error) {
+			if urn == "urn:li:dataset:events" {
+				return &integration.TableAvailability{Available: true, LastUpdated: &engineUpdate}, nil
+			}
+			return &integration.TableAvailability{Available: true}, nil
+		},
+	}
+	toolkit := NewToolkit(freshnessMock(now), DefaultConfig(), WithQueryProvider(provider))
+
+	result, _, _ := toolkit.handleCheckFreshness(context.Background(), nil, CheckFreshnessInput{URN: "urn:li:dashboard:sales"})
+	var out CheckFreshnessOutput
+	if err := json.Unmarshal([]byte(resultText(result)), &out); err != nil {
+		t.Fatalf("failed to parse result: %v", err)
+	}
+	for _, e := range out.Upstream {
+		if e.URN != "urn:li:dataset:events" {
+			continue
+		}
+		if e.Source != types.FreshnessSourceQueryEngine || e.LastUpdated != engineUpdate.UnixMilli() || e.Stale {
+			t.Errorf("handleCheckFreshness() events = %+v, want the newer query engine update", e)
+		}
+	}
+	if len(out.Stale) != 1 || out.Stale[0].URN != "urn:li:dataJob:load" {
+		t.Errorf("handleCheckFreshness() stale = %+v, want only the job", out.Stale)
+	}
+}
+
+func TestHandleCheckFreshnessErrors(t *testing.T) {
+	toolkit := NewToolkit(&mockClient{
+		getLineageFunc: func(_ context.Context, _ string, _ ...client.LineageOption) (*types.LineageResult, error) {
+			return nil, errors.New("lineage unavailable")
+		},
+	}, DefaultConfig())
+
+	result, _, _ := toolkit.handleCheckFreshness(context.Background(), nil, CheckFreshnessInput{})
+	if !result.IsError || !strings.Contains(resultText(result), "urn parameter is required") {
+		t.Errorf("handleCheckFreshness() without urn = %s", resultText(result))
+	}
+	result, _, _ = toolkit.handleCheckFreshness(context.Background(), nil, CheckFreshnessInput{URN: "urn:li:dataset:a"})
+	if !result.IsError || !strings.Contains(resultText(result), "lineage unavailable") {
+		t.Errorf("handleCheckFreshness() lineage error = %s", resultText(result))
+	}
+}
+
+func TestBuildFreshnessReport(t *testing.T) {
+	now := time.UnixMilli(100 * 3600000)
+	lineage := &types.LineageResult{
+		Start:     "urn:li:dataset:start",
+		Depth:     2,
+		Nodes:     []types.LineageNode{{URN: "urn:li:dataset:far", Level: 2}},
+		Total:     9,
+		Truncated: true,
+	}
+	freshness := map[string]types.Freshness{
+		"urn:li:dataset:far": {URN: "urn:li:dataset:far", LastUpdated: 10 * 3600000},
+	}
+
+	out := buildFreshnessReport(lineage, freshness, 24, now)
+	if out.Entity.LastUpdated != 0 || out.Entity.Stale {
+		t.Errorf("buildFreshnessReport() entity = %+v, want unknown and not stale", out.Entity)
+	}
+	if len(out.Stale) != 1 || out.Stale[0].AgeHours != 90 {
+		t.Errorf("buildFreshnessReport() stale = %+v", out.Stale)
+	}
+	if len(out.StalestPath) != 2 || out.StalestPath[1].URN != "urn:li:dataset:start" {
+		t.Errorf("buildFreshnessReport() path = %+v, want the two unconnected entities", out.StalestPath)
+	}
+	if !strings.Contains(out.Warning, "1 of 9") {
+		t.Errorf("buildFreshnessReport() warning = %q", out.Warning)
+	}
+
+	out = buildFreshnessReport(&types.LineageResult{Start: "urn:li:dataset:start"}, nil, 24, now)
+	if len(out.Upstream) != 0 || len(out.StalestPath) != 0 || !strings.HasPrefix(out.Summary, "No upstream lineage") {
+		t.Errorf("buildFreshnessReport() without lineage = %+v", out)
+	}
+}
+
+func TestRefreshFreshness(t *testing.T) {
+	if f := refreshFreshness("urn:li:chart:c", 0, 500); f.LastUpdated != 500 || f.Source != types.FreshnessSourceLastModified {
+		t.Errorf("refreshFreshness() = %+v, want the last modification", f)
+	}
+	if f := refreshFreshness("urn:li:chart:c", 0, 0); f.LastUpdated != 0 || f.Source != "" {
+		t.Errorf("refreshFreshness() = %+v, want no update time", f)
+	}
+}
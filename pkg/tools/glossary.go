@@ -2,8 +2,11 @@ package tools
 
 import (
 	"context"
+	"errors"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
 )
 
 // GetGlossaryTermInput is the input for the get_glossary_term tool.
@@ -13,6 +16,15 @@ type GetGlossaryTermInput struct {
 	Connection string `json:"connection,omitempty" jsonschema_description:"Named connection to use (see datahub_list_connections)"`
 }
 
+// ListGlossaryInput is the input for the list_glossary tool.
+type ListGlossaryInput struct {
+	Parent string `json:"parent,omitempty" jsonschema_description:"Glossary node URN to list the children of; omit for the glossary root"`
+	Limit  int    `json:"limit,omitempty" jsonschema_description:"Maximum number of nodes and terms (default: 10, max: 100)"`
+	Offset int    `json:"offset,omitempty" jsonschema_description:"Result offset for pagination"`
+	// Connection is the named connection to use. Empty uses the default connection.
+	Connection string `json:"connection,omitempty" jsonschema_description:"Named connection to use (see datahub_list_connections)"`
+}
+
 func (t *Toolkit) registerGetGlossaryTermTool(server *mcp.Server, cfg *toolConfig) {
 	baseHandler := func(ctx context.Context, req *mcp.CallToolRequest, input any) (*mcp.CallToolResult, any, error) {
 		glossaryInput, ok := input.(GetGlossaryTermInput)
@@ -61,3 +73,55 @@ func (t *Toolkit) handleGetGlossaryTerm(
 
 	return jsonResult, term, nil
 }
+
+func (t *Toolkit) registerListGlossaryTool(server *mcp.Server, cfg *toolConfig) {
+	baseHandler := func(ctx context.Context, req *mcp.CallToolRequest, input any) (*mcp.CallToolResult, any, error) {
+		listInput, ok := input.(ListGlossaryInput)
+		if !ok {
+			return ErrorResult("internal error: invalid input type"), nil, nil
+		}
+		return t.handleListGlossary(ctx, req, listInput)
+	}
+
+	wrappedHandler := t.wrapHandler(ToolListGlossary, baseHandler, cfg)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:         string(ToolListGlossary),
+		Description:  t.getDescription(ToolListGlossary, cfg),
+		Annotations:  t.getAnnotations(ToolListGlossary, cfg),
+		Icons:        t.getIcons(ToolListGlossary, cfg),
+		Title:        t.getTitle(ToolListGlossary, cfg),
+		OutputSchema: t.getOutputSchema(ToolListGlossary, cfg),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, input ListGlossaryInput) (*mcp.CallToolResult, any, error) {
+		return wrappedHandler(ctx, req, input)
+	})
+}
+
+func (t *Toolkit) handleListGlossary(
+	ctx context.Context,
+	_ *mcp.CallToolRequest,
+	input ListGlossaryInput,
+) (*mcp.CallToolResult, any, error) {
+	var opts []client.BrowseOption
+	if input.Limit > 0 {
+		opts = append(opts, client.WithBrowseLimit(input.Limit))
+	}
+	if input.Offset > 0 {
+		opts = append(opts, client.WithBrowseOffset(input.Offset))
+	}
+
+	datahubClient, err := t.getClient(input.Connection)
+	if err != nil {
+		return ErrorResult("Connection error: " + err.Error()), nil, nil
+	}
+
+	listing, err := datahubClient.ListGlossary(ctx, input.Parent, opts...)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return ErrorResult("Glossary node not found: " + input.Parent), nil, nil
+		}
+		return ErrorResult(err.Error()), nil, nil
+	}
+
+	return formatJSONResult(listing)
+}
@@ -3,8 +3,11 @@ package tools
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/txn2/mcp-datahub/pkg/client"
 	"github.com/txn2/mcp-datahub/pkg/types"
 )
 
@@ -65,3 +68,63 @@ func TestHandleGetGlossaryTerm(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleListGlossary(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      ListGlossaryInput
+		mockErr    error
+		wantErr    bool
+		wantErrMsg string
+		wantOpts   int
+	}{
+		{name: "root", input: ListGlossaryInput{}},
+		{name: "node with paging", input: ListGlossaryInput{Parent: "urn:li:glossaryNode:Finance", Limit: 5, Offset: 5}, wantOpts: 2},
+		{
+			name:       "node not found",
+			input:      ListGlossaryInput{Parent: "urn:li:glossaryNode:missing"},
+			mockErr:    fmt.Errorf("ListGlossary: %w", client.ErrNotFound),
+			wantErr:    true,
+			wantErrMsg: "Glossary node not found",
+		},
+		{name: "client error", input: ListGlossaryInput{}, mockErr: errors.New("boom"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotParent string
+			var gotOpts int
+			mock := &mockClient{
+				listGlossaryFunc: func(_ context.Context, parentURN string, opts ...client.BrowseOption) (*types.GlossaryListing, error) {
+					gotParent = parentURN
+					gotOpts = len(opts)
+					if tt.mockErr != nil {
+						return nil, tt.mockErr
+					}
+					return &types.GlossaryListing{Parent: parentURN, Total: 1, Entries: []types.GlossaryEntry{{URN: "urn:li:glossaryTerm:Revenue"}}}, nil
+				},
+			}
+			toolkit := NewToolkit(mock, DefaultConfig())
+
+			result, out, err := toolkit.handleListGlossary(context.Background(), nil, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.IsError != tt.wantErr {
+				t.Fatalf("IsError = %v, want %v (%s)", result.IsError, tt.wantErr, resultText(result))
+			}
+			if tt.wantErr {
+				if tt.wantErrMsg != "" && !strings.Contains(resultText(result), tt.wantErrMsg) {
+					t.Errorf("error %q does not mention %q", resultText(result), tt.wantErrMsg)
+				}
+				return
+			}
+			if gotParent != tt.input.Parent || gotOpts != tt.wantOpts {
+				t.Errorf("called with parent %q and %d options", gotParent, gotOpts)
+			}
+			if listing, ok := out.(*types.GlossaryListing); !ok || len(listing.Entries) != 1 {
+				t.Errorf("unexpected output: %#v", out)
+			}
+		})
+	}
+}
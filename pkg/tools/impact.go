@@ -0,0 +1,265 @@
+package tools
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+// defaultImpactDepth is the number of downstream hops analyzed when no depth is given.
+const defaultImpactDepth = 3
+
+// impactConcurrency bounds the number of entity lookups in flight at once.
+const impactConcurrency = 8
+
+// defaultCriticalTags are the tag names that mark an affected entity as critical
+// when the caller does not supply its own list.
+var defaultCriticalTags = []string{"critical", "tier1", "tier-1", "tier_1", "p0", "gold"}
+
+// ImpactAnalysisInput is the input for the impact_analysis tool.
+type ImpactAnalysisInput struct {
+	URN   string `json:"urn" jsonschema_description:"The DataHub URN of the entity about to change"`
+	Depth int    `json:"depth,omitempty" jsonschema_description:"Number of downstream hops to analyze (default: 3, max: 5)"`
+	// CriticalTags defaults to critical, tier1, tier-1, tier_1, p0 and gold.
+	CriticalTags []string `json:"critical_tags,omitempty" jsonschema_description:"Tags that mark an entity critical (default: tier1, p0, ...)"`
+	// Connection is the named connection to use. Empty uses the default connection.
+	Connection string `json:"connection,omitempty" jsonschema_description:"Named connection to use (see datahub_list_connections)"`
+}
+
+func (t *Toolkit) registerImpactAnalysisTool(server *mcp.Server, cfg *toolConfig) {
+	baseHandler := func(ctx context.Context, req *mcp.CallToolRequest, input any) (*mcp.CallToolResult, any, error) {
+		impactInput, ok := input.(ImpactAnalysisInput)
+		if !ok {
+			return ErrorResult("internal error: invalid input type"), nil, nil
+		}
+		return t.handleImpactAnalysis(ctx, req, impactInput)
+	}
+
+	wrappedHandler := t.wrapHandler(ToolImpactAnalysis, baseHandler, cfg)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:         string(ToolImpactAnalysis),
+		Description:  t.getDescription(ToolImpactAnalysis, cfg),
+		Annotations:  t.getAnnotations(ToolImpactAnalysis, cfg),
+		Icons:        t.getIcons(ToolImpactAnalysis, cfg),
+		Title:        t.getTitle(ToolImpactAnalysis, cfg),
+		OutputSchema: t.getOutputSchema(ToolImpactAnalysis, cfg),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, input ImpactAnalysisInput) (*mcp.CallToolResult, any, error) {
+		return wrappedHandler(ctx, req, input)
+	})
+}
+
+func (t *Toolkit) handleImpactAnalysis(
+	ctx context.Context,
+	_ *mcp.CallToolRequest,
+	input ImpactAnalysisInput,
+) (*mcp.CallToolResult, any, error) {
+	if input.URN == "" {
+		return ErrorResult("urn parameter is required"), nil, nil
+	}
+	depth := input.Depth
+	if depth <= 0 {
+		depth = defaultImpactDepth
+	}
+	criticalTags := input.CriticalTags
+	if len(criticalTags) == 0 {
+		criticalTags = defaultCriticalTags
+	}
+
+	datahubClient, err := t.getClient(input.Connection)
+	if err != nil {
+		return ErrorResult("Connection error: " + err.Error()), nil, nil
+	}
+
+	lineage, err := datahubClient.GetLineage(ctx, input.URN,
+		client.WithDirection(client.LineageDirectionDownstream),
+		client.WithDepth(depth),
+	)
+	if err != nil {
+		return ErrorResult(err.Error()), nil, nil
+	}
+
+	entities := t.describeImpacted(ctx, datahubClient, lineage.Nodes, criticalTags)
+	output := buildImpactReport(input.URN, lineage, entities)
+
+	return formatJSONResult(output)
+}
+
+// describeImpacted looks up owners, domain, tags and usage for each lineage node
+// concurrently. A failed lookup keeps the node with what lineage already knew. Each
+// URN is looked up once; a node that appears again reuses the first lookup.
+func (t *Toolkit) describeImpacted(
+	ctx context.Context, datahubClient DataHubClient, nodes []types.LineageNode, criticalTags []string,
+) []ImpactedEntity {
+	entities := make([]ImpactedEntity, len(nodes))
+	sem := make(chan struct{}, impactConcurrency)
+	first := make(map[string]int, len(nodes))
+
+	var wg sync.WaitGroup
+	for i, node := range nodes {
+		if _, seen := first[node.URN]; seen {
+			continue
+		}
+		first[node.URN] = i
+		wg.Add(1)
+		go func(i int, node types.LineageNode) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			entities[i] = t.describeImpactedEntity(ctx, datahubClient, node, criticalTags)
+		}(i, node)
+	}
+	wg.Wait()
+
+	for i, node := range nodes {
+		if j := first[node.URN]; j != i {
+			entities[i] = entities[j]
+			entities[i].Level = node.Level
+		}
+	}
+
+	return entities
+}
+
+// describeImpactedEntity builds the report entry for a single lineage node.
+func (t *Toolkit) describeImpactedEntity(
+	ctx context.Context, datahubClient DataHubClient, node types.LineageNode, criticalTags []string,
+) ImpactedEntity {
+	impacted := ImpactedEntity{
+		URN:      node.URN,
+		Type:     node.Type,
+		Name:     node.Name,
+		Platform: node.Platform,
+		Level:    node.Level,
+	}
+
+	var entity *types.Entity
+	if node.Type == "DATA_JOB" {
+		job, err := datahubClient.GetDataJob(ctx, node.URN, client.WithDataJobRunLimit(1))
+		if err == nil {
+			entity = &job.Entity
+		}
+	} else if e, err := datahubClient.GetEntity(ctx, node.URN); err == nil {
+		entity = e
+	}
+	if entity == nil {
+		t.log().Warn("impact analysis entity lookup failed", "urn", node.URN)
+	} else {
+		impacted.Name = cmp.Or(entity.Name, impacted.Name)
+		impacted.Platform = cmp.Or(entity.Platform, impacted.Platform)
+		impacted.Owners = entity.Owners
+		impacted.Domain = entity.Domain
+		for _, tag := range entity.Tags {
+			name := cmp.Or(tag.Name, strings.TrimPrefix(tag.URN, "urn:li:tag:"))
+			impacted.Tags = append(impacted.Tags, name)
+			if isCriticalTag(name, criticalTags) {
+				impacted.Critical = true
+			}
+		}
+	}
+
+	if node.Type == "DATASET" {
+		if usage, err := datahubClient.GetUsageStats(ctx, node.URN); err == nil {
+			impacted.Queries = usage.TotalQueries
+			impacted.UniqueUsers = usage.UniqueUsers
+		}
+	}
+
+	return impacted
+}
+
+// isCriticalTag reports whether name matches one of the critical tag names, ignoring case.
+func isCriticalTag(name string, criticalTags []string) bool {
+	for _, critical := range criticalTags {
+		if strings.EqualFold(name, critical) {
+			return true
+		}
+	}
+	return false
+}
+
+// buildImpactReport groups the affected entities by owner and domain. Owners are
+// ordered by the number of critical entities they own, then by total entities, so
+// the people to warn first come first.
+func buildImpactReport(urn string, lineage *types.LineageResult, entities []ImpactedEntity) ImpactAnalysisOutput {
+	output := ImpactAnalysisOutput{
+		URN:           urn,
+		Depth:         lineage.Depth,
+		TotalAffected: len(entities),
+		Truncated:     lineage.Truncated,
+		Entities:      entities,
+		ByOwner:       []OwnerImpact{},
+		ByDomain:      []DomainImpact{},
+	}
+	if output.Entities == nil {
+		output.Entities = []ImpactedEntity{}
+	}
+
+	owners := make(map[string]*OwnerImpact)
+	domains := make(map[string]*DomainImpact)
+	for _, e := range entities {
+		if e.Critical {
+			output.Critical++
+		}
+		if len(e.Owners) == 0 {
+			output.Unowned = append(output.Unowned, e.URN)
+		}
+		for _, owner := range e.Owners {
+			impact, ok := owners[owner.URN]
+			if !ok {
+				impact = &OwnerImpact{URN: owner.URN, Name: owner.Name, Email: owner.Email}
+				owners[owner.URN] = impact
+			}
+			impact.Entities = append(impact.Entities, e.URN)
+			if e.Critical {
+				impact.Critical++
+			}
+		}
+		if e.Domain != nil {
+			impact, ok := domains[e.Domain.URN]
+			if !ok {
+				impact = &DomainImpact{URN: e.Domain.URN, Name: e.Domain.Name}
+				domains[e.Domain.URN] = impact
+			}
+			impact.Entities = append(impact.Entities, e.URN)
+		}
+	}
+
+	for _, impact := range owners {
+		output.ByOwner = append(output.ByOwner, *impact)
+	}
+	sort.Slice(output.ByOwner, func(a, b int) bool {
+		oa, ob := output.ByOwner[a], output.ByOwner[b]
+		if oa.Critical != ob.Critical {
+			return oa.Critical > ob.Critical
+		}
+		if len(oa.Entities) != len(ob.Entities) {
+			return len(oa.Entities) > len(ob.Entities)
+		}
+		return oa.URN < ob.URN
+	})
+
+	for _, impact := range domains {
+		output.ByDomain = append(output.ByDomain, *impact)
+	}
+	sort.Slice(output.ByDomain, func(a, b int) bool {
+		return output.ByDomain[a].URN < output.ByDomain[b].URN
+	})
+
+	if lineage.Truncated {
+		output.Warning = fmt.Sprintf("Only %d of %d downstream entities were analyzed; "+
+			"reduce depth or use datahub_get_lineage with filters to see the rest.",
+			len(entities), lineage.Total)
+	}
+
+	return output
+}
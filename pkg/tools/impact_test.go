@@ -0,0 +1,206 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+func impactMock() *mockClient {
+	jdoe := types.Owner{URN: "urn:li:corpuser:jdoe", Name: "Jane Doe", Email: "jdoe@example.com"}
+	team := types.Owner{URN: "urn:li:corpGroup:analytics", Name: "Analytics"}
+	finance := &types.Domain{URN: "urn:li:domain:finance", Name: "Finance"}
+
+	entities := map[string]*types.Entity{
+		"urn:li:dataset:revenue": {
+			URN: "urn:li:dataset:revenue", Name: "revenue", Owners: []types.Owner{jdoe, team},
+			Domain: finance, Tags: []types.Tag{{URN: "urn:li:tag:Tier1", Name: "Tier1"}},
+		},
+		"urn:li:dataset:staging": {
+			URN: "urn:li:dataset:staging", Name: "staging", Owners: []types.Owner{team},
+			Tags: []types.Tag{{URN: "urn:li:tag:pii"}},
+		},
+	}
+
+	return &mockClient{
+		getLineageFunc: func(_ context.Context, urn string, _ ...client.LineageOption) (*types.LineageResult, error) {
+			return &types.LineageResult{
+				Start:     urn,
+				Direction: client.LineageDirectionDownstream,
+				Depth:     3,
+				Nodes: []types.LineageNode{
+					{URN: "urn:li:dataset:revenue", Type: "DATASET", Level: 1},
+					{URN: "urn:li:dataset:staging", Type: "DATASET", Level: 1},
+					{URN: "urn:li:dataJob:load", Type: "DATA_JOB", Name: "load", Level: 2},
+					{URN: "urn:li:dashboard:sales", Type: "DASHBOARD", Name: "Sales", Level: 2},
+				},
+			}, nil
+		},
+		getEntityFunc: func(_ context.Context, urn string) (*types.Entity, error) {
+			if e, ok := entities[urn]; ok {
+				return e, nil
+			}
+			return nil, client.ErrNotFound
+		},
+		getDataJobFunc: func(_ context.Context, urn string, _ ...client.DataJobOption) (*types.Pipeline, error) {
+			return &types.Pipeline{Entity: types.Entity{URN: urn, Name: "Load job", Owners: []types.Owner{jdoe}}}, nil
+		},
+		getUsageStatsFunc: func(_ context.Context, urn string, _ ...client.UsageOption) (*types.UsageStats, error) {
+			if urn == "urn:li:dataset:revenue" {
+				return &types.UsageStats{URN: urn, TotalQueries: 1320, UniqueUsers: 41}, nil
+			}
+			return nil, errors.New("usage unavailable")
+		},
+	}
+}
+
+func TestHandleImpactAnalysis(t *testing.T) {
+	toolkit := NewToolkit(impactMock(), DefaultConfig())
+	result, _, _ := toolkit.handleImpactAnalysis(context.Background(), nil, ImpactAnalysisInput{URN: "urn:li:dataset:orders"})
+	if result.IsError {
+		t.Fatalf("handleImpactAnalysis() error: %s", resultText(result))
+	}
+
+	var out ImpactAnalysisOutput
+	if err := json.Unmarshal([]byte(resultText(result)), &out); err != nil {
+		t.Fatalf("failed to parse result: %v", err)
+	}
+
+	if out.TotalAffected != 4 || out.Critical != 1 {
+		t.Errorf("total/critical = %d/%d, want 4/1", out.TotalAffected, out.Critical)
+	}
+	if len(out.ByOwner) != 2 {
+		t.Fatalf("ByOwner = %+v, want 2 owners", out.ByOwner)
+	}
+	// Both owners have one critical and two affected entities, so URN order decides.
+	if out.ByOwner[0].URN != "urn:li:corpGroup:analytics" || out.ByOwner[0].Critical != 1 || len(out.ByOwner[0].Entities) != 2 {
+		t.Errorf("first owner = %+v, want analytics with two datasets", out.ByOwner[0])
+	}
+	if out.ByOwner[1].URN != "urn:li:corpuser:jdoe" || out.ByOwner[1].Email != "jdoe@example.com" || len(out.ByOwner[1].Entities) != 2 {
+		t.Errorf("second owner = %+v, want jdoe with the critical dataset and the job", out.ByOwner[1])
+	}
+	if len(out.ByDomain) != 1 || out.ByDomain[0].Name != "Finance" {
+		t.Errorf("ByDomain = %+v, want Finance", out.ByDomain)
+	}
+	if len(out.Unowned) != 1 || out.Unowned[0] != "urn:li:dashboard:sales" {
+		t.Errorf("Unowned = %v, want the dashboard", out.Unowned)
+	}
+
+	byURN := make(map[string]ImpactedEntity)
+	for _, e := range out.Entities {
+		byURN[e.URN] = e
+	}
+	revenue := byURN["urn:li:dataset:revenue"]
+	if !revenue.Critical || revenue.Queries != 1320 || revenue.UniqueUsers != 41 || revenue.Name != "revenue" {
+		t.Errorf("revenue = %+v", revenue)
+	}
+	if staging := byURN["urn:li:dataset:staging"]; staging.Critical || len(staging.Tags) != 1 || staging.Tags[0] != "pii" {
+		t.Errorf("staging = %+v, want non-critical with tag from URN", staging)
+	}
+	if job := byURN["urn:li:dataJob:load"]; job.Name != "Load job" || job.Level != 2 {
+		t.Errorf("job = %+v, want details from GetDataJob", job)
+	}
+	if dashboard := byURN["urn:li:dashboard:sales"]; dashboard.Name != "Sales" {
+		t.Errorf("dashboard = %+v, want lineage name kept when lookup fails", dashboard)
+	}
+}
+
+func TestHandleImpactAnalysisCustomCriticalTags(t *testing.T) {
+	toolkit := NewToolkit(impactMock(), DefaultConfig())
+	result, _, _ := toolkit.handleImpactAnalysis(context.Background(), nil, ImpactAnalysisInput{
+		URN:          "urn:li:dataset:orders",
+		CriticalTags: []string{"PII"},
+	})
+
+	var out ImpactAnalysisOutput
+	if err := json.Unmarshal([]byte(resultText(result)), &out); err != nil {
+		t.Fatalf("failed to parse result: %v", err)
+	}
+	if out.Critical != 1 || out.ByOwner[0].URN != "urn:li:corpGroup:analytics" {
+		t.Errorf("critical = %d, first owner = %s; want staging critical and analytics first", out.Critical, out.ByOwner[0].URN)
+	}
+}
+
+func TestDescribeImpactedLooksUpEachURNOnce(t *testing.T) {
+	var mu sync.Mutex
+	calls := make(map[string]int)
+	count := func(kind, urn string) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls[kind+" "+urn]++
+	}
+	mock := &mockClient{
+		getEntityFunc: func(_ context.Context, urn string) (*types.Entity, error) {
+			count("entity", urn)
+			return &types.Entity{URN: urn, Name: "revenue"}, nil
+		},
+		getUsageStatsFunc: func(_ context.Context, urn string, _ ...client.UsageOption) (*types.UsageStats, error) {
+			count("usage", urn)
+			return &types.UsageStats{URN: urn, TotalQueries: 7}, nil
+		},
+	}
+	nodes := []types.LineageNode{
+		{URN: "urn:li:dataset:revenue", Type: "DATASET", Level: 1},
+		{URN: "urn:li:dataset:revenue", Type: "DATASET", Level: 2},
+	}
+
+	toolkit := NewToolkit(mock, DefaultConfig())
+	entities := toolkit.describeImpacted(context.Background(), mock, nodes, defaultCriticalTags)
+
+	for key, n := range calls {
+		if n != 1 {
+			t.Errorf("%s looked up %d times, want 1", key, n)
+		}
+	}
+	if len(calls) != 2 {
+		t.Errorf("lookups = %v, want one entity and one usage lookup", calls)
+	}
+	if entities[1].Name != "revenue" || entities[1].Queries != 7 || entities[1].Level != 2 {
+		t.Errorf("repeated node = %+v, want the first lookup at its own level", entities[1])
+	}
+}
+
+func TestHandleImpactAnalysisErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      ImpactAnalysisInput
+		mockErr    error
+		wantErrMsg string
+	}{
+		{name: "empty URN", input: ImpactAnalysisInput{}, wantErrMsg: "urn parameter is required"},
+		{name: "lineage error", input: ImpactAnalysisInput{URN: "urn:li:dataset:x"}, mockErr: errors.New("boom"), wantErrMsg: "boom"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockClient{
+				getLineageFunc: func(_ context.Context, _ string, _ ...client.LineageOption) (*types.LineageResult, error) {
+					return nil, tt.mockErr
+				},
+			}
+			toolkit := NewToolkit(mock, DefaultConfig())
+			result, _, _ := toolkit.handleImpactAnalysis(context.Background(), nil, tt.input)
+			if !result.IsError || !strings.Contains(resultText(result), tt.wantErrMsg) {
+				t.Errorf("handleImpactAnalysis() = %s, want error %q", resultText(result), tt.wantErrMsg)
+			}
+		})
+	}
+}
+
+func TestBuildImpactReportTruncated(t *testing.T) {
+	out := buildImpactReport("urn:li:dataset:x",
+		&types.LineageResult{Depth: 2, Total: 500, Truncated: true},
+		[]ImpactedEntity{{URN: "urn:li:dataset:a"}})
+
+	if !out.Truncated || !strings.Contains(out.Warning, "1 of 500") {
+		t.Errorf("buildImpactReport() truncated/warning = %v/%q", out.Truncated, out.Warning)
+	}
+	if out.ByOwner == nil || out.ByDomain == nil {
+		t.Error("buildImpactReport() groups should be empty slices, not nil")
+	}
+}
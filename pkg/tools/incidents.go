@@ -0,0 +1,81 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+// ListIncidentsInput is the input for the list_incidents tool.
+type ListIncidentsInput struct {
+	URN   string `json:"urn" jsonschema_description:"The DataHub URN of the entity (dataset, data job, data flow, dashboard or chart)"`
+	State string `json:"state,omitempty" jsonschema_description:"Filter by state: ACTIVE or RESOLVED (default: all)"`
+	Limit int    `json:"limit,omitempty" jsonschema_description:"Maximum number of incidents to return (default: 20)"`
+	// Connection is the named connection to use. Empty uses the default connection.
+	Connection string `json:"connection,omitempty" jsonschema_description:"Named connection to use (see datahub_list_connections)"`
+}
+
+func (t *Toolkit) registerListIncidentsTool(server *mcp.Server, cfg *toolConfig) {
+	baseHandler := func(ctx context.Context, req *mcp.CallToolRequest, input any) (*mcp.CallToolResult, any, error) {
+		incidentsInput, ok := input.(ListIncidentsInput)
+		if !ok {
+			return ErrorResult("internal error: invalid input type"), nil, nil
+		}
+		return t.handleListIncidents(ctx, req, incidentsInput)
+	}
+
+	wrappedHandler := t.wrapHandler(ToolListIncidents, baseHandler, cfg)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:         string(ToolListIncidents),
+		Description:  t.getDescription(ToolListIncidents, cfg),
+		Annotations:  t.getAnnotations(ToolListIncidents, cfg),
+		Icons:        t.getIcons(ToolListIncidents, cfg),
+		Title:        t.getTitle(ToolListIncidents, cfg),
+		OutputSchema: t.getOutputSchema(ToolListIncidents, cfg),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, input ListIncidentsInput) (*mcp.CallToolResult, any, error) {
+		return wrappedHandler(ctx, req, input)
+	})
+}
+
+func (t *Toolkit) handleListIncidents(
+	ctx context.Context,
+	_ *mcp.CallToolRequest,
+	input ListIncidentsInput,
+) (*mcp.CallToolResult, any, error) {
+	if input.URN == "" {
+		return ErrorResult("urn parameter is required"), nil, nil
+	}
+
+	var opts []client.IncidentOption
+	if input.State != "" {
+		state := strings.ToUpper(input.State)
+		if state != types.IncidentStateActive && state != types.IncidentStateResolved {
+			return ErrorResult("state must be ACTIVE or RESOLVED"), nil, nil
+		}
+		opts = append(opts, client.WithIncidentState(state))
+	}
+	if input.Limit > 0 {
+		opts = append(opts, client.WithIncidentLimit(input.Limit))
+	}
+
+	datahubClient, err := t.getClient(input.Connection)
+	if err != nil {
+		return ErrorResult("Connection error: " + err.Error()), nil, nil
+	}
+
+	incidents, err := datahubClient.ListIncidents(ctx, input.URN, opts...)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return ErrorResult("Entity not found: " + input.URN), nil, nil
+		}
+		return ErrorResult(err.Error()), nil, nil
+	}
+
+	return formatJSONResult(incidents)
+}
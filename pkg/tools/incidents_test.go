@@ -0,0 +1,95 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+func TestHandleListIncidents(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      ListIncidentsInput
+		mockErr    error
+		wantErr    bool
+		wantErrMsg string
+		wantOpts   int
+	}{
+		{
+			name:  "all incidents",
+			input: ListIncidentsInput{URN: "urn:li:dataset:test"},
+		},
+		{
+			name:     "state and limit",
+			input:    ListIncidentsInput{URN: "urn:li:dataset:test", State: "active", Limit: 5},
+			wantOpts: 2,
+		},
+		{
+			name:       "empty URN",
+			input:      ListIncidentsInput{},
+			wantErr:    true,
+			wantErrMsg: "urn parameter is required",
+		},
+		{
+			name:       "invalid state",
+			input:      ListIncidentsInput{URN: "urn:li:dataset:test", State: "OPEN"},
+			wantErr:    true,
+			wantErrMsg: "state must be ACTIVE or RESOLVED",
+		},
+		{
+			name:       "entity not found",
+			input:      ListIncidentsInput{URN: "urn:li:dataset:missing"},
+			mockErr:    fmt.Errorf("ListIncidents: %w", client.ErrNotFound),
+			wantErr:    true,
+			wantErrMsg: "Entity not found",
+		},
+		{
+			name:    "client error",
+			input:   ListIncidentsInput{URN: "urn:li:dataset:test"},
+			mockErr: errors.New("incidents unavailable"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotOpts int
+			mock := &mockClient{
+				listIncidentsFunc: func(_ context.Context, urn string, opts ...client.IncidentOption) (*types.IncidentList, error) {
+					gotOpts = len(opts)
+					if tt.mockErr != nil {
+						return nil, tt.mockErr
+					}
+					return &types.IncidentList{URN: urn, Total: 1, Incidents: []types.Incident{{URN: "urn:li:incident:a"}}}, nil
+				},
+			}
+			toolkit := NewToolkit(mock, DefaultConfig())
+
+			result, out, err := toolkit.handleListIncidents(context.Background(), nil, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.IsError != tt.wantErr {
+				t.Fatalf("IsError = %v, want %v (%s)", result.IsError, tt.wantErr, resultText(result))
+			}
+			if tt.wantErr {
+				if tt.wantErrMsg != "" && !strings.Contains(resultText(result), tt.wantErrMsg) {
+					t.Errorf("error %q does not mention %q", resultText(result), tt.wantErrMsg)
+				}
+				return
+			}
+			if gotOpts != tt.wantOpts {
+				t.Errorf("passed %d options, want %d", gotOpts, tt.wantOpts)
+			}
+			list, ok := out.(*types.IncidentList)
+			if !ok || list.Total != 1 {
+				t.Errorf("unexpected output: %#v", out)
+			}
+		})
+	}
+}
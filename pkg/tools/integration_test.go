@@ -116,6 +116,26 @@ func TestToolsViaServer(t *testing.T) {
 		{"get_glossary_term", ToolGetGlossaryTerm, map[string]any{"urn": "urn:li:glossaryTerm:test"}},
 		{"list_data_products", ToolListDataProducts, map[string]any{}},
 		{"get_data_product", ToolGetDataProduct, map[string]any{"urn": "urn:li:dataProduct:test"}},
+		{"get_dataset_profile", ToolGetDatasetProfile, map[string]any{"urn": "urn:li:dataset:test"}},
+		{"get_usage_stats", ToolGetUsageStats, map[string]any{"urn": "urn:li:dataset:test"}},
+		{"get_assertions", ToolGetAssertions, map[string]any{"urn": "urn:li:dataset:test"}},
+		{"list_incidents", ToolListIncidents, map[string]any{"urn": "urn:li:dataset:test"}},
+		{"get_data_contract", ToolGetDataContract, map[string]any{"urn": "urn:li:dataset:test"}},
+		{"browse", ToolBrowse, map[string]any{"urn": "urn:li:container:test"}},
+		{"get_container", ToolGetContainer, map[string]any{"urn": "urn:li:container:test"}},
+		{"get_dashboard", ToolGetDashboard, map[string]any{"urn": "urn:li:dashboard:(looker,sales)"}},
+		{"get_chart", ToolGetChart, map[string]any{"urn": "urn:li:chart:(looker,revenue)"}},
+		{"get_data_job", ToolGetDataJob, map[string]any{"urn": "urn:li:dataJob:(urn:li:dataFlow:(airflow,etl,prod),load)"}},
+		{"get_data_flow", ToolGetDataFlow, map[string]any{"urn": "urn:li:dataFlow:(airflow,etl,prod)"}},
+		{"get_user", ToolGetUser, map[string]any{"urn": "urn:li:corpuser:jdoe"}},
+		{"get_group", ToolGetGroup, map[string]any{"urn": "urn:li:corpGroup:data-eng"}},
+		{"list_owned_entities", ToolListOwnedEntities, map[string]any{"urn": "urn:li:corpuser:jdoe"}},
+		{"list_glossary", ToolListGlossary, map[string]any{"limit": 5}},
+		{"impact_analysis", ToolImpactAnalysis, map[string]any{"urn": "urn:li:dataset:test"}},
+		{"trace_column", ToolTraceColumn, map[string]any{"urn": "urn:li:dataset:test", "column": "id"}},
+		{"find_lineage_path", ToolFindLineagePath, map[string]any{"source": "urn:li:dataset:a", "target": "urn:li:dataset:b"}},
+		{"check_freshness", ToolCheckFreshness, map[string]any{"urn": "urn:li:dataset:test"}},
+		{"federated_search", ToolFederatedSearch, map[string]any{"query": "test"}},
 	}
 
 	for _, tt := range tests {
@@ -340,6 +360,36 @@ func TestWriteToolsViaServer(t *testing.T) {
 				"url": "https://docs.example.com",
 			},
 		},
+		{
+			"raise_incident", ToolRaiseIncident,
+			map[string]any{
+				"urn":   "urn:li:dataset:(urn:li:dataPlatform:hive,db.table,PROD)",
+				"title": "Table is empty",
+			},
+		},
+		{
+			"resolve_incident", ToolResolveIncident,
+			map[string]any{
+				"incident_urn": "urn:li:incident:abc",
+				"message":      "Backfilled",
+			},
+		},
+		{
+			"add_lineage", ToolAddLineage,
+			map[string]any{
+				"upstream":   "urn:li:dataset:(urn:li:dataPlatform:hive,db.raw,PROD)",
+				"downstream": "urn:li:dataset:(urn:li:dataPlatform:hive,db.table,PROD)",
+			},
+		},
+		{
+			"remove_lineage", ToolRemoveLineage,
+			map[string]any{
+				"upstream":          "urn:li:dataset:(urn:li:dataPlatform:hive,db.raw,PROD)",
+				"downstream":        "urn:li:dataset:(urn:li:dataPlatform:hive,db.table,PROD)",
+				"upstream_column":   "id",
+				"downstream_column": "id",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -3,18 +3,34 @@ package tools
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"time"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 
 	"github.com/txn2/mcp-datahub/pkg/client"
+	"github.com/txn2/mcp-datahub/pkg/lineage/render"
 	"github.com/txn2/mcp-datahub/pkg/types"
 )
 
 // GetLineageInput is the input for the get_lineage tool.
 type GetLineageInput struct {
 	URN       string `json:"urn" jsonschema_description:"The DataHub URN of the entity"`
-	Direction string `json:"direction,omitempty" jsonschema_description:"Lineage direction: UPSTREAM or DOWNSTREAM (default: DOWNSTREAM)"`
+	Direction string `json:"direction,omitempty" jsonschema_description:"UPSTREAM, DOWNSTREAM or BOTH (default: DOWNSTREAM)"`
 	Depth     int    `json:"depth,omitempty" jsonschema_description:"Maximum depth of lineage traversal (default: 1, max: 5)"`
+	// EntityTypes limits results to these entity types.
+	EntityTypes []string `json:"entity_types,omitempty" jsonschema_description:"Only return these entity types (e.g. DATASET, DASHBOARD)"`
+	// Platforms limits results to entities on these platforms.
+	Platforms []string `json:"platforms,omitempty" jsonschema_description:"Only return entities on these platforms (e.g. snowflake)"`
+	// StartTimeMillis and EndTimeMillis limit lineage to edges observed in the window.
+	StartTimeMillis int64 `json:"start_time_millis,omitempty" jsonschema_description:"Only lineage observed at or after this time (epoch ms)"`
+	EndTimeMillis   int64 `json:"end_time_millis,omitempty" jsonschema_description:"Only lineage observed at or before this time (epoch ms)"`
+	// AsOfMillis returns lineage as it stood at a point in time.
+	AsOfMillis int64 `json:"as_of_millis,omitempty" jsonschema_description:"Lineage as it stood at this time (epoch ms)"`
+	Limit      int   `json:"limit,omitempty" jsonschema_description:"Maximum number of results per direction (default and max: 100)"`
+	Offset     int   `json:"offset,omitempty" jsonschema_description:"Result offset for pagination"`
+	// Format renders the lineage as a graph instead of JSON.
+	Format string `json:"format,omitempty" jsonschema_description:"Output format: json (default), dot, mermaid or graphml"`
 	// Connection is the named connection to use. Empty uses the default connection.
 	Connection string `json:"connection,omitempty" jsonschema_description:"Named connection to use (see datahub_list_connections)"`
 }
@@ -46,12 +62,36 @@ func (t *Toolkit) handleGetLineage(ctx context.Context, _ *mcp.CallToolRequest,
 	if input.URN == "" {
 		return ErrorResult("urn parameter is required"), nil, nil
 	}
+	if input.AsOfMillis > 0 && (input.StartTimeMillis > 0 || input.EndTimeMillis > 0) {
+		return ErrorResult("as_of_millis cannot be combined with start_time_millis or end_time_millis"), nil, nil
+	}
+	format, err := render.ParseFormat(input.Format)
+	if err != nil {
+		return ErrorResult(err.Error()), nil, nil
+	}
 
 	datahubClient, err := t.getClient(input.Connection)
 	if err != nil {
 		return ErrorResult("Connection error: " + err.Error()), nil, nil
 	}
 
+	lineage, err := datahubClient.GetLineage(ctx, input.URN, lineageOptions(input)...)
+	if err != nil {
+		return ErrorResult(err.Error()), nil, nil
+	}
+
+	if format != render.FormatJSON {
+		return formatGraphResult(render.FromLineage(lineage), format, lineageTruncationWarning(lineage))
+	}
+	if t.queryProvider != nil {
+		return t.enrichLineageWithQueryContext(ctx, lineage)
+	}
+
+	return formatJSONResult(GetLineageOutput{LineageResult: lineage, Warning: lineageTruncationWarning(lineage)})
+}
+
+// lineageOptions converts get_lineage input into client lineage options.
+func lineageOptions(input GetLineageInput) []client.LineageOption {
 	var opts []client.LineageOption
 	if input.Direction != "" {
 		opts = append(opts, client.WithDirection(input.Direction))
@@ -59,17 +99,66 @@ func (t *Toolkit) handleGetLineage(ctx context.Context, _ *mcp.CallToolRequest,
 	if input.Depth > 0 {
 		opts = append(opts, client.WithDepth(input.Depth))
 	}
+	if len(input.EntityTypes) > 0 {
+		opts = append(opts, client.WithLineageEntityTypes(input.EntityTypes...))
+	}
+	if len(input.Platforms) > 0 {
+		opts = append(opts, client.WithLineagePlatforms(input.Platforms...))
+	}
+	if input.StartTimeMillis > 0 || input.EndTimeMillis > 0 {
+		opts = append(opts, client.WithLineageTimeRange(millisToTime(input.StartTimeMillis), millisToTime(input.EndTimeMillis)))
+	}
+	if input.AsOfMillis > 0 {
+		opts = append(opts, client.WithLineageAsOf(millisToTime(input.AsOfMillis)))
+	}
+	if input.Limit > 0 {
+		opts = append(opts, client.WithLineageLimit(input.Limit))
+	}
+	if input.Offset > 0 {
+		opts = append(opts, client.WithLineageOffset(input.Offset))
+	}
+	return opts
+}
+
+// graphFences are the code block languages used for each rendered format.
+var graphFences = map[string]string{
+	render.FormatDOT:     "dot",
+	render.FormatMermaid: "mermaid",
+	render.FormatGraphML: "xml",
+}
 
-	lineage, err := datahubClient.GetLineage(ctx, input.URN, opts...)
+// formatGraphResult renders g in format. The text content is a fenced code block,
+// which markdown-aware clients draw inline; the structured output holds the raw graph.
+func formatGraphResult(g *render.Graph, format, warning string) (*mcp.CallToolResult, any, error) {
+	graph, err := render.Render(g, format)
 	if err != nil {
 		return ErrorResult(err.Error()), nil, nil
 	}
 
-	if t.queryProvider != nil {
-		return t.enrichLineageWithQueryContext(ctx, lineage)
+	text := "```" + graphFences[format] + "\n" + graph + "```"
+	if warning != "" {
+		text = warning + "\n\n" + text
+	}
+	return TextResult(text), RenderedLineageOutput{Format: format, Graph: graph, Warning: warning}, nil
+}
+
+// lineageTruncationWarning explains how to get the rest of a capped lineage result. The
+// count is what DataHub returned, not the nodes left after depth pruning.
+func lineageTruncationWarning(lineage *types.LineageResult) string {
+	if !lineage.Truncated {
+		return ""
 	}
+	return fmt.Sprintf("Lineage truncated: %d of %d results returned. "+
+		"Narrow with entity_types, platforms or depth, or page with offset.",
+		lineage.Fetched, lineage.Total)
+}
 
-	return formatJSONResult(lineage)
+// millisToTime converts epoch milliseconds, treating zero as unset.
+func millisToTime(millis int64) time.Time {
+	if millis == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(millis)
 }
 
 // enrichLineageWithQueryContext flattens lineage fields to top level and appends
@@ -85,6 +174,10 @@ func (t *Toolkit) enrichLineageWithQueryContext(ctx context.Context, lineage *ty
 	response := map[string]any{}
 	_ = json.Unmarshal(lineageJSON, &response)
 
+	if warning := lineageTruncationWarning(lineage); warning != "" {
+		response["warning"] = warning
+	}
+
 	urns := collectLineageURNs(lineage)
 	if len(urns) > 0 {
 		if execCtx, execErr := t.queryProvider.GetExecutionContext(ctx, urns); execErr == nil && execCtx != nil {
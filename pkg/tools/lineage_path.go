@@ -0,0 +1,363 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+// defaultPathDepth is the number of hops searched when no depth is given.
+const defaultPathDepth = 3
+
+// maxLineagePaths caps the number of paths a single call returns.
+const maxLineagePaths = 20
+
+// maxPathExpansions bounds the number of partial paths extended while searching, so
+// densely connected graphs cannot make the search explode.
+const maxPathExpansions = 10000
+
+// FindLineagePathInput is the input for the find_lineage_path tool.
+type FindLineagePathInput struct {
+	Source   string `json:"source" jsonschema_description:"URN of the entity the data may flow from"`
+	Target   string `json:"target" jsonschema_description:"URN of the entity the data may flow to"`
+	Depth    int    `json:"depth,omitempty" jsonschema_description:"Maximum number of hops to search (default: 3, max: 5)"`
+	MaxPaths int    `json:"max_paths,omitempty" jsonschema_description:"Maximum paths to return, shortest first (default: 1, max: 20)"`
+	// Connection is the named connection to use. Empty uses the default connection.
+	Connection string `json:"connection,omitempty" jsonschema_description:"Named connection to use (see datahub_list_connections)"`
+}
+
+func (t *Toolkit) registerFindLineagePathTool(server *mcp.Server, cfg *toolConfig) {
+	baseHandler := func(ctx context.Context, req *mcp.CallToolRequest, input any) (*mcp.CallToolResult, any, error) {
+		pathInput, ok := input.(FindLineagePathInput)
+		if !ok {
+			return ErrorResult("internal error: invalid input type"), nil, nil
+		}
+		return t.handleFindLineagePath(ctx, req, pathInput)
+	}
+
+	wrappedHandler := t.wrapHandler(ToolFindLineagePath, baseHandler, cfg)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:         string(ToolFindLineagePath),
+		Description:  t.getDescription(ToolFindLineagePath, cfg),
+		Annotations:  t.getAnnotations(ToolFindLineagePath, cfg),
+		Icons:        t.getIcons(ToolFindLineagePath, cfg),
+		Title:        t.getTitle(ToolFindLineagePath, cfg),
+		OutputSchema: t.getOutputSchema(ToolFindLineagePath, cfg),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, input FindLineagePathInput) (*mcp.CallToolResult, any, error) {
+		return wrappedHandler(ctx, req, input)
+	})
+}
+
+func (t *Toolkit) handleFindLineagePath(
+	ctx context.Context,
+	_ *mcp.CallToolRequest,
+	input FindLineagePathInput,
+) (*mcp.CallToolResult, any, error) {
+	if input.Source == "" {
+		return ErrorResult("source parameter is required"), nil, nil
+	}
+	if input.Target == "" {
+		return ErrorResult("target parameter is required"), nil, nil
+	}
+	if input.Source == input.Target {
+		return ErrorResult("source and target must be different entities"), nil, nil
+	}
+	depth := input.Depth
+	if depth <= 0 {
+		depth = defaultPathDepth
+	}
+	maxPaths := input.MaxPaths
+	if maxPaths <= 0 {
+		maxPaths = 1
+	}
+	if maxPaths > maxLineagePaths {
+		maxPaths = maxLineagePaths
+	}
+
+	datahubClient, err := t.getClient(input.Connection)
+	if err != nil {
+		return ErrorResult("Connection error: " + err.Error()), nil, nil
+	}
+
+	lineage, capped, err := lineageReaching(ctx, datahubClient, input.Source, input.Target, depth)
+	if err != nil {
+		return ErrorResult(err.Error()), nil, nil
+	}
+
+	output := FindLineagePathOutput{
+		Source: input.Source,
+		Target: input.Target,
+		Depth:  depth,
+		Paths:  []LineagePath{},
+	}
+	if lineage == nil {
+		output.Message = fmt.Sprintf("No lineage path between %s and %s within %d hops in either direction.",
+			input.Source, input.Target, depth)
+		if capped {
+			output.Message += " Lineage results were truncated, so a path may exist beyond them; try a smaller depth."
+		}
+		return formatJSONResult(output)
+	}
+
+	output.Found = true
+	output.Direction = lineage.Direction
+	output.Depth = lineage.Depth
+
+	// Edges follow the data flow, so an upstream result runs from target to source.
+	from, to := input.Source, input.Target
+	if lineage.Direction == client.LineageDirectionUpstream {
+		from, to = input.Target, input.Source
+	}
+	output.Paths, output.Truncated = findLineagePaths(lineage, from, to, maxPaths)
+	t.attachHopQueries(ctx, datahubClient, output.Paths)
+	output.Entities = pathEntities(lineage, output.Paths)
+
+	switch {
+	case len(output.Paths) == 0:
+		output.Message = fmt.Sprintf("%s is within %d hops of %s, but DataHub returned no edges connecting them.",
+			input.Target, depth, input.Source)
+	case lineage.Direction == client.LineageDirectionUpstream:
+		output.Message = fmt.Sprintf("%s does not feed %s; data flows the other way, from target to source.",
+			input.Source, input.Target)
+	}
+
+	return formatJSONResult(output)
+}
+
+// lineageReaching returns the lineage of source in the first direction that reaches
+// target, or nil when neither does, and whether any result was truncated. It looks
+// downstream first, since "does A feed B" is the usual question.
+func lineageReaching(
+	ctx context.Context, datahubClient DataHubClient, source, target string, depth int,
+) (*types.LineageResult, bool, error) {
+	var capped bool
+	for _, direction := range []string{client.LineageDirectionDownstream, client.LineageDirectionUpstream} {
+		lineage, err := datahubClient.GetLineage(ctx, source,
+			client.WithDirection(direction),
+			client.WithDepth(depth),
+		)
+		if err != nil {
+			return nil, false, err
+		}
+		capped = capped || lineage.Truncated
+		if lineageContains(lineage, target) {
+			return lineage, capped, nil
+		}
+	}
+	return nil, capped, nil
+}
+
+// lineageContains reports whether urn is one of the lineage result's nodes.
+func lineageContains(lineage *types.LineageResult, urn string) bool {
+	for _, node := range lineage.Nodes {
+		if node.URN == urn {
+			return true
+		}
+	}
+	return false
+}
+
+// findLineagePaths returns up to maxPaths simple paths from one entity to another
+// over the lineage edges, shortest first, and whether more paths exist. Data jobs
+// between two entities become the Jobs of a single hop rather than hops of their own.
+//
+// Paths are extended breadth first, one hop per round, up to the lineage depth, and
+// only through entities that can still reach the target within the remaining hops.
+// Every path of a length is found before any longer one, so a round is ranked only
+// once it is complete; if the search budget runs out partway through a round, that
+// round's paths are dropped and more is reported.
+func findLineagePaths(lineage *types.LineageResult, from, to string, maxPaths int) ([]LineagePath, bool) {
+	hops, next := lineageHops(lineage, from, to)
+	maxHops := lineage.Depth
+	if maxHops <= 0 {
+		maxHops = len(lineage.Nodes) + 1
+	}
+	toTarget := hopsToTarget(next, to)
+
+	var found [][]string
+	frontier := [][]string{{from}}
+	budget := maxPathExpansions
+	more := false
+search:
+	for length := 1; length <= maxHops && len(frontier) > 0 && len(found) <= maxPaths; length++ {
+		var complete, extended [][]string
+		for _, path := range frontier {
+			for _, n := range next[path[len(path)-1]] {
+				dist, ok := toTarget[n]
+				if !ok || length+dist > maxHops || slices.Contains(path, n) {
+					continue
+				}
+				if budget == 0 {
+					more = true
+					break search
+				}
+				budget--
+				grown := append(slices.Clip(path), n)
+				if n == to {
+					complete = append(complete, grown)
+				} else {
+					extended = append(extended, grown)
+				}
+			}
+		}
+		sort.Slice(complete, func(a, b int) bool {
+			return strings.Join(complete[a], " ") < strings.Join(complete[b], " ")
+		})
+		found = append(found, complete...)
+		frontier = extended
+	}
+	if len(found) > maxPaths {
+		found, more = found[:maxPaths], true
+	}
+
+	paths := make([]LineagePath, 0, len(found))
+	for _, nodes := range found {
+		path := LineagePath{Length: len(nodes) - 1, Nodes: nodes, Hops: make([]LineageHop, 0, len(nodes)-1)}
+		for i := 1; i < len(nodes); i++ {
+			path.Hops = append(path.Hops, *hops[nodes[i-1]+" "+nodes[i]])
+		}
+		paths = append(paths, path)
+	}
+	return paths, more
+}
+
+// hopsToTarget returns the fewest hops from each entity that can reach to, by a
+// breadth-first search backwards over next.
+func hopsToTarget(next map[string][]string, to string) map[string]int {
+	prev := make(map[string][]string)
+	for src, dsts := range next {
+		for _, dst := range dsts {
+			prev[dst] = append(prev[dst], src)
+		}
+	}
+	dist := map[string]int{to: 0}
+	queue := []string{to}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		for _, p := range prev[n] {
+			if _, seen := dist[p]; !seen {
+				dist[p] = dist[n] + 1
+				queue = append(queue, p)
+			}
+		}
+	}
+	return dist
+}
+
+// lineageHops turns lineage edges into hops keyed by "from to", plus the sorted
+// successors of each entity. A data job in the middle of the graph is folded into
+// the hops from each of its inputs to each of its outputs; jobs that are the path's
+// own endpoints stay entities.
+func lineageHops(lineage *types.LineageResult, from, to string) (map[string]*LineageHop, map[string][]string) {
+	isJob := make(map[string]bool)
+	for _, node := range lineage.Nodes {
+		if node.Type == "DATA_JOB" && node.URN != from && node.URN != to {
+			isJob[node.URN] = true
+		}
+	}
+
+	jobInputs := make(map[string][]string)
+	for _, edge := range lineage.Edges {
+		if isJob[edge.Target] {
+			jobInputs[edge.Target] = append(jobInputs[edge.Target], edge.Source)
+		}
+	}
+
+	hops := make(map[string]*LineageHop)
+	next := make(map[string][]string)
+	addHop := func(src, dst, job string) {
+		key := src + " " + dst
+		hop, ok := hops[key]
+		if !ok {
+			hop = &LineageHop{From: src, To: dst}
+			hops[key] = hop
+			next[src] = append(next[src], dst)
+		}
+		if job != "" && !slices.Contains(hop.Jobs, job) {
+			hop.Jobs = append(hop.Jobs, job)
+			sort.Strings(hop.Jobs)
+		}
+	}
+
+	for _, edge := range lineage.Edges {
+		switch {
+		case isJob[edge.Target]:
+			// Folded into the job's outgoing edges below.
+		case isJob[edge.Source]:
+			for _, input := range jobInputs[edge.Source] {
+				addHop(input, edge.Target, edge.Source)
+			}
+		default:
+			addHop(edge.Source, edge.Target, edge.Via)
+		}
+	}
+
+	for src := range next {
+		sort.Strings(next[src])
+	}
+	return hops, next
+}
+
+// attachHopQueries adds the queries recorded in fine-grained lineage to each hop
+// into a dataset. The lookups are best effort: a failure leaves Queries empty.
+func (t *Toolkit) attachHopQueries(ctx context.Context, datahubClient DataHubClient, paths []LineagePath) {
+	lineageByURN := make(map[string]*types.ColumnLineage)
+	for p := range paths {
+		for h := range paths[p].Hops {
+			hop := &paths[p].Hops[h]
+			if !strings.HasPrefix(hop.To, "urn:li:dataset:") {
+				continue
+			}
+			columnLineage, ok := lineageByURN[hop.To]
+			if !ok {
+				var err error
+				columnLineage, err = datahubClient.GetColumnLineage(ctx, hop.To)
+				if err != nil {
+					t.log().Warn("lineage path query lookup failed", "urn", hop.To, "error", err.Error())
+				}
+				lineageByURN[hop.To] = columnLineage
+			}
+			if columnLineage == nil {
+				continue
+			}
+			for _, m := range columnLineage.Mappings {
+				if m.UpstreamDataset == hop.From && m.Query != "" && !slices.Contains(hop.Queries, m.Query) {
+					hop.Queries = append(hop.Queries, m.Query)
+				}
+			}
+			sort.Strings(hop.Queries)
+		}
+	}
+}
+
+// pathEntities returns the lineage nodes that appear on any of the paths.
+func pathEntities(lineage *types.LineageResult, paths []LineagePath) []types.LineageNode {
+	onPath := make(map[string]bool)
+	for _, path := range paths {
+		for _, urn := range path.Nodes {
+			onPath[urn] = true
+		}
+		for _, hop := range path.Hops {
+			for _, job := range hop.Jobs {
+				onPath[job] = true
+			}
+		}
+	}
+
+	entities := []types.LineageNode{}
+	for _, node := range lineage.Nodes {
+		if onPath[node.URN] {
+			entities = append(entities, node)
+		}
+	}
+	return entities
+}
@@ -0,0 +1,282 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+// pathDownstream is the downstream lineage of orders: orders -> load job -> clean ->
+// dashboard, plus an orders -> staging -> clean branch.
+func pathDownstream() *types.LineageResult {
+	return &types.LineageResult{
+		Start:     "urn:li:dataset:orders",
+		Direction: client.LineageDirectionDownstream,
+		Depth:     3,
+		Nodes: []types.LineageNode{
+			{URN: "urn:li:dataJob:load", Type: "DATA_JOB", Level: 1},
+			{URN: "urn:li:dataset:clean", Type: "DATASET", Name: "clean", Level: 1},
+			{URN: "urn:li:dataset:staging", Type: "DATASET", Level: 1},
+			{URN: "urn:li:dashboard:sales", Type: "DASHBOARD", Name: "Sales", Level: 2},
+		},
+		Edges: []types.LineageEdge{
+			{Source: "urn:li:dataset:orders", Target: "urn:li:dataJob:load"},
+			{Source: "urn:li:dataJob:load", Target: "urn:li:dataset:clean"},
+			{Source: "urn:li:dataset:orders", Target: "urn:li:dataset:clean", Via: "urn:li:dataJob:load"},
+			{Source: "urn:li:dataset:orders", Target: "urn:li:dataset:staging"},
+			{Source: "urn:li:dataset:clean", Target: "urn:li:dashboard:sales"},
+			{Source: "urn:li:dataset:staging", Target: "urn:li:dataset:clean"},
+		},
+	}
+}
+
+// pathUpstream is the upstream lineage of orders: raw -> orders.
+func pathUpstream() *types.LineageResult {
+	return &types.LineageResult{
+		Start:     "urn:li:dataset:orders",
+		Direction: client.LineageDirectionUpstream,
+		Depth:     3,
+		Nodes:     []types.LineageNode{{URN: "urn:li:dataset:raw", Type: "DATASET", Level: 1}},
+		Edges:     []types.LineageEdge{{Source: "urn:li:dataset:raw", Target: "urn:li:dataset:orders"}},
+	}
+}
+
+// pathMock answers lineage calls with results in order, the downstream search
+// coming first, and serves the queries that build clean.
+func pathMock(results ...*types.LineageResult) *mockClient {
+	calls := 0
+	return &mockClient{
+		getLineageFunc: func(_ context.Context, _ string, _ ...client.LineageOption) (*types.LineageResult, error) {
+			result := results[calls]
+			calls++
+			return result, nil
+		},
+		getColumnLineageFunc: func(_ context.Context, urn string) (*types.ColumnLineage, error) {
+			if urn != "urn:li:dataset:clean" {
+				return nil, errors.New("no column lineage")
+			}
+			return &types.ColumnLineage{DatasetURN: urn, Mappings: []types.ColumnLineageMapping{
+				{UpstreamDataset: "urn:li:dataset:orders", Query: "urn:li:query:clean"},
+				{UpstreamDataset: "urn:li:dataset:staging", Query: "urn:li:query:merge"},
+			}}, nil
+		},
+	}
+}
+
+func TestHandleFindLineagePath(t *testing.T) {
+	toolkit := NewToolkit(pathMock(pathDownstream()), DefaultConfig())
+	result, _, _ := toolkit.handleFindLineagePath(context.Background(), nil, FindLineagePathInput{
+		Source:   "urn:li:dataset:orders",
+		Target:   "urn:li:dashboard:sales",
+		MaxPaths: 5,
+	})
+	if result.IsError {
+		t.Fatalf("handleFindLineagePath() error: %s", resultText(result))
+	}
+
+	var out FindLineagePathOutput
+	if err := json.Unmarshal([]byte(resultText(result)), &out); err != nil {
+		t.Fatalf("failed to parse result: %v", err)
+	}
+	if !out.Found || out.Direction != client.LineageDirectionDownstream || out.Truncated {
+		t.Errorf("found/direction/truncated = %v/%s/%v", out.Found, out.Direction, out.Truncated)
+	}
+	if len(out.Paths) != 2 {
+		t.Fatalf("Paths = %+v, want 2", out.Paths)
+	}
+
+	shortest := out.Paths[0]
+	if shortest.Length != 2 || strings.Join(shortest.Nodes, " ") != "urn:li:dataset:orders urn:li:dataset:clean urn:li:dashboard:sales" {
+		t.Errorf("shortest path = %+v", shortest)
+	}
+	first := shortest.Hops[0]
+	if len(first.Jobs) != 1 || first.Jobs[0] != "urn:li:dataJob:load" {
+		t.Errorf("first hop jobs = %v, want the load job folded in once", first.Jobs)
+	}
+	if len(first.Queries) != 1 || first.Queries[0] != "urn:li:query:clean" {
+		t.Errorf("first hop queries = %v, want urn:li:query:clean", first.Queries)
+	}
+	if len(shortest.Hops[1].Queries) != 0 {
+		t.Errorf("dashboard hop queries = %v, want none", shortest.Hops[1].Queries)
+	}
+
+	if out.Paths[1].Length != 3 || out.Paths[1].Hops[1].Queries[0] != "urn:li:query:merge" {
+		t.Errorf("second path = %+v, want the three-hop staging branch", out.Paths[1])
+	}
+	if len(out.Entities) != 4 {
+		t.Errorf("Entities = %+v, want clean, staging, dashboard and the job", out.Entities)
+	}
+}
+
+func TestHandleFindLineagePathShortestOnly(t *testing.T) {
+	toolkit := NewToolkit(pathMock(pathDownstream()), DefaultConfig())
+	result, _, _ := toolkit.handleFindLineagePath(context.Background(), nil, FindLineagePathInput{
+		Source: "urn:li:dataset:orders",
+		Target: "urn:li:dashboard:sales",
+	})
+
+	var out FindLineagePathOutput
+	if err := json.Unmarshal([]byte(resultText(result)), &out); err != nil {
+		t.Fatalf("failed to parse result: %v", err)
+	}
+	if len(out.Paths) != 1 || out.Paths[0].Length != 2 || !out.Truncated {
+		t.Errorf("paths/truncated = %d/%v, want the shortest path and truncated", len(out.Paths), out.Truncated)
+	}
+}
+
+func TestHandleFindLineagePathUpstream(t *testing.T) {
+	toolkit := NewToolkit(pathMock(pathDownstream(), pathUpstream()), DefaultConfig())
+	result, _, _ := toolkit.handleFindLineagePath(context.Background(), nil, FindLineagePathInput{
+		Source: "urn:li:dataset:orders",
+		Target: "urn:li:dataset:raw",
+	})
+
+	var out FindLineagePathOutput
+	if err := json.Unmarshal([]byte(resultText(result)), &out); err != nil {
+		t.Fatalf("failed to parse result: %v", err)
+	}
+	if !out.Found || out.Direction != client.LineageDirectionUpstream || !strings.Contains(out.Message, "other way") {
+		t.Errorf("found/direction/message = %v/%s/%q", out.Found, out.Direction, out.Message)
+	}
+	if len(out.Paths) != 1 || strings.Join(out.Paths[0].Nodes, " ") != "urn:li:dataset:raw urn:li:dataset:orders" {
+		t.Errorf("Paths = %+v, want raw -> orders in data-flow order", out.Paths)
+	}
+}
+
+func TestHandleFindLineagePathNotFound(t *testing.T) {
+	upstream := pathUpstream()
+	upstream.Truncated = true
+	toolkit := NewToolkit(pathMock(pathDownstream(), upstream), DefaultConfig())
+	result, _, _ := toolkit.handleFindLineagePath(context.Background(), nil, FindLineagePathInput{
+		Source: "urn:li:dataset:orders",
+		Target: "urn:li:dataset:unrelated",
+		Depth:  2,
+	})
+	if result.IsError {
+		t.Fatalf("handleFindLineagePath() error: %s", resultText(result))
+	}
+
+	var out FindLineagePathOutput
+	if err := json.Unmarshal([]byte(resultText(result)), &out); err != nil {
+		t.Fatalf("failed to parse result: %v", err)
+	}
+	if out.Found || out.Paths == nil || len(out.Paths) != 0 {
+		t.Errorf("found/paths = %v/%v, want not found with empty paths", out.Found, out.Paths)
+	}
+	if !strings.Contains(out.Message, "within 2 hops") || !strings.Contains(out.Message, "truncated") {
+		t.Errorf("Message = %q", out.Message)
+	}
+}
+
+func TestHandleFindLineagePathErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      FindLineagePathInput
+		mockErr    error
+		wantErrMsg string
+	}{
+		{name: "empty source", input: FindLineagePathInput{Target: "b"}, wantErrMsg: "source parameter is required"},
+		{name: "empty target", input: FindLineagePathInput{Source: "a"}, wantErrMsg: "target parameter is required"},
+		{name: "same entity", input: FindLineagePathInput{Source: "a", Target: "a"}, wantErrMsg: "must be different"},
+		{name: "lineage error", input: FindLineagePathInput{Source: "a", Target: "b"}, mockErr: errors.New("boom"), wantErrMsg: "boom"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockClient{
+				getLineageFunc: func(_ context.Context, _ string, _ ...client.LineageOption) (*types.LineageResult, error) {
+					return nil, tt.mockErr
+				},
+			}
+			toolkit := NewToolkit(mock, DefaultConfig())
+			result, _, _ := toolkit.handleFindLineagePath(context.Background(), nil, tt.input)
+			if !result.IsError || !strings.Contains(resultText(result), tt.wantErrMsg) {
+				t.Errorf("handleFindLineagePath() = %s, want error %q", resultText(result), tt.wantErrMsg)
+			}
+		})
+	}
+}
+
+func TestFindLineagePathsMissingEdges(t *testing.T) {
+	lineage := &types.LineageResult{
+		Nodes: []types.LineageNode{{URN: "b", Type: "DATASET", Level: 2}},
+	}
+	paths, more := findLineagePaths(lineage, "a", "b", 1)
+	if len(paths) != 0 || more {
+		t.Errorf("findLineagePaths() = %+v/%v, want no paths", paths, more)
+	}
+}
+
+// denseLineage links a source through layers of width entities, each connected to
+// every entity of the next layer, to a target behind the last layer.
+func denseLineage(layers, width int) *types.LineageResult {
+	lineage := &types.LineageResult{Start: "src", Depth: layers + 1}
+	prev := []string{"src"}
+	for l := 0; l < layers; l++ {
+		var layer []string
+		for w := 0; w < width; w++ {
+			urn := fmt.Sprintf("urn:li:dataset:l%dw%d", l, w)
+			layer = append(layer, urn)
+			lineage.Nodes = append(lineage.Nodes, types.LineageNode{URN: urn, Type: "DATASET", Level: l + 1})
+			for _, p := range prev {
+				lineage.Edges = append(lineage.Edges, types.LineageEdge{Source: p, Target: urn})
+			}
+		}
+		prev = layer
+	}
+	lineage.Nodes = append(lineage.Nodes, types.LineageNode{URN: "dst", Type: "DATASET", Level: layers + 1})
+	for _, p := range prev {
+		lineage.Edges = append(lineage.Edges, types.LineageEdge{Source: p, Target: "dst"})
+	}
+	return lineage
+}
+
+func TestFindLineagePathsShortestFirst(t *testing.T) {
+	lineage := denseLineage(2, 2)
+	lineage.Edges = append(lineage.Edges, types.LineageEdge{Source: "urn:li:dataset:l0w1", Target: "dst"})
+
+	paths, more := findLineagePaths(lineage, "src", "dst", 3)
+	if !more || len(paths) != 3 {
+		t.Fatalf("findLineagePaths() = %d paths, more %v; want 3 and more", len(paths), more)
+	}
+	if paths[0].Length != 2 || strings.Join(paths[0].Nodes, " ") != "src urn:li:dataset:l0w1 dst" {
+		t.Errorf("findLineagePaths() first path = %v, want the two-hop shortcut", paths[0].Nodes)
+	}
+	if paths[1].Length != 3 || strings.Join(paths[1].Nodes, " ") != "src urn:li:dataset:l0w0 urn:li:dataset:l1w0 dst" {
+		t.Errorf("findLineagePaths() second path = %v, want the first three-hop path by name", paths[1].Nodes)
+	}
+}
+
+func TestFindLineagePathsDepth(t *testing.T) {
+	lineage := denseLineage(3, 2)
+	lineage.Depth = 3
+
+	paths, more := findLineagePaths(lineage, "src", "dst", 5)
+	if len(paths) != 0 || more {
+		t.Errorf("findLineagePaths() = %d paths, more %v; want none within 3 hops", len(paths), more)
+	}
+}
+
+func TestFindLineagePathsBudget(t *testing.T) {
+	// 10^4 paths of 5 hops; extending every partial path exceeds the budget.
+	lineage := denseLineage(4, 10)
+
+	paths, more := findLineagePaths(lineage, "src", "dst", 1)
+	if len(paths) != 0 || !more {
+		t.Errorf("findLineagePaths() = %d paths, more %v; want the incomplete round dropped", len(paths), more)
+	}
+
+	// Entities that cannot reach the target are never extended.
+	lineage = denseLineage(4, 10)
+	lineage.Edges = lineage.Edges[:len(lineage.Edges)-10]
+	lineage.Edges = append(lineage.Edges, types.LineageEdge{Source: "src", Target: "dst"})
+	paths, more = findLineagePaths(lineage, "src", "dst", 1)
+	if len(paths) != 1 || paths[0].Length != 1 || more {
+		t.Errorf("findLineagePaths() = %+v, more %v; want only the direct edge", paths, more)
+	}
+}
@@ -2,8 +2,11 @@ package tools
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/txn2/mcp-datahub/pkg/client"
 	"github.com/txn2/mcp-datahub/pkg/types"
@@ -46,6 +49,23 @@ func TestHandleGetLineage(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "successful get both directions",
+			input: GetLineageInput{
+				URN:       "urn:li:dataset:test",
+				Direction: "BOTH",
+			},
+			mockLineage: &types.LineageResult{
+				Start:     "urn:li:dataset:test",
+				Direction: "BOTH",
+				Depth:     1,
+				Nodes: []types.LineageNode{
+					{URN: "urn:li:dataset:upstream", Name: "upstream", Level: -1},
+					{URN: "urn:li:dataset:downstream", Name: "downstream", Level: 1},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "with depth",
 			input: GetLineageInput{
@@ -97,3 +117,133 @@ func TestHandleGetLineage(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleGetLineageFiltersAndTruncation(t *testing.T) {
+	var gotOpts int
+	mock := &mockClient{
+		getLineageFunc: func(_ context.Context, _ string, opts ...client.LineageOption) (*types.LineageResult, error) {
+			gotOpts = len(opts)
+			return &types.LineageResult{
+				Start:     "urn:li:dataset:hub",
+				Direction: "DOWNSTREAM",
+				Depth:     1,
+				Nodes:     []types.LineageNode{{URN: "urn:li:dataset:a", Level: 1}},
+				Total:     250,
+				Fetched:   3,
+				Truncated: true,
+			}, nil
+		},
+	}
+
+	toolkit := NewToolkit(mock, DefaultConfig())
+	result, _, _ := toolkit.handleGetLineage(context.Background(), nil, GetLineageInput{
+		URN:             "urn:li:dataset:hub",
+		EntityTypes:     []string{"DATASET"},
+		Platforms:       []string{"snowflake"},
+		StartTimeMillis: 1000,
+		Limit:           1,
+		Offset:          10,
+	})
+	if result.IsError {
+		t.Fatalf("handleGetLineage() error: %s", resultText(result))
+	}
+	if gotOpts != 5 {
+		t.Errorf("handleGetLineage() passed %d options, want 5", gotOpts)
+	}
+
+	var out GetLineageOutput
+	if err := json.Unmarshal([]byte(resultText(result)), &out); err != nil {
+		t.Fatalf("failed to parse result: %v", err)
+	}
+	if !out.Truncated || out.Total != 250 {
+		t.Errorf("handleGetLineage() truncated/total = %v/%d", out.Truncated, out.Total)
+	}
+	if !strings.Contains(out.Warning, "3 of 250") {
+		t.Errorf("handleGetLineage() warning = %q, want truncation count", out.Warning)
+	}
+}
+
+func TestHandleGetLineageAsOf(t *testing.T) {
+	var gotOpts int
+	mock := &mockClient{
+		getLineageFunc: func(_ context.Context, urn string, opts ...client.LineageOption) (*types.LineageResult, error) {
+			gotOpts = len(opts)
+			return &types.LineageResult{Start: urn, Direction: "UPSTREAM", Depth: 1, AsOf: 1700000000000}, nil
+		},
+	}
+	toolkit := NewToolkit(mock, DefaultConfig())
+
+	result, _, _ := toolkit.handleGetLineage(context.Background(), nil, GetLineageInput{
+		URN:        "urn:li:dataset:orders",
+		Direction:  "UPSTREAM",
+		AsOfMillis: 1700000000000,
+	})
+	if result.IsError {
+		t.Fatalf("handleGetLineage() error: %s", resultText(result))
+	}
+	if gotOpts != 2 {
+		t.Errorf("handleGetLineage() passed %d options, want direction and as-of", gotOpts)
+	}
+	if !strings.Contains(resultText(result), `"as_of": 1700000000000`) {
+		t.Errorf("handleGetLineage() result = %s, want as_of", resultText(result))
+	}
+
+	result, _, _ = toolkit.handleGetLineage(context.Background(), nil, GetLineageInput{
+		URN:             "urn:li:dataset:orders",
+		AsOfMillis:      1700000000000,
+		StartTimeMillis: 1000,
+	})
+	if !result.IsError || !strings.Contains(resultText(result), "as_of_millis cannot be combined") {
+		t.Errorf("handleGetLineage() with as-of and a window = %s", resultText(result))
+	}
+}
+
+func TestHandleGetLineageFormat(t *testing.T) {
+	mock := &mockClient{
+		getLineageFunc: func(_ context.Context, urn string, _ ...client.LineageOption) (*types.LineageResult, error) {
+			return &types.LineageResult{
+				Start:     urn,
+				Direction: "DOWNSTREAM",
+				Depth:     1,
+				Nodes:     []types.LineageNode{{URN: "urn:li:dashboard:sales", Type: "DASHBOARD", Name: "Sales", Level: 1}},
+				Edges:     []types.LineageEdge{{Source: urn, Target: "urn:li:dashboard:sales"}},
+				Total:     2,
+				Truncated: true,
+			}, nil
+		},
+	}
+	toolkit := NewToolkit(mock, DefaultConfig())
+
+	result, out, _ := toolkit.handleGetLineage(context.Background(), nil, GetLineageInput{URN: "urn:li:dataset:orders", Format: "Mermaid"})
+	if result.IsError {
+		t.Fatalf("handleGetLineage() error: %s", resultText(result))
+	}
+	text := resultText(result)
+	if !strings.Contains(text, "```mermaid\nflowchart LR\n") || !strings.Contains(text, `n1[/"Sales"/]`) {
+		t.Errorf("handleGetLineage() text = %s", text)
+	}
+	if !strings.HasPrefix(text, "Lineage truncated") {
+		t.Errorf("handleGetLineage() text should start with the truncation warning: %s", text)
+	}
+	rendered, ok := out.(RenderedLineageOutput)
+	if !ok || rendered.Format != "mermaid" || !strings.HasPrefix(rendered.Graph, "flowchart LR") || rendered.Warning == "" {
+		t.Errorf("handleGetLineage() output = %#v", out)
+	}
+
+	result, _, _ = toolkit.handleGetLineage(context.Background(), nil, GetLineageInput{URN: "urn:li:dataset:orders", Format: "png"})
+	if !result.IsError || !strings.Contains(resultText(result), `unknown format "png"`) {
+		t.Errorf("handleGetLineage() with bad format = %s", resultText(result))
+	}
+}
+
+func TestLineageTruncationWarning(t *testing.T) {
+	if w := lineageTruncationWarning(&types.LineageResult{Total: 3}); w != "" {
+		t.Errorf("lineageTruncationWarning() = %q, want empty when not truncated", w)
+	}
+	if millisToTime(0) != (time.Time{}) {
+		t.Error("millisToTime(0) should be the zero time")
+	}
+	if millisToTime(1500).UnixMilli() != 1500 {
+		t.Error("millisToTime() did not round-trip")
+	}
+}
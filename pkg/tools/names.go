@@ -5,18 +5,38 @@ type ToolName string
 
 // Tool name constants.
 const (
-	ToolSearch           ToolName = "datahub_search"
-	ToolGetEntity        ToolName = "datahub_get_entity"
-	ToolGetSchema        ToolName = "datahub_get_schema"
-	ToolGetLineage       ToolName = "datahub_get_lineage"
-	ToolGetColumnLineage ToolName = "datahub_get_column_lineage"
-	ToolGetQueries       ToolName = "datahub_get_queries"
-	ToolGetGlossaryTerm  ToolName = "datahub_get_glossary_term"
-	ToolListTags         ToolName = "datahub_list_tags"
-	ToolListDomains      ToolName = "datahub_list_domains"
-	ToolListDataProducts ToolName = "datahub_list_data_products"
-	ToolGetDataProduct   ToolName = "datahub_get_data_product"
-	ToolListConnections  ToolName = "datahub_list_connections"
+	ToolSearch            ToolName = "datahub_search"
+	ToolGetEntity         ToolName = "datahub_get_entity"
+	ToolGetSchema         ToolName = "datahub_get_schema"
+	ToolGetLineage        ToolName = "datahub_get_lineage"
+	ToolGetColumnLineage  ToolName = "datahub_get_column_lineage"
+	ToolGetQueries        ToolName = "datahub_get_queries"
+	ToolGetGlossaryTerm   ToolName = "datahub_get_glossary_term"
+	ToolListTags          ToolName = "datahub_list_tags"
+	ToolListDomains       ToolName = "datahub_list_domains"
+	ToolListDataProducts  ToolName = "datahub_list_data_products"
+	ToolGetDataProduct    ToolName = "datahub_get_data_product"
+	ToolGetDatasetProfile ToolName = "datahub_get_dataset_profile"
+	ToolGetUsageStats     ToolName = "datahub_get_usage_stats"
+	ToolGetAssertions     ToolName = "datahub_get_assertions"
+	ToolListIncidents     ToolName = "datahub_list_incidents"
+	ToolGetDataContract   ToolName = "datahub_get_data_contract"
+	ToolBrowse            ToolName = "datahub_browse"
+	ToolGetContainer      ToolName = "datahub_get_container"
+	ToolGetDashboard      ToolName = "datahub_get_dashboard"
+	ToolGetChart          ToolName = "datahub_get_chart"
+	ToolGetDataJob        ToolName = "datahub_get_data_job"
+	ToolGetDataFlow       ToolName = "datahub_get_data_flow"
+	ToolGetUser           ToolName = "datahub_get_user"
+	ToolGetGroup          ToolName = "datahub_get_group"
+	ToolListOwnedEntities ToolName = "datahub_list_owned_entities"
+	ToolListGlossary      ToolName = "datahub_list_glossary"
+	ToolImpactAnalysis    ToolName = "datahub_impact_analysis"
+	ToolTraceColumn       ToolName = "datahub_trace_column"
+	ToolFindLineagePath   ToolName = "datahub_find_lineage_path"
+	ToolCheckFreshness    ToolName = "datahub_check_freshness"
+	ToolFederatedSearch   ToolName = "datahub_federated_search"
+	ToolListConnections   ToolName = "datahub_list_connections"
 
 	// Write tool names.
 	ToolUpdateDescription  ToolName = "datahub_update_description"
@@ -26,6 +46,10 @@ const (
 	ToolRemoveGlossaryTerm ToolName = "datahub_remove_glossary_term"
 	ToolAddLink            ToolName = "datahub_add_link"
 	ToolRemoveLink         ToolName = "datahub_remove_link"
+	ToolRaiseIncident      ToolName = "datahub_raise_incident"
+	ToolResolveIncident    ToolName = "datahub_resolve_incident"
+	ToolAddLineage         ToolName = "datahub_add_lineage"
+	ToolRemoveLineage      ToolName = "datahub_remove_lineage"
 )
 
 // AllTools returns all available read-only tool names.
@@ -43,6 +67,26 @@ func AllTools() []ToolName {
 		ToolListDomains,
 		ToolListDataProducts,
 		ToolGetDataProduct,
+		ToolGetDatasetProfile,
+		ToolGetUsageStats,
+		ToolGetAssertions,
+		ToolListIncidents,
+		ToolGetDataContract,
+		ToolBrowse,
+		ToolGetContainer,
+		ToolGetDashboard,
+		ToolGetChart,
+		ToolGetDataJob,
+		ToolGetDataFlow,
+		ToolGetUser,
+		ToolGetGroup,
+		ToolListOwnedEntities,
+		ToolListGlossary,
+		ToolImpactAnalysis,
+		ToolTraceColumn,
+		ToolFindLineagePath,
+		ToolCheckFreshness,
+		ToolFederatedSearch,
 		ToolListConnections,
 	}
 }
@@ -57,5 +101,9 @@ func WriteTools() []ToolName {
 		ToolRemoveGlossaryTerm,
 		ToolAddLink,
 		ToolRemoveLink,
+		ToolRaiseIncident,
+		ToolResolveIncident,
+		ToolAddLineage,
+		ToolRemoveLineage,
 	}
 }
@@ -21,6 +21,26 @@ func TestToolNameConstants(t *testing.T) {
 		{ToolListDomains, "datahub_list_domains"},
 		{ToolListDataProducts, "datahub_list_data_products"},
 		{ToolGetDataProduct, "datahub_get_data_product"},
+		{ToolGetDatasetProfile, "datahub_get_dataset_profile"},
+		{ToolGetUsageStats, "datahub_get_usage_stats"},
+		{ToolGetAssertions, "datahub_get_assertions"},
+		{ToolListIncidents, "datahub_list_incidents"},
+		{ToolGetDataContract, "datahub_get_data_contract"},
+		{ToolBrowse, "datahub_browse"},
+		{ToolGetContainer, "datahub_get_container"},
+		{ToolGetDashboard, "datahub_get_dashboard"},
+		{ToolGetChart, "datahub_get_chart"},
+		{ToolGetDataJob, "datahub_get_data_job"},
+		{ToolGetDataFlow, "datahub_get_data_flow"},
+		{ToolGetUser, "datahub_get_user"},
+		{ToolGetGroup, "datahub_get_group"},
+		{ToolListOwnedEntities, "datahub_list_owned_entities"},
+		{ToolListGlossary, "datahub_list_glossary"},
+		{ToolImpactAnalysis, "datahub_impact_analysis"},
+		{ToolTraceColumn, "datahub_trace_column"},
+		{ToolFindLineagePath, "datahub_find_lineage_path"},
+		{ToolCheckFreshness, "datahub_check_freshness"},
+		{ToolFederatedSearch, "datahub_federated_search"},
 		{ToolListConnections, "datahub_list_connections"},
 	}
 
@@ -36,26 +56,46 @@ func TestToolNameConstants(t *testing.T) {
 func TestAllTools(t *testing.T) {
 	tools := AllTools()
 
-	// Should return all 12 tools
-	expectedCount := 12
+	// Should return all 32 tools
+	expectedCount := 32
 	if len(tools) != expectedCount {
 		t.Errorf("AllTools() count = %d, want %d", len(tools), expectedCount)
 	}
 
 	// Should contain all expected tools
 	expectedTools := map[ToolName]bool{
-		ToolSearch:           true,
-		ToolGetEntity:        true,
-		ToolGetSchema:        true,
-		ToolGetLineage:       true,
-		ToolGetColumnLineage: true,
-		ToolGetQueries:       true,
-		ToolGetGlossaryTerm:  true,
-		ToolListTags:         true,
-		ToolListDomains:      true,
-		ToolListDataProducts: true,
-		ToolGetDataProduct:   true,
-		ToolListConnections:  true,
+		ToolSearch:            true,
+		ToolGetEntity:         true,
+		ToolGetSchema:         true,
+		ToolGetLineage:        true,
+		ToolGetColumnLineage:  true,
+		ToolGetQueries:        true,
+		ToolGetGlossaryTerm:   true,
+		ToolListTags:          true,
+		ToolListDomains:       true,
+		ToolListDataProducts:  true,
+		ToolGetDataProduct:    true,
+		ToolGetDatasetProfile: true,
+		ToolGetUsageStats:     true,
+		ToolGetAssertions:     true,
+		ToolListIncidents:     true,
+		ToolGetDataContract:   true,
+		ToolBrowse:            true,
+		ToolGetContainer:      true,
+		ToolGetDashboard:      true,
+		ToolGetChart:          true,
+		ToolGetDataJob:        true,
+		ToolGetDataFlow:       true,
+		ToolGetUser:           true,
+		ToolGetGroup:          true,
+		ToolListOwnedEntities: true,
+		ToolListGlossary:      true,
+		ToolImpactAnalysis:    true,
+		ToolTraceColumn:       true,
+		ToolFindLineagePath:   true,
+		ToolCheckFreshness:    true,
+		ToolFederatedSearch:   true,
+		ToolListConnections:   true,
 	}
 
 	for _, tool := range tools {
@@ -6,18 +6,38 @@ import "encoding/json"
 // These declare the structure of the JSON objects returned by each tool to MCP clients.
 // Schemas are top-level objects; not exhaustive — they describe the primary response shape.
 var defaultOutputSchemas = map[ToolName]json.RawMessage{
-	ToolSearch:           schemaSearch,
-	ToolGetEntity:        schemaGetEntity,
-	ToolGetSchema:        schemaGetSchema,
-	ToolGetLineage:       schemaGetLineage,
-	ToolGetColumnLineage: schemaGetColumnLineage,
-	ToolGetQueries:       schemaGetQueries,
-	ToolGetGlossaryTerm:  schemaGetGlossaryTerm,
-	ToolListTags:         schemaListTags,
-	ToolListDomains:      schemaListDomains,
-	ToolListDataProducts: schemaListDataProducts,
-	ToolGetDataProduct:   schemaGetDataProduct,
-	ToolListConnections:  schemaListConnections,
+	ToolSearch:            schemaSearch,
+	ToolGetEntity:         schemaGetEntity,
+	ToolGetSchema:         schemaGetSchema,
+	ToolGetLineage:        schemaGetLineage,
+	ToolGetColumnLineage:  schemaGetColumnLineage,
+	ToolGetQueries:        schemaGetQueries,
+	ToolGetGlossaryTerm:   schemaGetGlossaryTerm,
+	ToolListTags:          schemaListTags,
+	ToolListDomains:       schemaListDomains,
+	ToolListDataProducts:  schemaListDataProducts,
+	ToolGetDataProduct:    schemaGetDataProduct,
+	ToolGetDatasetProfile: schemaGetDatasetProfile,
+	ToolGetUsageStats:     schemaGetUsageStats,
+	ToolGetAssertions:     schemaGetAssertions,
+	ToolListIncidents:     schemaListIncidents,
+	ToolGetDataContract:   schemaGetDataContract,
+	ToolBrowse:            schemaBrowse,
+	ToolGetContainer:      schemaGetContainer,
+	ToolGetDashboard:      schemaGetDashboard,
+	ToolGetChart:          schemaGetChart,
+	ToolGetDataJob:        schemaGetDataJob,
+	ToolGetDataFlow:       schemaGetDataFlow,
+	ToolGetUser:           schemaGetUser,
+	ToolGetGroup:          schemaGetGroup,
+	ToolListOwnedEntities: schemaListOwnedEntities,
+	ToolListGlossary:      schemaListGlossary,
+	ToolImpactAnalysis:    schemaImpactAnalysis,
+	ToolTraceColumn:       schemaTraceColumn,
+	ToolFindLineagePath:   schemaFindLineagePath,
+	ToolCheckFreshness:    schemaCheckFreshness,
+	ToolFederatedSearch:   schemaFederatedSearch,
+	ToolListConnections:   schemaListConnections,
 	// Write tools
 	ToolUpdateDescription:  schemaUpdateDescription,
 	ToolAddTag:             schemaAddTag,
@@ -26,6 +46,10 @@ var defaultOutputSchemas = map[ToolName]json.RawMessage{
 	ToolRemoveGlossaryTerm: schemaRemoveGlossaryTerm,
 	ToolAddLink:            schemaAddLink,
 	ToolRemoveLink:         schemaRemoveLink,
+	ToolRaiseIncident:      schemaRaiseIncident,
+	ToolResolveIncident:    schemaResolveIncident,
+	ToolAddLineage:         schemaAddLineage,
+	ToolRemoveLineage:      schemaRemoveLineage,
 }
 
 // DefaultOutputSchema returns the default output JSON Schema for a tool.
@@ -60,6 +84,8 @@ var schemaSearch = json.RawMessage(`{
   "type": "object",
   "properties": {
     "total":    {"type": "integer", "description": "Total number of matching entities"},
+    "offset":   {"type": "integer"},
+    "limit":    {"type": "integer"},
     "entities": {
       "type": "array",
       "items": {
@@ -69,10 +95,17 @@ var schemaSearch = json.RawMessage(`{
           "name":        {"type": "string"},
           "type":        {"type": "string"},
           "description": {"type": "string"},
-          "platform":    {"type": "string"}
+          "platform":    {"type": "string"},
+          "connections": {
+            "type": "array",
+            "items": {"type": "string"},
+            "description": "Federated search only: connections that returned this entity"
+          }
         }
       }
     },
+    "connections": {"type": "array", "items": {"type": "string"}, "description": "Federated search only: connections queried"},
+    "warnings":    {"type": "array", "items": {"type": "string"}, "description": "Federated search only: connections that failed"},
     "query_context": {
       "type": "object",
       "description": "Optional: query engine availability per entity URN",
@@ -164,8 +197,9 @@ var schemaGetLineage = json.RawMessage(`{
   "type": "object",
   "properties": {
     "start":     {"type": "string", "description": "URN of the queried entity"},
-    "direction": {"type": "string", "description": "Lineage direction: UPSTREAM or DOWNSTREAM"},
+    "direction": {"type": "string", "description": "Lineage direction: UPSTREAM, DOWNSTREAM or BOTH"},
     "depth":     {"type": "integer", "description": "Depth of lineage traversal"},
+    "as_of":     {"type": "integer", "description": "Point in time the lineage was queried at (epoch millis)"},
     "nodes": {
       "type": ["array", "null"],
       "items": {
@@ -175,7 +209,7 @@ var schemaGetLineage = json.RawMessage(`{
           "name":     {"type": "string"},
           "type":     {"type": "string"},
           "platform": {"type": "string"},
-          "level":    {"type": "integer"}
+          "level":    {"type": "integer", "description": "Hops from the start entity; negative for upstream nodes when direction is BOTH"}
         }
       }
     },
@@ -184,12 +218,24 @@ var schemaGetLineage = json.RawMessage(`{
       "items": {
         "type": "object",
         "properties": {
-          "source": {"type": "string"},
-          "target": {"type": "string"},
-          "type":   {"type": "string"}
+          "source":     {"type": "string"},
+          "target":     {"type": "string"},
+          "type":       {"type": "string"},
+          "created":    {"type": "integer", "description": "When the edge was created (epoch millis)"},
+          "updated":    {"type": "integer", "description": "When the edge was last updated (epoch millis)"},
+          "updated_by": {"type": "string", "description": "URN of the actor that last changed the edge"},
+          "via":        {"type": "string", "description": "URN of the data job that produces the edge"},
+          "origin":     {"type": "string", "description": "MANUAL or INGESTED"},
+          "change":     {"type": "string", "description": "ADDED or REMOVED within the queried time window"}
         }
       }
     },
+    "total":     {"type": "integer", "description": "Number of results DataHub matched"},
+    "fetched":   {"type": "integer", "description": "Number of results DataHub returned for this page"},
+    "truncated": {"type": "boolean", "description": "True when more results matched than were returned"},
+    "warning":   {"type": "string", "description": "Explains how to narrow or page a truncated result"},
+    "format":    {"type": "string", "description": "dot, mermaid or graphml when a graph format was requested"},
+    "graph":     {"type": "string", "description": "The rendered graph; only set with a graph format"},
     "execution_context": {
       "type": "object",
       "description": "Optional: query engine execution context for lineage bridging"
@@ -200,7 +246,9 @@ var schemaGetLineage = json.RawMessage(`{
 var schemaGetColumnLineage = json.RawMessage(`{
   "type": "object",
   "properties": {
-    "urn": {"type": "string"},
+    "urn":    {"type": "string"},
+    "format": {"type": "string", "description": "dot, mermaid or graphml when a graph format was requested"},
+    "graph":  {"type": "string", "description": "The rendered graph; only set with a graph format"},
     "columns": {
       "type": "array",
       "items": {
@@ -245,20 +293,46 @@ var schemaGetQueries = json.RawMessage(`{
 var schemaGetGlossaryTerm = json.RawMessage(`{
   "type": "object",
   "properties": {
-    "urn":        {"type": "string"},
-    "name":       {"type": "string"},
-    "definition": {"type": "string"},
-    "entities": {
+    "urn":         {"type": "string"},
+    "name":        {"type": "string"},
+    "description": {"type": "string", "description": "The term definition"},
+    "parent_node": {"type": "string", "description": "Direct parent glossary node URN"},
+    "parent_nodes": {
       "type": "array",
-      "description": "Datasets and columns linked to this term",
+      "description": "Glossary nodes above the term, root first",
       "items": {
         "type": "object",
         "properties": {
-          "urn":    {"type": "string"},
-          "column": {"type": "string"}
+          "urn":  {"type": "string"},
+          "name": {"type": "string"}
         }
       }
-    }
+    },
+    "related_terms": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "urn":           {"type": "string"},
+          "name":          {"type": "string"},
+          "relation_type": {"type": "string", "description": "IsA, HasA, HasValue or IsRelatedTo"},
+          "direction":     {"type": "string", "description": "OUTGOING when this term declares the relationship, INCOMING otherwise"}
+        }
+      }
+    },
+    "entity_count": {"type": "integer", "description": "Number of entities the term is applied to"},
+    "owners": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "urn":  {"type": "string"},
+          "name": {"type": "string"},
+          "type": {"type": "string"}
+        }
+      }
+    },
+    "properties": {"type": "object", "additionalProperties": {"type": "string"}}
   }
 }`)
 
@@ -352,88 +426,1123 @@ var schemaGetDataProduct = json.RawMessage(`{
   }
 }`)
 
-var schemaListConnections = json.RawMessage(`{
+var schemaGetDatasetProfile = json.RawMessage(`{
   "type": "object",
   "properties": {
-    "connections": {
+    "urn":           {"type": "string"},
+    "timestamp":     {"type": "integer", "description": "When the profile was taken (ms since epoch)"},
+    "row_count":     {"type": "integer"},
+    "column_count":  {"type": "integer"},
+    "size_in_bytes": {"type": "integer"},
+    "partition":     {"type": "string"},
+    "fields": {
+      "type": "array",
+      "description": "Per-column statistics",
+      "items": {
+        "type": "object",
+        "properties": {
+          "field_path":        {"type": "string"},
+          "unique_count":      {"type": "integer"},
+          "unique_proportion": {"type": "number"},
+          "null_count":        {"type": "integer"},
+          "null_proportion":   {"type": "number"},
+          "min":               {"type": "string"},
+          "max":               {"type": "string"},
+          "mean":              {"type": "string"},
+          "median":            {"type": "string"},
+          "stdev":             {"type": "string"},
+          "sample_values":     {"type": "array", "items": {"type": "string"}}
+        }
+      }
+    },
+    "history": {
       "type": "array",
+      "description": "Earlier profiles in the time range, newest first",
       "items": {
         "type": "object",
         "properties": {
-          "name":       {"type": "string"},
-          "url":        {"type": "string"},
-          "is_default": {"type": "boolean"}
+          "timestamp":     {"type": "integer"},
+          "row_count":     {"type": "integer"},
+          "column_count":  {"type": "integer"},
+          "size_in_bytes": {"type": "integer"}
         }
       }
     }
   }
 }`)
 
-var schemaUpdateDescription = json.RawMessage(`{
+var schemaGetUsageStats = json.RawMessage(`{
   "type": "object",
   "properties": {
-    "urn":         {"type": "string"},
-    "description": {"type": "string"},
-    "aspect":      {"type": "string"},
-    "action":      {"type": "string"}
+    "urn":           {"type": "string"},
+    "range":         {"type": "string", "description": "Usage window (DAY, WEEK, MONTH, QUARTER, YEAR, ALL)"},
+    "total_queries": {"type": "integer"},
+    "unique_users":  {"type": "integer"},
+    "top_users": {
+      "type": "array",
+      "description": "Heaviest users ordered by query count",
+      "items": {
+        "type": "object",
+        "properties": {
+          "user":     {"type": "string"},
+          "username": {"type": "string"},
+          "email":    {"type": "string"},
+          "count":    {"type": "integer"}
+        }
+      }
+    },
+    "fields": {
+      "type": "array",
+      "description": "Per-column usage counts ordered by count",
+      "items": {
+        "type": "object",
+        "properties": {
+          "field": {"type": "string"},
+          "count": {"type": "integer"}
+        }
+      }
+    },
+    "buckets": {
+      "type": "array",
+      "description": "Usage time series, oldest first",
+      "items": {
+        "type": "object",
+        "properties": {
+          "timestamp":     {"type": "integer"},
+          "duration":      {"type": "string"},
+          "total_queries": {"type": "integer"},
+          "unique_users":  {"type": "integer"}
+        }
+      }
+    }
   }
 }`)
 
-var schemaAddTag = json.RawMessage(`{
+var schemaGetAssertions = json.RawMessage(`{
   "type": "object",
   "properties": {
-    "urn":    {"type": "string"},
-    "tag":    {"type": "string"},
-    "aspect": {"type": "string"},
-    "action": {"type": "string"}
+    "urn":      {"type": "string"},
+    "total":    {"type": "integer"},
+    "passing":  {"type": "integer", "description": "Assertions whose latest run succeeded"},
+    "failing":  {"type": "integer", "description": "Assertions whose latest run failed"},
+    "erroring": {"type": "integer", "description": "Assertions whose latest run errored"},
+    "not_run":  {"type": "integer", "description": "Assertions with no completed run"},
+    "warning":  {"type": "string", "description": "Set when any assertion is currently failing or erroring"},
+    "assertions": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "urn":         {"type": "string"},
+          "type":        {"type": "string", "description": "DATASET, FRESHNESS, VOLUME, SQL, FIELD, SCHEMA, ..."},
+          "description": {"type": "string"},
+          "source":      {"type": "string"},
+          "definition":  {"type": "object"},
+          "latest_run": {
+            "type": "object",
+            "properties": {
+              "timestamp":    {"type": "integer"},
+              "result":       {"type": "string", "description": "SUCCESS, FAILURE or ERROR"},
+              "actual_value": {"type": "number"}
+            }
+          },
+          "runs":           {"type": "array", "description": "Recent runs, newest first", "items": {"type": "object"}},
+          "total_runs":     {"type": "integer"},
+          "failed_runs":    {"type": "integer"},
+          "succeeded_runs": {"type": "integer"}
+        }
+      }
+    }
   }
 }`)
 
-var schemaRemoveTag = json.RawMessage(`{
+var schemaListIncidents = json.RawMessage(`{
   "type": "object",
   "properties": {
-    "urn":    {"type": "string"},
-    "tag":    {"type": "string"},
-    "aspect": {"type": "string"},
-    "action": {"type": "string"}
+    "urn":   {"type": "string"},
+    "total": {"type": "integer"},
+    "incidents": {
+      "type": "array",
+      "description": "Incidents on the entity, newest first",
+      "items": {
+        "type": "object",
+        "properties": {
+          "urn":             {"type": "string"},
+          "entity_urn":      {"type": "string"},
+          "type":            {"type": "string"},
+          "custom_type":     {"type": "string"},
+          "title":           {"type": "string"},
+          "description":     {"type": "string"},
+          "priority":        {"type": "string", "description": "CRITICAL, HIGH, MEDIUM or LOW"},
+          "state":           {"type": "string", "description": "ACTIVE or RESOLVED"},
+          "stage":           {"type": "string"},
+          "message":         {"type": "string"},
+          "source":          {"type": "string"},
+          "assignees":       {"type": "array", "items": {"type": "string"}},
+          "created":         {"type": "integer"},
+          "created_by":      {"type": "string"},
+          "last_updated":    {"type": "integer"},
+          "last_updated_by": {"type": "string"}
+        }
+      }
+    }
   }
 }`)
 
-var schemaAddGlossaryTerm = json.RawMessage(`{
+var schemaGetDataContract = json.RawMessage(`{
   "type": "object",
   "properties": {
-    "urn":           {"type": "string"},
-    "glossary_term": {"type": "string"},
-    "aspect":        {"type": "string"},
-    "action":        {"type": "string"}
+    "urn":        {"type": "string"},
+    "entity_urn": {"type": "string"},
+    "state":      {"type": "string", "description": "Contract lifecycle state (ACTIVE, PENDING)"},
+    "result":     {"type": "string", "description": "PASSING, FAILING or UNKNOWN, from the latest run of each assertion"},
+    "freshness": {
+      "type": "array",
+      "description": "Freshness guarantees",
+      "items": {
+        "type": "object",
+        "properties": {
+          "urn":        {"type": "string"},
+          "type":       {"type": "string"},
+          "definition": {"type": "object"},
+          "latest_run": {
+            "type": "object",
+            "properties": {
+              "timestamp": {"type": "integer"},
+              "result":    {"type": "string", "description": "SUCCESS, FAILURE or ERROR"}
+            }
+          },
+          "runs": {"type": "array", "items": {"type": "object"}}
+        }
+      }
+    },
+    "schema": {
+      "type": "array",
+      "description": "Schema guarantees",
+      "items": {
+        "type": "object",
+        "properties": {
+          "urn":        {"type": "string"},
+          "type":       {"type": "string"},
+          "definition": {"type": "object"},
+          "latest_run": {
+            "type": "object",
+            "properties": {
+              "timestamp": {"type": "integer"},
+              "result":    {"type": "string", "description": "SUCCESS, FAILURE or ERROR"}
+            }
+          },
+          "runs": {"type": "array", "items": {"type": "object"}}
+        }
+      }
+    },
+    "data_quality": {
+      "type": "array",
+      "description": "Data quality guarantees",
+      "items": {
+        "type": "object",
+        "properties": {
+          "urn":        {"type": "string"},
+          "type":       {"type": "string"},
+          "definition": {"type": "object"},
+          "latest_run": {
+            "type": "object",
+            "properties": {
+              "timestamp": {"type": "integer"},
+              "result":    {"type": "string", "description": "SUCCESS, FAILURE or ERROR"}
+            }
+          },
+          "runs": {"type": "array", "items": {"type": "object"}}
+        }
+      }
+    }
   }
 }`)
 
-var schemaRemoveGlossaryTerm = json.RawMessage(`{
+var schemaBrowse = json.RawMessage(`{
   "type": "object",
   "properties": {
-    "urn":           {"type": "string"},
-    "glossary_term": {"type": "string"},
-    "aspect":        {"type": "string"},
-    "action":        {"type": "string"}
+    "urn":    {"type": "string", "description": "The container, platform or platform instance browsed"},
+    "total":  {"type": "integer"},
+    "offset": {"type": "integer"},
+    "limit":  {"type": "integer"},
+    "entries": {
+      "type": "array",
+      "description": "Direct children",
+      "items": {
+        "type": "object",
+        "properties": {
+          "urn":         {"type": "string"},
+          "type":        {"type": "string", "description": "CONTAINER, DATASET, DASHBOARD, ..."},
+          "name":        {"type": "string"},
+          "description": {"type": "string"},
+          "platform":    {"type": "string"},
+          "sub_types":   {"type": "array", "items": {"type": "string"}}
+        }
+      }
+    }
   }
 }`)
 
-var schemaAddLink = json.RawMessage(`{
+var schemaGetContainer = json.RawMessage(`{
   "type": "object",
   "properties": {
-    "urn":    {"type": "string"},
-    "url":    {"type": "string"},
-    "label":  {"type": "string"},
-    "action": {"type": "string"}
+    "urn": {"type": "string"},
+    "container": {
+      "type": "object",
+      "description": "The container itself, or the innermost container the entity lives in",
+      "properties": {
+        "urn":               {"type": "string"},
+        "name":              {"type": "string"},
+        "description":       {"type": "string"},
+        "platform":          {"type": "string"},
+        "platform_instance": {"type": "string"},
+        "sub_types":         {"type": "array", "items": {"type": "string"}},
+        "external_url":      {"type": "string"},
+        "children":          {"type": "integer"}
+      }
+    },
+    "path": {
+      "type": "array",
+      "description": "Containers the entity lives in, root first",
+      "items": {
+        "type": "object",
+        "properties": {
+          "name":      {"type": "string"},
+          "urn":       {"type": "string"},
+          "type":      {"type": "string"},
+          "sub_types": {"type": "array", "items": {"type": "string"}}
+        }
+      }
+    }
   }
 }`)
 
-var schemaRemoveLink = json.RawMessage(`{
+var schemaGetDashboard = json.RawMessage(`{
   "type": "object",
   "properties": {
-    "urn":    {"type": "string"},
-    "url":    {"type": "string"},
-    "action": {"type": "string"}
+    "urn":            {"type": "string"},
+    "type":           {"type": "string"},
+    "name":           {"type": "string"},
+    "description":    {"type": "string"},
+    "platform":       {"type": "string"},
+    "dashboard_url":  {"type": "string"},
+    "external_url":   {"type": "string"},
+    "last_refreshed": {"type": "integer", "description": "Last refresh time in epoch milliseconds"},
+    "last_modified":  {"type": "integer"},
+    "total_charts":   {"type": "integer", "description": "Number of charts in the dashboard; at most 100 are returned"},
+    "charts": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "urn":            {"type": "string"},
+          "type":           {"type": "string"},
+          "name":           {"type": "string"},
+          "description":    {"type": "string"},
+          "platform":       {"type": "string"},
+          "chart_url":      {"type": "string"},
+          "external_url":   {"type": "string"},
+          "chart_type":     {"type": "string"},
+          "last_refreshed": {"type": "integer", "description": "Last refresh time in epoch milliseconds"},
+          "last_modified":  {"type": "integer"},
+          "query": {
+            "type": "object",
+            "properties": {
+              "type":      {"type": "string", "description": "Query language (SQL, LOOKML)"},
+              "raw_query": {"type": "string"}
+            }
+          },
+          "input_datasets": {
+            "type": "array",
+            "description": "Datasets the chart reads",
+            "items": {
+              "type": "object",
+              "properties": {
+                "urn":      {"type": "string"},
+                "type":     {"type": "string"},
+                "name":     {"type": "string"},
+                "platform": {"type": "string"}
+              }
+            }
+          },
+          "input_fields": {
+            "type": "array",
+            "description": "Dataset fields the chart reads",
+            "items": {
+              "type": "object",
+              "properties": {
+                "dataset_urn": {"type": "string"},
+                "field_path":  {"type": "string"},
+                "type":        {"type": "string"},
+                "native_type": {"type": "string"}
+              }
+            }
+          }
+        }
+      }
+    },
+    "input_datasets": {
+      "type": "array",
+      "description": "Datasets the dashboard reads directly",
+      "items": {
+        "type": "object",
+        "properties": {
+          "urn":      {"type": "string"},
+          "type":     {"type": "string"},
+          "name":     {"type": "string"},
+          "platform": {"type": "string"}
+        }
+      }
+    },
+    "input_fields": {
+      "type": "array",
+      "description": "Dataset fields the dashboard reads directly",
+      "items": {
+        "type": "object",
+        "properties": {
+          "dataset_urn": {"type": "string"},
+          "field_path":  {"type": "string"},
+          "type":        {"type": "string"},
+          "native_type": {"type": "string"}
+        }
+      }
+    }
+  }
+}`)
+
+var schemaGetChart = json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    "urn":            {"type": "string"},
+    "type":           {"type": "string"},
+    "name":           {"type": "string"},
+    "description":    {"type": "string"},
+    "platform":       {"type": "string"},
+    "chart_url":      {"type": "string"},
+    "external_url":   {"type": "string"},
+    "chart_type":     {"type": "string"},
+    "last_refreshed": {"type": "integer", "description": "Last refresh time in epoch milliseconds"},
+    "last_modified":  {"type": "integer"},
+    "query": {
+      "type": "object",
+      "properties": {
+        "type":      {"type": "string", "description": "Query language (SQL, LOOKML)"},
+        "raw_query": {"type": "string"}
+      }
+    },
+    "input_datasets": {
+      "type": "array",
+      "description": "Datasets the chart reads",
+      "items": {
+        "type": "object",
+        "properties": {
+          "urn":      {"type": "string"},
+          "type":     {"type": "string"},
+          "name":     {"type": "string"},
+          "platform": {"type": "string"}
+        }
+      }
+    },
+    "input_fields": {
+      "type": "array",
+      "description": "Dataset fields the chart reads",
+      "items": {
+        "type": "object",
+        "properties": {
+          "dataset_urn": {"type": "string"},
+          "field_path":  {"type": "string"},
+          "type":        {"type": "string"},
+          "native_type": {"type": "string"}
+        }
+      }
+    },
+    "dashboards": {
+      "type": "array",
+      "description": "Dashboards containing the chart",
+      "items": {
+        "type": "object",
+        "properties": {
+          "urn":      {"type": "string"},
+          "type":     {"type": "string"},
+          "name":     {"type": "string"},
+          "platform": {"type": "string"}
+        }
+      }
+    }
+  }
+}`)
+
+var schemaGetDataJob = json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    "urn":          {"type": "string"},
+    "type":         {"type": "string"},
+    "name":         {"type": "string"},
+    "description":  {"type": "string"},
+    "data_flow":    {"type": "string", "description": "Parent data flow URN"},
+    "orchestrator": {"type": "string"},
+    "external_url": {"type": "string"},
+    "owners": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "urn":   {"type": "string"},
+          "name":  {"type": "string"},
+          "email": {"type": "string"},
+          "type":  {"type": "string"}
+        }
+      }
+    },
+    "inputs": {
+      "type": "array",
+      "description": "Datasets the job reads",
+      "items": {
+        "type": "object",
+        "properties": {
+          "urn":      {"type": "string"},
+          "type":     {"type": "string"},
+          "name":     {"type": "string"},
+          "platform": {"type": "string"}
+        }
+      }
+    },
+    "outputs": {
+      "type": "array",
+      "description": "Datasets the job writes",
+      "items": {
+        "type": "object",
+        "properties": {
+          "urn":      {"type": "string"},
+          "type":     {"type": "string"},
+          "name":     {"type": "string"},
+          "platform": {"type": "string"}
+        }
+      }
+    },
+    "total_runs":   {"type": "integer"},
+    "runs": {
+      "type": "array",
+      "description": "Most recent runs, newest first",
+      "items": {
+        "type": "object",
+        "properties": {
+          "urn":             {"type": "string"},
+          "name":            {"type": "string"},
+          "status":          {"type": "string", "description": "STARTED or COMPLETE"},
+          "result":          {"type": "string", "description": "SUCCESS, FAILURE, SKIPPED or UP_FOR_RETRY"},
+          "native_result":   {"type": "string"},
+          "attempt":         {"type": "integer"},
+          "start_time":      {"type": "integer", "description": "Run start in epoch milliseconds"},
+          "duration_millis": {"type": "integer"},
+          "external_url":    {"type": "string"}
+        }
+      }
+    },
+    "warning": {"type": "string", "description": "Set when the latest run failed"}
+  }
+}`)
+
+var schemaGetDataFlow = json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    "urn":          {"type": "string"},
+    "type":         {"type": "string"},
+    "name":         {"type": "string"},
+    "description":  {"type": "string"},
+    "orchestrator": {"type": "string"},
+    "cluster":      {"type": "string"},
+    "external_url": {"type": "string"},
+    "owners": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "urn":   {"type": "string"},
+          "name":  {"type": "string"},
+          "email": {"type": "string"},
+          "type":  {"type": "string"}
+        }
+      }
+    },
+    "total_jobs":   {"type": "integer", "description": "Number of jobs in the flow; at most 100 are returned"},
+    "jobs": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "urn":          {"type": "string"},
+          "name":         {"type": "string"},
+          "description":  {"type": "string"},
+          "external_url": {"type": "string"},
+          "inputs": {
+            "type": "array",
+            "items": {
+              "type": "object",
+              "properties": {
+                "urn":      {"type": "string"},
+                "type":     {"type": "string"},
+                "name":     {"type": "string"},
+                "platform": {"type": "string"}
+              }
+            }
+          },
+          "outputs": {
+            "type": "array",
+            "items": {
+              "type": "object",
+              "properties": {
+                "urn":      {"type": "string"},
+                "type":     {"type": "string"},
+                "name":     {"type": "string"},
+                "platform": {"type": "string"}
+              }
+            }
+          },
+          "total_runs":   {"type": "integer"},
+          "runs": {
+            "type": "array",
+            "description": "The latest run of the job",
+            "items": {
+              "type": "object",
+              "properties": {
+                "urn":             {"type": "string"},
+                "name":            {"type": "string"},
+                "status":          {"type": "string", "description": "STARTED or COMPLETE"},
+                "result":          {"type": "string", "description": "SUCCESS, FAILURE, SKIPPED or UP_FOR_RETRY"},
+                "native_result":   {"type": "string"},
+                "attempt":         {"type": "integer"},
+                "start_time":      {"type": "integer", "description": "Run start in epoch milliseconds"},
+                "duration_millis": {"type": "integer"},
+                "external_url":    {"type": "string"}
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}`)
+
+var schemaGetUser = json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    "urn":          {"type": "string"},
+    "username":     {"type": "string"},
+    "display_name": {"type": "string"},
+    "email":        {"type": "string"},
+    "title":        {"type": "string"},
+    "department":   {"type": "string"},
+    "teams":        {"type": "array", "items": {"type": "string"}},
+    "slack":        {"type": "string"},
+    "phone":        {"type": "string"},
+    "manager":      {"type": "string", "description": "Manager's user URN"},
+    "active":       {"type": "boolean"},
+    "groups": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "urn":  {"type": "string"},
+          "name": {"type": "string"}
+        }
+      }
+    },
+    "owned": {
+      "type": "object",
+      "description": "Entities directly owned (first page; use datahub_list_owned_entities for more)",
+      "properties": {
+        "total":   {"type": "integer"},
+        "entries": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "urn":      {"type": "string"},
+              "type":     {"type": "string"},
+              "name":     {"type": "string"},
+              "platform": {"type": "string"}
+            }
+          }
+        }
+      }
+    }
+  }
+}`)
+
+var schemaGetGroup = json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    "urn":           {"type": "string"},
+    "name":          {"type": "string"},
+    "display_name":  {"type": "string"},
+    "description":   {"type": "string"},
+    "email":         {"type": "string"},
+    "slack":         {"type": "string"},
+    "owners": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "urn":  {"type": "string"},
+          "name": {"type": "string"},
+          "type": {"type": "string"}
+        }
+      }
+    },
+    "total_members": {"type": "integer", "description": "Number of members; at most 100 are returned"},
+    "members": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "urn":          {"type": "string"},
+          "username":     {"type": "string"},
+          "display_name": {"type": "string"},
+          "email":        {"type": "string"},
+          "title":        {"type": "string"},
+          "slack":        {"type": "string"}
+        }
+      }
+    },
+    "owned": {
+      "type": "object",
+      "description": "Entities directly owned (first page; use datahub_list_owned_entities for more)",
+      "properties": {
+        "total":   {"type": "integer"},
+        "entries": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "urn":      {"type": "string"},
+              "type":     {"type": "string"},
+              "name":     {"type": "string"},
+              "platform": {"type": "string"}
+            }
+          }
+        }
+      }
+    }
+  }
+}`)
+
+var schemaListOwnedEntities = json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    "urn":    {"type": "string", "description": "The owning user or group"},
+    "total":  {"type": "integer"},
+    "offset": {"type": "integer"},
+    "limit":  {"type": "integer"},
+    "entries": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "urn":         {"type": "string"},
+          "type":        {"type": "string", "description": "DATASET, DASHBOARD, DATA_JOB, ..."},
+          "name":        {"type": "string"},
+          "description": {"type": "string"},
+          "platform":    {"type": "string"},
+          "sub_types":   {"type": "array", "items": {"type": "string"}}
+        }
+      }
+    }
+  }
+}`)
+
+var schemaListGlossary = json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    "parent": {"type": "string", "description": "The glossary node listed; absent for the root"},
+    "path": {
+      "type": "array",
+      "description": "Glossary nodes from the root down to the listed node",
+      "items": {
+        "type": "object",
+        "properties": {
+          "urn":  {"type": "string"},
+          "name": {"type": "string"}
+        }
+      }
+    },
+    "total":  {"type": "integer"},
+    "offset": {"type": "integer"},
+    "limit":  {"type": "integer"},
+    "entries": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "urn":         {"type": "string"},
+          "type":        {"type": "string", "description": "GLOSSARY_NODE or GLOSSARY_TERM"},
+          "name":        {"type": "string"},
+          "description": {"type": "string"},
+          "child_nodes": {"type": "integer"},
+          "child_terms": {"type": "integer"}
+        }
+      }
+    }
+  }
+}`)
+
+var schemaImpactAnalysis = json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    "urn":            {"type": "string", "description": "The entity about to change"},
+    "depth":          {"type": "integer"},
+    "total_affected": {"type": "integer", "description": "Number of downstream entities analyzed"},
+    "critical":       {"type": "integer", "description": "Affected entities carrying a critical tag"},
+    "truncated":      {"type": "boolean"},
+    "warning":        {"type": "string"},
+    "by_owner": {
+      "type": "array",
+      "description": "Notification list: each owner with the affected entities they own, most critical first",
+      "items": {
+        "type": "object",
+        "properties": {
+          "urn":      {"type": "string"},
+          "name":     {"type": "string"},
+          "email":    {"type": "string"},
+          "critical": {"type": "integer"},
+          "entities": {"type": "array", "items": {"type": "string"}}
+        }
+      }
+    },
+    "by_domain": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "urn":      {"type": "string"},
+          "name":     {"type": "string"},
+          "entities": {"type": "array", "items": {"type": "string"}}
+        }
+      }
+    },
+    "unowned": {"type": "array", "description": "Affected entities with no owner", "items": {"type": "string"}},
+    "entities": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "urn":          {"type": "string"},
+          "type":         {"type": "string"},
+          "name":         {"type": "string"},
+          "platform":     {"type": "string"},
+          "level":        {"type": "integer", "description": "Hops downstream of the changed entity"},
+          "owners":       {"type": "array", "items": {"type": "object"}},
+          "domain":       {"type": "object"},
+          "tags":         {"type": "array", "items": {"type": "string"}},
+          "critical":     {"type": "boolean"},
+          "queries":      {"type": "integer", "description": "Queries over the usage window (datasets only)"},
+          "unique_users": {"type": "integer"}
+        }
+      }
+    }
+  }
+}`)
+
+var schemaTraceColumn = json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    "dataset_urn": {"type": "string"},
+    "column":      {"type": "string"},
+    "direction":   {"type": "string", "description": "UPSTREAM or DOWNSTREAM"},
+    "depth":       {"type": "integer"},
+    "truncated":   {"type": "boolean", "description": "True when lineage continues past depth or the hop limit"},
+    "format":      {"type": "string", "description": "dot, mermaid or graphml when a graph format was requested"},
+    "graph":       {"type": "string", "description": "The rendered graph; only set with a graph format"},
+    "hops": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "level":      {"type": "integer", "description": "Hops from the traced column"},
+          "upstream": {
+            "type": "object",
+            "properties": {
+              "urn":   {"type": "string"},
+              "type":  {"type": "string"},
+              "field": {"type": "string"}
+            }
+          },
+          "downstream": {
+            "type": "object",
+            "properties": {
+              "urn":   {"type": "string"},
+              "type":  {"type": "string"},
+              "field": {"type": "string"}
+            }
+          },
+          "transform":  {"type": "string"},
+          "query":      {"type": "string", "description": "URN of the query that produces the downstream column"},
+          "sql":        {"type": "string"},
+          "job":        {"type": "string", "description": "URN of the data job that writes the downstream dataset"}
+        }
+      }
+    },
+    "endpoints": {
+      "type": "array",
+      "description": "Ultimate source columns (UPSTREAM) or final dependent columns and chart fields (DOWNSTREAM)",
+      "items": {"type": "object", "properties": {"urn": {"type": "string"}, "type": {"type": "string"}, "field": {"type": "string"}}}
+    }
+  }
+}`)
+
+var schemaFindLineagePath = json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    "source":    {"type": "string"},
+    "target":    {"type": "string"},
+    "direction": {"type": "string", "description": "DOWNSTREAM when source feeds target, UPSTREAM when target feeds source"},
+    "depth":     {"type": "integer"},
+    "found":     {"type": "boolean", "description": "Whether target is within depth hops of source in either direction"},
+    "truncated": {"type": "boolean", "description": "True when more paths exist than were returned"},
+    "message":   {"type": "string"},
+    "paths": {
+      "type": "array",
+      "description": "Paths in data-flow order, shortest first",
+      "items": {
+        "type": "object",
+        "properties": {
+          "length": {"type": "integer"},
+          "nodes":  {"type": "array", "items": {"type": "string"}},
+          "hops": {
+            "type": "array",
+            "items": {
+              "type": "object",
+              "properties": {
+                "from":    {"type": "string"},
+                "to":      {"type": "string"},
+                "jobs":    {"type": "array", "description": "Data jobs that produce the hop", "items": {"type": "string"}},
+                "queries": {"type": "array", "description": "Query URNs recorded for the hop", "items": {"type": "string"}}
+              }
+            }
+          }
+        }
+      }
+    },
+    "entities": {
+      "type": "array",
+      "description": "Lineage nodes on the returned paths",
+      "items": {
+        "type": "object",
+        "properties": {
+          "urn":      {"type": "string"},
+          "type":     {"type": "string"},
+          "name":     {"type": "string"},
+          "platform": {"type": "string"},
+          "level":    {"type": "integer"}
+        }
+      }
+    }
+  }
+}`)
+
+var schemaCheckFreshness = json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    "urn":           {"type": "string", "description": "The checked entity"},
+    "depth":         {"type": "integer"},
+    "max_age_hours": {"type": "integer", "description": "Age past which an entity is stale"},
+    "checked_at":    {"type": "integer", "description": "When the check ran (epoch millis)"},
+    "summary":       {"type": "string", "description": "One-sentence verdict naming the oldest upstream source"},
+    "truncated":     {"type": "boolean"},
+    "warning":       {"type": "string"},
+    "entity":        {"type": "object", "description": "The checked entity's last update, with the fields of upstream items"},
+    "stale": {
+      "type": "array",
+      "description": "Upstream entities older than max_age_hours, oldest first",
+      "items": {"type": "object"}
+    },
+    "stalest_path": {
+      "type": "array",
+      "description": "Lineage path from the oldest upstream entity to the checked one, in data flow order",
+      "items": {"type": "object"}
+    },
+    "unknown": {"type": "array", "description": "Upstream entities with no recorded update time", "items": {"type": "string"}},
+    "upstream": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "urn":          {"type": "string"},
+          "type":         {"type": "string"},
+          "name":         {"type": "string"},
+          "platform":     {"type": "string"},
+          "level":        {"type": "integer", "description": "Hops upstream of the checked entity"},
+          "last_updated": {"type": "integer", "description": "When the data last changed (epoch millis)"},
+          "age_hours":    {"type": "number"},
+          "source":       {"type": "string", "description": "OPERATION, LAST_MODIFIED, QUERY_ENGINE, RUN or REFRESH"},
+          "stale":        {"type": "boolean"}
+        }
+      }
+    }
+  }
+}`)
+
+var schemaFederatedSearch = json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    "total":    {"type": "integer", "description": "Sum of per-connection totals"},
+    "offset":   {"type": "integer", "description": "Result offset applied on each connection"},
+    "limit":    {"type": "integer", "description": "Result limit applied on each connection"},
+    "entities": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "urn":         {"type": "string"},
+          "name":        {"type": "string"},
+          "type":        {"type": "string"},
+          "description": {"type": "string"},
+          "platform":    {"type": "string"},
+          "connections": {"type": "array", "items": {"type": "string"}, "description": "Connections that returned this entity"}
+        }
+      }
+    },
+    "connections": {"type": "array", "items": {"type": "string"}, "description": "Connections queried"},
+    "warnings":    {"type": "array", "items": {"type": "string"}, "description": "Connections that failed"},
+    "query_context": {
+      "type": "object",
+      "description": "Optional: query engine availability per entity URN",
+      "additionalProperties": {
+        "type": "object",
+        "properties": {
+          "available": {"type": "boolean"},
+          "table":     {"type": "string"}
+        }
+      }
+    }
+  }
+}`)
+
+var schemaListConnections = json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    "connections": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "name":          {"type": "string"},
+          "url":           {"type": "string"},
+          "is_default":    {"type": "boolean"},
+          "write_enabled": {"type": "boolean"}
+        }
+      }
+    }
+  }
+}`)
+
+var schemaUpdateDescription = json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    "urn":         {"type": "string"},
+    "description": {"type": "string"},
+    "aspect":      {"type": "string"},
+    "action":      {"type": "string"}
+  }
+}`)
+
+var schemaAddTag = json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    "urn":    {"type": "string"},
+    "tag":    {"type": "string"},
+    "aspect": {"type": "string"},
+    "action": {"type": "string"}
+  }
+}`)
+
+var schemaRemoveTag = json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    "urn":    {"type": "string"},
+    "tag":    {"type": "string"},
+    "aspect": {"type": "string"},
+    "action": {"type": "string"}
+  }
+}`)
+
+var schemaAddGlossaryTerm = json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    "urn":           {"type": "string"},
+    "glossary_term": {"type": "string"},
+    "aspect":        {"type": "string"},
+    "action":        {"type": "string"}
+  }
+}`)
+
+var schemaRemoveGlossaryTerm = json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    "urn":           {"type": "string"},
+    "glossary_term": {"type": "string"},
+    "aspect":        {"type": "string"},
+    "action":        {"type": "string"}
+  }
+}`)
+
+var schemaAddLink = json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    "urn":    {"type": "string"},
+    "url":    {"type": "string"},
+    "label":  {"type": "string"},
+    "action": {"type": "string"}
+  }
+}`)
+
+var schemaRemoveLink = json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    "urn":    {"type": "string"},
+    "url":    {"type": "string"},
+    "action": {"type": "string"}
+  }
+}`)
+
+var schemaRaiseIncident = json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    "urn":      {"type": "string"},
+    "incident": {"type": "string", "description": "URN of the new incident"},
+    "state":    {"type": "string"},
+    "action":   {"type": "string"}
+  }
+}`)
+
+var schemaResolveIncident = json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    "incident": {"type": "string"},
+    "state":    {"type": "string"},
+    "action":   {"type": "string"}
+  }
+}`)
+
+var schemaAddLineage = json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    "upstream":          {"type": "string"},
+    "downstream":        {"type": "string"},
+    "upstream_column":   {"type": "string"},
+    "downstream_column": {"type": "string"},
+    "action":            {"type": "string"}
+  }
+}`)
+
+var schemaRemoveLineage = json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    "upstream":          {"type": "string"},
+    "downstream":        {"type": "string"},
+    "upstream_column":   {"type": "string"},
+    "downstream_column": {"type": "string"},
+    "action":            {"type": "string"}
   }
 }`)
@@ -17,6 +17,57 @@ type ListDataProductsOutput struct {
 	DataProducts []types.DataProduct `json:"data_products"`
 }
 
+// GetContainerOutput is the structured output of the datahub_get_container tool.
+type GetContainerOutput struct {
+	URN       string            `json:"urn"`
+	Container *types.Container  `json:"container,omitempty"`
+	Path      []types.PathEntry `json:"path"`
+}
+
+// GetAssertionsOutput is the structured output of the datahub_get_assertions tool.
+type GetAssertionsOutput struct {
+	URN        string            `json:"urn"`
+	Total      int               `json:"total"`
+	Passing    int               `json:"passing"`
+	Failing    int               `json:"failing"`
+	Erroring   int               `json:"erroring"`
+	NotRun     int               `json:"not_run"`
+	Warning    string            `json:"warning,omitempty"`
+	Assertions []types.Assertion `json:"assertions"`
+}
+
+// GetDataJobOutput is the structured output of the datahub_get_data_job tool.
+type GetDataJobOutput struct {
+	types.Pipeline
+	Warning string `json:"warning,omitempty"`
+}
+
+// GetLineageOutput is the structured output of the datahub_get_lineage tool.
+type GetLineageOutput struct {
+	*types.LineageResult
+	Warning string `json:"warning,omitempty"`
+}
+
+// RenderedLineageOutput is the structured output of the lineage tools when a
+// graph format is requested.
+type RenderedLineageOutput struct {
+	Format  string `json:"format"`
+	Graph   string `json:"graph"`
+	Warning string `json:"warning,omitempty"`
+}
+
+// GetUserOutput is the structured output of the datahub_get_user tool.
+type GetUserOutput struct {
+	types.User
+	Owned *types.BrowseResult `json:"owned"`
+}
+
+// GetGroupOutput is the structured output of the datahub_get_group tool.
+type GetGroupOutput struct {
+	types.Group
+	Owned *types.BrowseResult `json:"owned"`
+}
+
 // UpdateDescriptionOutput is the structured output of the datahub_update_description tool.
 type UpdateDescriptionOutput struct {
 	URN    string `json:"urn"`
@@ -71,3 +122,139 @@ type RemoveLinkOutput struct {
 	Aspect string `json:"aspect"`
 	Action string `json:"action"`
 }
+
+// RaiseIncidentOutput is the structured output of the datahub_raise_incident tool.
+type RaiseIncidentOutput struct {
+	URN      string `json:"urn"`
+	Incident string `json:"incident"`
+	State    string `json:"state"`
+	Action   string `json:"action"`
+}
+
+// ResolveIncidentOutput is the structured output of the datahub_resolve_incident tool.
+type ResolveIncidentOutput struct {
+	Incident string `json:"incident"`
+	State    string `json:"state"`
+	Action   string `json:"action"`
+}
+
+// AddLineageOutput is the structured output of the datahub_add_lineage tool.
+type AddLineageOutput struct {
+	Upstream         string `json:"upstream"`
+	Downstream       string `json:"downstream"`
+	UpstreamColumn   string `json:"upstream_column,omitempty"`
+	DownstreamColumn string `json:"downstream_column,omitempty"`
+	Action           string `json:"action"`
+}
+
+// RemoveLineageOutput is the structured output of the datahub_remove_lineage tool.
+type RemoveLineageOutput struct {
+	Upstream         string `json:"upstream"`
+	Downstream       string `json:"downstream"`
+	UpstreamColumn   string `json:"upstream_column,omitempty"`
+	DownstreamColumn string `json:"downstream_column,omitempty"`
+	Action           string `json:"action"`
+}
+
+// ImpactAnalysisOutput is the structured output of the datahub_impact_analysis tool.
+type ImpactAnalysisOutput struct {
+	URN           string           `json:"urn"`
+	Depth         int              `json:"depth"`
+	TotalAffected int              `json:"total_affected"`
+	Critical      int              `json:"critical"`
+	Truncated     bool             `json:"truncated,omitempty"`
+	Warning       string           `json:"warning,omitempty"`
+	ByOwner       []OwnerImpact    `json:"by_owner"`
+	ByDomain      []DomainImpact   `json:"by_domain"`
+	Unowned       []string         `json:"unowned,omitempty"`
+	Entities      []ImpactedEntity `json:"entities"`
+}
+
+// ImpactedEntity is a downstream entity in an impact analysis report.
+type ImpactedEntity struct {
+	URN         string        `json:"urn"`
+	Type        string        `json:"type"`
+	Name        string        `json:"name,omitempty"`
+	Platform    string        `json:"platform,omitempty"`
+	Level       int           `json:"level"`
+	Owners      []types.Owner `json:"owners,omitempty"`
+	Domain      *types.Domain `json:"domain,omitempty"`
+	Tags        []string      `json:"tags,omitempty"`
+	Critical    bool          `json:"critical"`
+	Queries     int           `json:"queries,omitempty"`
+	UniqueUsers int           `json:"unique_users,omitempty"`
+}
+
+// OwnerImpact lists the affected entities of a single owner, for notification.
+type OwnerImpact struct {
+	URN      string   `json:"urn"`
+	Name     string   `json:"name,omitempty"`
+	Email    string   `json:"email,omitempty"`
+	Critical int      `json:"critical"`
+	Entities []string `json:"entities"`
+}
+
+// DomainImpact lists the affected entities of a single domain.
+type DomainImpact struct {
+	URN      string   `json:"urn"`
+	Name     string   `json:"name,omitempty"`
+	Entities []string `json:"entities"`
+}
+
+// CheckFreshnessOutput is the structured output of the datahub_check_freshness tool.
+type CheckFreshnessOutput struct {
+	URN         string            `json:"urn"`
+	Depth       int               `json:"depth"`
+	MaxAgeHours int               `json:"max_age_hours"`
+	CheckedAt   int64             `json:"checked_at"`
+	Summary     string            `json:"summary"`
+	Truncated   bool              `json:"truncated,omitempty"`
+	Warning     string            `json:"warning,omitempty"`
+	Entity      FreshnessEntity   `json:"entity"`
+	Stale       []FreshnessEntity `json:"stale"`
+	StalestPath []FreshnessEntity `json:"stalest_path"`
+	Unknown     []string          `json:"unknown,omitempty"`
+	Upstream    []FreshnessEntity `json:"upstream"`
+}
+
+// FreshnessEntity is an entity in a freshness report with its last update.
+type FreshnessEntity struct {
+	URN         string  `json:"urn"`
+	Type        string  `json:"type,omitempty"`
+	Name        string  `json:"name,omitempty"`
+	Platform    string  `json:"platform,omitempty"`
+	Level       int     `json:"level"`
+	LastUpdated int64   `json:"last_updated,omitempty"`
+	AgeHours    float64 `json:"age_hours,omitempty"`
+	Source      string  `json:"source,omitempty"`
+	Stale       bool    `json:"stale"`
+}
+
+// FindLineagePathOutput is the structured output of the datahub_find_lineage_path tool.
+type FindLineagePathOutput struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+	// Direction is DOWNSTREAM when source feeds target and UPSTREAM when target feeds source.
+	Direction string              `json:"direction,omitempty"`
+	Depth     int                 `json:"depth"`
+	Found     bool                `json:"found"`
+	Paths     []LineagePath       `json:"paths"`
+	Truncated bool                `json:"truncated,omitempty"`
+	Message   string              `json:"message,omitempty"`
+	Entities  []types.LineageNode `json:"entities,omitempty"`
+}
+
+// LineagePath is one route between two entities, in data-flow order.
+type LineagePath struct {
+	Length int          `json:"length"`
+	Nodes  []string     `json:"nodes"`
+	Hops   []LineageHop `json:"hops"`
+}
+
+// LineageHop is one step of a lineage path with the jobs and queries that produce it.
+type LineageHop struct {
+	From    string   `json:"from"`
+	To      string   `json:"to"`
+	Jobs    []string `json:"jobs,omitempty"`
+	Queries []string `json:"queries,omitempty"`
+}
@@ -0,0 +1,127 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+// GetDataJobInput is the input for the get_data_job tool.
+type GetDataJobInput struct {
+	URN      string `json:"urn" jsonschema_description:"The DataHub URN of the data job (urn:li:dataJob:...)"`
+	RunLimit int    `json:"run_limit,omitempty" jsonschema_description:"Maximum number of recent runs to return (default: 10)"`
+	// Connection is the named connection to use. Empty uses the default connection.
+	Connection string `json:"connection,omitempty" jsonschema_description:"Named connection to use (see datahub_list_connections)"`
+}
+
+// GetDataFlowInput is the input for the get_data_flow tool.
+type GetDataFlowInput struct {
+	URN string `json:"urn" jsonschema_description:"The DataHub URN of the data flow (urn:li:dataFlow:...)"`
+	// Connection is the named connection to use. Empty uses the default connection.
+	Connection string `json:"connection,omitempty" jsonschema_description:"Named connection to use (see datahub_list_connections)"`
+}
+
+func (t *Toolkit) registerGetDataJobTool(server *mcp.Server, cfg *toolConfig) {
+	baseHandler := func(ctx context.Context, req *mcp.CallToolRequest, input any) (*mcp.CallToolResult, any, error) {
+		jobInput, ok := input.(GetDataJobInput)
+		if !ok {
+			return ErrorResult("internal error: invalid input type"), nil, nil
+		}
+		return t.handleGetDataJob(ctx, req, jobInput)
+	}
+
+	wrappedHandler := t.wrapHandler(ToolGetDataJob, baseHandler, cfg)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:         string(ToolGetDataJob),
+		Description:  t.getDescription(ToolGetDataJob, cfg),
+		Annotations:  t.getAnnotations(ToolGetDataJob, cfg),
+		Icons:        t.getIcons(ToolGetDataJob, cfg),
+		Title:        t.getTitle(ToolGetDataJob, cfg),
+		OutputSchema: t.getOutputSchema(ToolGetDataJob, cfg),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, input GetDataJobInput) (*mcp.CallToolResult, any, error) {
+		return wrappedHandler(ctx, req, input)
+	})
+}
+
+func (t *Toolkit) registerGetDataFlowTool(server *mcp.Server, cfg *toolConfig) {
+	baseHandler := func(ctx context.Context, req *mcp.CallToolRequest, input any) (*mcp.CallToolResult, any, error) {
+		flowInput, ok := input.(GetDataFlowInput)
+		if !ok {
+			return ErrorResult("internal error: invalid input type"), nil, nil
+		}
+		return t.handleGetDataFlow(ctx, req, flowInput)
+	}
+
+	wrappedHandler := t.wrapHandler(ToolGetDataFlow, baseHandler, cfg)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:         string(ToolGetDataFlow),
+		Description:  t.getDescription(ToolGetDataFlow, cfg),
+		Annotations:  t.getAnnotations(ToolGetDataFlow, cfg),
+		Icons:        t.getIcons(ToolGetDataFlow, cfg),
+		Title:        t.getTitle(ToolGetDataFlow, cfg),
+		OutputSchema: t.getOutputSchema(ToolGetDataFlow, cfg),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, input GetDataFlowInput) (*mcp.CallToolResult, any, error) {
+		return wrappedHandler(ctx, req, input)
+	})
+}
+
+func (t *Toolkit) handleGetDataJob(ctx context.Context, _ *mcp.CallToolRequest, input GetDataJobInput) (*mcp.CallToolResult, any, error) {
+	if input.URN == "" {
+		return ErrorResult("urn parameter is required"), nil, nil
+	}
+
+	var opts []client.DataJobOption
+	if input.RunLimit > 0 {
+		opts = append(opts, client.WithDataJobRunLimit(input.RunLimit))
+	}
+
+	datahubClient, err := t.getClient(input.Connection)
+	if err != nil {
+		return ErrorResult("Connection error: " + err.Error()), nil, nil
+	}
+
+	job, err := datahubClient.GetDataJob(ctx, input.URN, opts...)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return ErrorResult("Data job not found: " + input.URN), nil, nil
+		}
+		return ErrorResult(err.Error()), nil, nil
+	}
+
+	output := GetDataJobOutput{Pipeline: *job}
+	if len(job.Runs) > 0 && job.Runs[0].Result == types.RunResultFailure {
+		output.Warning = fmt.Sprintf(
+			"the latest run (%s) failed; datasets written by this job may be stale or incomplete",
+			job.Runs[0].URN)
+	}
+
+	return formatJSONResult(output)
+}
+
+func (t *Toolkit) handleGetDataFlow(ctx context.Context, _ *mcp.CallToolRequest, input GetDataFlowInput) (*mcp.CallToolResult, any, error) {
+	if input.URN == "" {
+		return ErrorResult("urn parameter is required"), nil, nil
+	}
+
+	datahubClient, err := t.getClient(input.Connection)
+	if err != nil {
+		return ErrorResult("Connection error: " + err.Error()), nil, nil
+	}
+
+	flow, err := datahubClient.GetDataFlow(ctx, input.URN)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return ErrorResult("Data flow not found: " + input.URN), nil, nil
+		}
+		return ErrorResult(err.Error()), nil, nil
+	}
+
+	return formatJSONResult(flow)
+}
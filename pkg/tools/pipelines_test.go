@@ -0,0 +1,144 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+func TestHandleGetDataJob(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       GetDataJobInput
+		runs        []types.PipelineRun
+		mockErr     error
+		wantErr     bool
+		wantErrMsg  string
+		wantOpts    int
+		wantWarning bool
+	}{
+		{
+			name:  "latest run succeeded",
+			input: GetDataJobInput{URN: "urn:li:dataJob:x"},
+			runs:  []types.PipelineRun{{URN: "run2", Result: types.RunResultSuccess}, {URN: "run1", Result: types.RunResultFailure}},
+		},
+		{
+			name:        "latest run failed",
+			input:       GetDataJobInput{URN: "urn:li:dataJob:x", RunLimit: 5},
+			runs:        []types.PipelineRun{{URN: "run2", Result: types.RunResultFailure}},
+			wantOpts:    1,
+			wantWarning: true,
+		},
+		{name: "no runs", input: GetDataJobInput{URN: "urn:li:dataJob:x"}},
+		{name: "empty URN", input: GetDataJobInput{}, wantErr: true, wantErrMsg: "urn parameter is required"},
+		{
+			name:       "not found",
+			input:      GetDataJobInput{URN: "urn:li:dataJob:missing"},
+			mockErr:    fmt.Errorf("GetDataJob: %w", client.ErrNotFound),
+			wantErr:    true,
+			wantErrMsg: "Data job not found",
+		},
+		{name: "client error", input: GetDataJobInput{URN: "urn:li:dataJob:x"}, mockErr: errors.New("boom"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotOpts int
+			mock := &mockClient{
+				getDataJobFunc: func(_ context.Context, urn string, opts ...client.DataJobOption) (*types.Pipeline, error) {
+					gotOpts = len(opts)
+					if tt.mockErr != nil {
+						return nil, tt.mockErr
+					}
+					return &types.Pipeline{Entity: types.Entity{URN: urn}, Runs: tt.runs}, nil
+				},
+			}
+			toolkit := NewToolkit(mock, DefaultConfig())
+
+			result, out, err := toolkit.handleGetDataJob(context.Background(), nil, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.IsError != tt.wantErr {
+				t.Fatalf("IsError = %v, want %v (%s)", result.IsError, tt.wantErr, resultText(result))
+			}
+			if tt.wantErr {
+				if tt.wantErrMsg != "" && !strings.Contains(resultText(result), tt.wantErrMsg) {
+					t.Errorf("error %q does not mention %q", resultText(result), tt.wantErrMsg)
+				}
+				return
+			}
+			if gotOpts != tt.wantOpts {
+				t.Errorf("passed %d options, want %d", gotOpts, tt.wantOpts)
+			}
+			output, ok := out.(GetDataJobOutput)
+			if !ok {
+				t.Fatalf("unexpected output type: %T", out)
+			}
+			if (output.Warning != "") != tt.wantWarning {
+				t.Errorf("Warning = %q, want warning %v", output.Warning, tt.wantWarning)
+			}
+		})
+	}
+}
+
+func TestHandleGetDataFlow(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      GetDataFlowInput
+		mockErr    error
+		wantErr    bool
+		wantErrMsg string
+	}{
+		{name: "success", input: GetDataFlowInput{URN: "urn:li:dataFlow:(airflow,etl,prod)"}},
+		{name: "empty URN", input: GetDataFlowInput{}, wantErr: true, wantErrMsg: "urn parameter is required"},
+		{
+			name:       "not found",
+			input:      GetDataFlowInput{URN: "urn:li:dataFlow:(airflow,missing,prod)"},
+			mockErr:    fmt.Errorf("GetDataFlow: %w", client.ErrNotFound),
+			wantErr:    true,
+			wantErrMsg: "Data flow not found",
+		},
+		{name: "client error", input: GetDataFlowInput{URN: "urn:li:dataFlow:(airflow,etl,prod)"}, mockErr: errors.New("boom"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockClient{
+				getDataFlowFunc: func(_ context.Context, urn string) (*types.DataFlow, error) {
+					if tt.mockErr != nil {
+						return nil, tt.mockErr
+					}
+					return &types.DataFlow{
+						Entity:    types.Entity{URN: urn},
+						TotalJobs: 1,
+						Jobs:      []types.Pipeline{{Entity: types.Entity{URN: "urn:li:dataJob:x"}}},
+					}, nil
+				},
+			}
+			toolkit := NewToolkit(mock, DefaultConfig())
+
+			result, out, err := toolkit.handleGetDataFlow(context.Background(), nil, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.IsError != tt.wantErr {
+				t.Fatalf("IsError = %v, want %v (%s)", result.IsError, tt.wantErr, resultText(result))
+			}
+			if tt.wantErr {
+				if tt.wantErrMsg != "" && !strings.Contains(resultText(result), tt.wantErrMsg) {
+					t.Errorf("error %q does not mention %q", resultText(result), tt.wantErrMsg)
+				}
+				return
+			}
+			if flow, ok := out.(*types.DataFlow); !ok || len(flow.Jobs) != 1 {
+				t.Errorf("unexpected output: %#v", out)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+)
+
+// GetDatasetProfileInput is the input for the get_dataset_profile tool.
+type GetDatasetProfileInput struct {
+	URN       string `json:"urn" jsonschema_description:"The DataHub URN of the dataset"`
+	StartTime string `json:"start_time,omitempty" jsonschema_description:"Only profiles taken at or after this time (RFC 3339 or YYYY-MM-DD)"`
+	EndTime   string `json:"end_time,omitempty" jsonschema_description:"Only profiles up to this time (RFC 3339 or YYYY-MM-DD, inclusive)"`
+	Limit     int    `json:"limit,omitempty" jsonschema_description:"Maximum profiles to retrieve, including the latest (default: 10)"`
+	// Connection is the named connection to use. Empty uses the default connection.
+	Connection string `json:"connection,omitempty" jsonschema_description:"Named connection to use (see datahub_list_connections)"`
+}
+
+func (t *Toolkit) registerGetDatasetProfileTool(server *mcp.Server, cfg *toolConfig) {
+	baseHandler := func(ctx context.Context, req *mcp.CallToolRequest, input any) (*mcp.CallToolResult, any, error) {
+		profileInput, ok := input.(GetDatasetProfileInput)
+		if !ok {
+			return ErrorResult("internal error: invalid input type"), nil, nil
+		}
+		return t.handleGetDatasetProfile(ctx, req, profileInput)
+	}
+
+	wrappedHandler := t.wrapHandler(ToolGetDatasetProfile, baseHandler, cfg)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:         string(ToolGetDatasetProfile),
+		Description:  t.getDescription(ToolGetDatasetProfile, cfg),
+		Annotations:  t.getAnnotations(ToolGetDatasetProfile, cfg),
+		Icons:        t.getIcons(ToolGetDatasetProfile, cfg),
+		Title:        t.getTitle(ToolGetDatasetProfile, cfg),
+		OutputSchema: t.getOutputSchema(ToolGetDatasetProfile, cfg),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, input GetDatasetProfileInput) (*mcp.CallToolResult, any, error) {
+		return wrappedHandler(ctx, req, input)
+	})
+}
+
+func (t *Toolkit) handleGetDatasetProfile(
+	ctx context.Context,
+	_ *mcp.CallToolRequest,
+	input GetDatasetProfileInput,
+) (*mcp.CallToolResult, any, error) {
+	if input.URN == "" {
+		return ErrorResult("urn parameter is required"), nil, nil
+	}
+
+	start, err := parseTimeInput("start_time", input.StartTime, false)
+	if err != nil {
+		return ErrorResult(err.Error()), nil, nil
+	}
+	end, err := parseTimeInput("end_time", input.EndTime, true)
+	if err != nil {
+		return ErrorResult(err.Error()), nil, nil
+	}
+	if !start.IsZero() && !end.IsZero() && end.Before(start) {
+		return ErrorResult("end_time must not be before start_time"), nil, nil
+	}
+
+	datahubClient, err := t.getClient(input.Connection)
+	if err != nil {
+		return ErrorResult("Connection error: " + err.Error()), nil, nil
+	}
+
+	opts := []client.ProfileOption{client.WithProfileTimeRange(start, end)}
+	if input.Limit > 0 {
+		opts = append(opts, client.WithProfileLimit(input.Limit))
+	}
+
+	profile, err := datahubClient.GetDatasetProfile(ctx, input.URN, opts...)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return ErrorResult("No profile found for " + input.URN +
+				": the dataset may not exist or has not been profiled in the requested time range"), nil, nil
+		}
+		return ErrorResult(err.Error()), nil, nil
+	}
+
+	return formatJSONResult(profile)
+}
+
+// parseTimeInput parses an optional RFC 3339 timestamp or YYYY-MM-DD date parameter.
+// An empty value yields the zero time. With endOfDay set, a date covers the whole
+// day and yields its last millisecond rather than midnight.
+func parseTimeInput(name, value string, endOfDay bool) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if ts, err := time.Parse(time.RFC3339, value); err == nil {
+		return ts, nil
+	}
+	if ts, err := time.Parse(time.DateOnly, value); err == nil {
+		if endOfDay {
+			return ts.AddDate(0, 0, 1).Add(-time.Millisecond), nil
+		}
+		return ts, nil
+	}
+	return time.Time{}, fmt.Errorf("%s must be an RFC 3339 timestamp or YYYY-MM-DD date, got %q", name, value)
+}
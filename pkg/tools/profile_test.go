@@ -0,0 +1,128 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+func TestHandleGetDatasetProfile(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      GetDatasetProfileInput
+		mockErr    error
+		wantErr    bool
+		wantErrMsg string
+	}{
+		{
+			name:  "latest profile",
+			input: GetDatasetProfileInput{URN: "urn:li:dataset:test"},
+		},
+		{
+			name:  "time range and limit",
+			input: GetDatasetProfileInput{URN: "urn:li:dataset:test", StartTime: "2024-01-01", EndTime: "2024-02-01T00:00:00Z", Limit: 3},
+		},
+		{
+			name:  "single day",
+			input: GetDatasetProfileInput{URN: "urn:li:dataset:test", StartTime: "2024-03-01", EndTime: "2024-03-01"},
+		},
+		{
+			name:       "empty URN",
+			input:      GetDatasetProfileInput{},
+			wantErr:    true,
+			wantErrMsg: "urn parameter is required",
+		},
+		{
+			name:       "invalid start time",
+			input:      GetDatasetProfileInput{URN: "urn:li:dataset:test", StartTime: "yesterday"},
+			wantErr:    true,
+			wantErrMsg: "start_time",
+		},
+		{
+			name:       "end before start",
+			input:      GetDatasetProfileInput{URN: "urn:li:dataset:test", StartTime: "2024-02-01", EndTime: "2024-01-01"},
+			wantErr:    true,
+			wantErrMsg: "end_time must not be before start_time",
+		},
+		{
+			name:       "not profiled",
+			input:      GetDatasetProfileInput{URN: "urn:li:dataset:test"},
+			mockErr:    fmt.Errorf("GetDatasetProfile: %w", client.ErrNotFound),
+			wantErr:    true,
+			wantErrMsg: "No profile found",
+		},
+		{
+			name:    "client error",
+			input:   GetDatasetProfileInput{URN: "urn:li:dataset:test"},
+			mockErr: errors.New("boom"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockClient{
+				getDatasetProfileFunc: func(_ context.Context, urn string, _ ...client.ProfileOption) (*types.DatasetProfile, error) {
+					if tt.mockErr != nil {
+						return nil, tt.mockErr
+					}
+					return &types.DatasetProfile{URN: urn, RowCount: 42}, nil
+				},
+			}
+			toolkit := NewToolkit(mock, DefaultConfig())
+
+			result, out, err := toolkit.handleGetDatasetProfile(context.Background(), nil, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.IsError != tt.wantErr {
+				t.Fatalf("IsError = %v, want %v", result.IsError, tt.wantErr)
+			}
+			if tt.wantErr {
+				if tt.wantErrMsg != "" && !strings.Contains(resultText(result), tt.wantErrMsg) {
+					t.Errorf("error %q does not mention %q", resultText(result), tt.wantErrMsg)
+				}
+				return
+			}
+			profile, ok := out.(*types.DatasetProfile)
+			if !ok || profile.RowCount != 42 {
+				t.Errorf("unexpected output: %#v", out)
+			}
+		})
+	}
+}
+
+func TestParseTimeInput(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		endOfDay bool
+		want     time.Time
+		wantErr  bool
+	}{
+		{"empty", "", false, time.Time{}, false},
+		{"date", "2024-03-01", false, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), false},
+		{"date end of day", "2024-03-01", true, time.Date(2024, 3, 1, 23, 59, 59, int(999*time.Millisecond), time.UTC), false},
+		{"timestamp", "2024-03-01T12:30:00Z", false, time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC), false},
+		{"timestamp end", "2024-03-01T12:30:00Z", true, time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC), false},
+		{"invalid", "03/01/2024", false, time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseTimeInput("start_time", tt.value, tt.endOfDay)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseTimeInput() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("parseTimeInput() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -16,8 +16,10 @@ type SearchInput struct {
 	EntityType string `json:"entity_type,omitempty" jsonschema_description:"Entity type to search. Defaults to DATASET."`
 	Limit      int    `json:"limit,omitempty" jsonschema_description:"Maximum number of results (default: 10, max: 100)"`
 	Offset     int    `json:"offset,omitempty" jsonschema_description:"Result offset for pagination"`
-	// Connection is the named connection to use. Empty uses the default connection.
-	Connection string `json:"connection,omitempty" jsonschema_description:"Named connection to use (see datahub_list_connections)"`
+	Container  string `json:"container,omitempty" jsonschema_description:"Only return direct children of this container URN"`
+	// Connection is the named connection to use. Empty uses the default connection;
+	// "*" searches every configured connection.
+	Connection string `json:"connection,omitempty" jsonschema_description:"Named connection (see datahub_list_connections), or \"*\" for all"`
 }
 
 func (t *Toolkit) registerSearchTool(server *mcp.Server, cfg *toolConfig) {
@@ -55,14 +57,21 @@ func buildSearchOptions(input SearchInput) []client.SearchOption {
 	if input.Offset > 0 {
 		opts = append(opts, client.WithOffset(input.Offset))
 	}
+	if input.Container != "" {
+		opts = append(opts, client.WithContainer(input.Container))
+	}
 	return opts
 }
 
-func (t *Toolkit) handleSearch(ctx context.Context, _ *mcp.CallToolRequest, input SearchInput) (*mcp.CallToolResult, any, error) {
+func (t *Toolkit) handleSearch(ctx context.Context, req *mcp.CallToolRequest, input SearchInput) (*mcp.CallToolResult, any, error) {
 	if input.Query == "" {
 		return ErrorResult("query parameter is required"), nil, nil
 	}
 
+	if input.Connection == AllConnections {
+		return t.handleFederatedSearch(ctx, req, federatedSearchInput(input))
+	}
+
 	datahubClient, err := t.getClient(input.Connection)
 	if err != nil {
 		return ErrorResult("Connection error: " + err.Error()), nil, nil
@@ -117,3 +117,21 @@ func TestSearchInputValidation(t *testing.T) {
 		t.Error("Should return error for invalid input type")
 	}
 }
+
+func TestBuildSearchOptions(t *testing.T) {
+	opts := buildSearchOptions(SearchInput{Query: "orders"})
+	if len(opts) != 0 {
+		t.Errorf("expected no options, got %d", len(opts))
+	}
+
+	opts = buildSearchOptions(SearchInput{
+		Query:      "orders",
+		EntityType: "DATASET",
+		Limit:      5,
+		Offset:     10,
+		Container:  "urn:li:container:sales",
+	})
+	if len(opts) != 4 {
+		t.Errorf("expected 4 options, got %d", len(opts))
+	}
+}
@@ -5,18 +5,38 @@ package tools
 // Display name precedence in the SDK: Tool.Title > ToolAnnotations.Title > Tool.Name.
 var defaultTitles = map[ToolName]string{
 	// Read tools
-	ToolSearch:           "Search Catalog",
-	ToolGetEntity:        "Get Entity",
-	ToolGetSchema:        "Get Schema",
-	ToolGetLineage:       "Get Lineage",
-	ToolGetColumnLineage: "Get Column Lineage",
-	ToolGetQueries:       "Get Queries",
-	ToolGetGlossaryTerm:  "Get Glossary Term",
-	ToolListTags:         "List Tags",
-	ToolListDomains:      "List Domains",
-	ToolListDataProducts: "List Data Products",
-	ToolGetDataProduct:   "Get Data Product",
-	ToolListConnections:  "List Connections",
+	ToolSearch:            "Search Catalog",
+	ToolGetEntity:         "Get Entity",
+	ToolGetSchema:         "Get Schema",
+	ToolGetLineage:        "Get Lineage",
+	ToolGetColumnLineage:  "Get Column Lineage",
+	ToolGetQueries:        "Get Queries",
+	ToolGetGlossaryTerm:   "Get Glossary Term",
+	ToolListTags:          "List Tags",
+	ToolListDomains:       "List Domains",
+	ToolListDataProducts:  "List Data Products",
+	ToolGetDataProduct:    "Get Data Product",
+	ToolGetDatasetProfile: "Get Dataset Profile",
+	ToolGetUsageStats:     "Get Usage Stats",
+	ToolGetAssertions:     "Get Assertions",
+	ToolListIncidents:     "List Incidents",
+	ToolGetDataContract:   "Get Data Contract",
+	ToolBrowse:            "Browse",
+	ToolGetContainer:      "Get Container",
+	ToolGetDashboard:      "Get Dashboard",
+	ToolGetChart:          "Get Chart",
+	ToolGetDataJob:        "Get Data Job",
+	ToolGetDataFlow:       "Get Data Flow",
+	ToolGetUser:           "Get User",
+	ToolGetGroup:          "Get Group",
+	ToolListOwnedEntities: "List Owned Entities",
+	ToolListGlossary:      "List Glossary",
+	ToolImpactAnalysis:    "Impact Analysis",
+	ToolTraceColumn:       "Trace Column",
+	ToolFindLineagePath:   "Find Lineage Path",
+	ToolCheckFreshness:    "Check Freshness",
+	ToolFederatedSearch:   "Federated Search",
+	ToolListConnections:   "List Connections",
 
 	// Write tools
 	ToolUpdateDescription:  "Update Description",
@@ -26,6 +46,10 @@ var defaultTitles = map[ToolName]string{
 	ToolRemoveGlossaryTerm: "Remove Glossary Term",
 	ToolAddLink:            "Add Link",
 	ToolRemoveLink:         "Remove Link",
+	ToolRaiseIncident:      "Raise Incident",
+	ToolResolveIncident:    "Resolve Incident",
+	ToolAddLineage:         "Add Lineage",
+	ToolRemoveLineage:      "Remove Lineage",
 }
 
 // DefaultTitle returns the default human-readable title for a tool.
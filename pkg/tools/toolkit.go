@@ -3,6 +3,7 @@ package tools
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
@@ -131,11 +132,17 @@ func (t *Toolkit) buildIntegrationMiddleware() {
 }
 
 // RegisterAll adds all DataHub tools to the given MCP server.
-// If WriteEnabled is true, also registers write tools.
+// If WriteEnabled is true, also registers write tools. In multi-server mode the
+// decision is made from the connections known at this point; without write tools,
+// the manager rejects write-enabled connections added later. Register WriteTools
+// explicitly if such connections are expected.
 func (t *Toolkit) RegisterAll(server *mcp.Server) {
 	t.Register(server, AllTools()...)
-	if t.isWriteEnabled() {
+	switch {
+	case t.isWriteEnabled():
 		t.Register(server, WriteTools()...)
+	case t.manager != nil && !t.hasWriteTools():
+		t.manager.RejectWriteConnections(true)
 	}
 }
 
@@ -162,18 +169,38 @@ type toolRegistrar func(server *mcp.Server, cfg *toolConfig)
 func (t *Toolkit) toolRegistry() map[ToolName]toolRegistrar {
 	return map[ToolName]toolRegistrar{
 		// Read tools
-		ToolSearch:           t.registerSearchTool,
-		ToolGetEntity:        t.registerGetEntityTool,
-		ToolGetSchema:        t.registerGetSchemaTool,
-		ToolGetLineage:       t.registerGetLineageTool,
-		ToolGetColumnLineage: t.registerGetColumnLineageTool,
-		ToolGetQueries:       t.registerGetQueriesTool,
-		ToolGetGlossaryTerm:  t.registerGetGlossaryTermTool,
-		ToolListTags:         t.registerListTagsTool,
-		ToolListDomains:      t.registerListDomainsTool,
-		ToolListDataProducts: t.registerListDataProductsTool,
-		ToolGetDataProduct:   t.registerGetDataProductTool,
-		ToolListConnections:  t.registerListConnectionsTool,
+		ToolSearch:            t.registerSearchTool,
+		ToolGetEntity:         t.registerGetEntityTool,
+		ToolGetSchema:         t.registerGetSchemaTool,
+		ToolGetLineage:        t.registerGetLineageTool,
+		ToolGetColumnLineage:  t.registerGetColumnLineageTool,
+		ToolGetQueries:        t.registerGetQueriesTool,
+		ToolGetGlossaryTerm:   t.registerGetGlossaryTermTool,
+		ToolListTags:          t.registerListTagsTool,
+		ToolListDomains:       t.registerListDomainsTool,
+		ToolListDataProducts:  t.registerListDataProductsTool,
+		ToolGetDataProduct:    t.registerGetDataProductTool,
+		ToolGetDatasetProfile: t.registerGetDatasetProfileTool,
+		ToolGetUsageStats:     t.registerGetUsageStatsTool,
+		ToolGetAssertions:     t.registerGetAssertionsTool,
+		ToolListIncidents:     t.registerListIncidentsTool,
+		ToolGetDataContract:   t.registerGetDataContractTool,
+		ToolBrowse:            t.registerBrowseTool,
+		ToolGetContainer:      t.registerGetContainerTool,
+		ToolGetDashboard:      t.registerGetDashboardTool,
+		ToolGetChart:          t.registerGetChartTool,
+		ToolGetDataJob:        t.registerGetDataJobTool,
+		ToolGetDataFlow:       t.registerGetDataFlowTool,
+		ToolGetUser:           t.registerGetUserTool,
+		ToolGetGroup:          t.registerGetGroupTool,
+		ToolListOwnedEntities: t.registerListOwnedEntitiesTool,
+		ToolListGlossary:      t.registerListGlossaryTool,
+		ToolImpactAnalysis:    t.registerImpactAnalysisTool,
+		ToolTraceColumn:       t.registerTraceColumnTool,
+		ToolFindLineagePath:   t.registerFindLineagePathTool,
+		ToolCheckFreshness:    t.registerCheckFreshnessTool,
+		ToolFederatedSearch:   t.registerFederatedSearchTool,
+		ToolListConnections:   t.registerListConnectionsTool,
 		// Write tools
 		ToolUpdateDescription:  t.registerUpdateDescriptionTool,
 		ToolAddTag:             t.registerAddTagTool,
@@ -182,6 +209,10 @@ func (t *Toolkit) toolRegistry() map[ToolName]toolRegistrar {
 		ToolRemoveGlossaryTerm: t.registerRemoveGlossaryTermTool,
 		ToolAddLink:            t.registerAddLinkTool,
 		ToolRemoveLink:         t.registerRemoveLinkTool,
+		ToolRaiseIncident:      t.registerRaiseIncidentTool,
+		ToolResolveIncident:    t.registerResolveIncidentTool,
+		ToolAddLineage:         t.registerAddLineageTool,
+		ToolRemoveLineage:      t.registerRemoveLineageTool,
 	}
 }
 
@@ -194,9 +225,17 @@ func (t *Toolkit) registerTool(server *mcp.Server, name ToolName, cfg *toolConfi
 	if register, ok := t.toolRegistry()[name]; ok {
 		register(server, cfg)
 		t.registeredTools[name] = true
+		if t.manager != nil && slices.Contains(WriteTools(), name) {
+			t.manager.RejectWriteConnections(false)
+		}
 	}
 }
 
+// hasWriteTools returns true if any write tool has been registered.
+func (t *Toolkit) hasWriteTools() bool {
+	return slices.ContainsFunc(WriteTools(), func(name ToolName) bool { return t.registeredTools[name] })
+}
+
 // wrapHandler wraps a handler with middleware support.
 func (t *Toolkit) wrapHandler(
 	name ToolName,
@@ -310,16 +349,41 @@ func (t *Toolkit) HasQueryProvider() bool {
 	return t.queryProvider != nil
 }
 
-// isWriteEnabled returns true if write operations are enabled in the toolkit config.
+// isWriteEnabled returns true if write operations are enabled for at least one connection.
+// In multi-server mode, per-connection WriteEnabled overrides are taken into account.
 func (t *Toolkit) isWriteEnabled() bool {
+	if t.manager != nil {
+		return t.manager.AnyWriteEnabled(t.config.WriteEnabled)
+	}
 	return t.config.WriteEnabled
 }
 
+// isConnectionWriteEnabled returns true if write operations are enabled for the given connection.
+// Connections without an explicit override inherit the toolkit-level WriteEnabled setting.
+func (t *Toolkit) isConnectionWriteEnabled(connection string) bool {
+	if t.manager != nil {
+		return t.manager.WriteEnabled(connection, t.config.WriteEnabled)
+	}
+	return t.config.WriteEnabled
+}
+
+// connectionName resolves an empty connection parameter to the default connection's name.
+func (t *Toolkit) connectionName(connection string) string {
+	if connection != "" {
+		return connection
+	}
+	if t.manager != nil {
+		return t.manager.Config().Default
+	}
+	return "default"
+}
+
 // getWriteClient returns the DataHub client for write operations.
-// Returns ErrWriteDisabled if write operations are not enabled.
+// Returns ErrWriteDisabled, naming the connection, if write operations are
+// not enabled for it.
 func (t *Toolkit) getWriteClient(connection string) (DataHubClient, error) {
-	if !t.isWriteEnabled() {
-		return nil, client.ErrWriteDisabled
+	if !t.isConnectionWriteEnabled(connection) {
+		return nil, fmt.Errorf("connection %q: %w", t.connectionName(connection), client.ErrWriteDisabled)
 	}
 	return t.getClient(connection)
 }
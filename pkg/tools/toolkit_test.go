@@ -3,6 +3,7 @@ package tools
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
@@ -14,26 +15,48 @@ import (
 
 // mockClient implements DataHubClient for testing.
 type mockClient struct {
-	searchFunc             func(ctx context.Context, query string, opts ...client.SearchOption) (*types.SearchResult, error)
-	getEntityFunc          func(ctx context.Context, urn string) (*types.Entity, error)
-	getSchemaFunc          func(ctx context.Context, urn string) (*types.SchemaMetadata, error)
-	getSchemasFunc         func(ctx context.Context, urns []string) (map[string]*types.SchemaMetadata, error)
-	getLineageFunc         func(ctx context.Context, urn string, opts ...client.LineageOption) (*types.LineageResult, error)
-	getColumnLineageFunc   func(ctx context.Context, urn string) (*types.ColumnLineage, error)
-	getQueriesFunc         func(ctx context.Context, urn string) (*types.QueryList, error)
-	getGlossaryTermFunc    func(ctx context.Context, urn string) (*types.GlossaryTerm, error)
-	listTagsFunc           func(ctx context.Context, filter string) ([]types.Tag, error)
-	listDomainsFunc        func(ctx context.Context) ([]types.Domain, error)
-	listDataProductsFunc   func(ctx context.Context) ([]types.DataProduct, error)
-	getDataProductFunc     func(ctx context.Context, urn string) (*types.DataProduct, error)
-	pingFunc               func(ctx context.Context) error
-	updateDescriptionFunc  func(ctx context.Context, urn, description string) error
-	addTagFunc             func(ctx context.Context, urn, tagURN string) error
-	removeTagFunc          func(ctx context.Context, urn, tagURN string) error
-	addGlossaryTermFunc    func(ctx context.Context, urn, termURN string) error
-	removeGlossaryTermFunc func(ctx context.Context, urn, termURN string) error
-	addLinkFunc            func(ctx context.Context, urn, linkURL, description string) error
-	removeLinkFunc         func(ctx context.Context, urn, linkURL string) error
+	searchFunc              func(ctx context.Context, query string, opts ...client.SearchOption) (*types.SearchResult, error)
+	getEntityFunc           func(ctx context.Context, urn string) (*types.Entity, error)
+	getSchemaFunc           func(ctx context.Context, urn string) (*types.SchemaMetadata, error)
+	getSchemasFunc          func(ctx context.Context, urns []string) (map[string]*types.SchemaMetadata, error)
+	getLineageFunc          func(ctx context.Context, urn string, opts ...client.LineageOption) (*types.LineageResult, error)
+	getColumnLineageFunc    func(ctx context.Context, urn string) (*types.ColumnLineage, error)
+	getQueriesFunc          func(ctx context.Context, urn string) (*types.QueryList, error)
+	getGlossaryTermFunc     func(ctx context.Context, urn string) (*types.GlossaryTerm, error)
+	listTagsFunc            func(ctx context.Context, filter string) ([]types.Tag, error)
+	listDomainsFunc         func(ctx context.Context) ([]types.Domain, error)
+	listDataProductsFunc    func(ctx context.Context) ([]types.DataProduct, error)
+	getDataProductFunc      func(ctx context.Context, urn string) (*types.DataProduct, error)
+	getDatasetProfileFunc   func(ctx context.Context, urn string, opts ...client.ProfileOption) (*types.DatasetProfile, error)
+	getUsageStatsFunc       func(ctx context.Context, urn string, opts ...client.UsageOption) (*types.UsageStats, error)
+	getAssertionsFunc       func(ctx context.Context, urn string, opts ...client.AssertionOption) ([]types.Assertion, error)
+	listIncidentsFunc       func(ctx context.Context, urn string, opts ...client.IncidentOption) (*types.IncidentList, error)
+	getDataContractFunc     func(ctx context.Context, urn string, opts ...client.AssertionOption) (*types.DataContract, error)
+	getContainerFunc        func(ctx context.Context, urn string) (*types.Container, error)
+	getBrowsePathFunc       func(ctx context.Context, urn string) ([]types.PathEntry, error)
+	browseFunc              func(ctx context.Context, urn string, opts ...client.BrowseOption) (*types.BrowseResult, error)
+	getDashboardFunc        func(ctx context.Context, urn string) (*types.Dashboard, error)
+	getChartFunc            func(ctx context.Context, urn string) (*types.Chart, error)
+	getDataJobFunc          func(ctx context.Context, urn string, opts ...client.DataJobOption) (*types.Pipeline, error)
+	getDataFlowFunc         func(ctx context.Context, urn string) (*types.DataFlow, error)
+	getUserFunc             func(ctx context.Context, urn string) (*types.User, error)
+	getGroupFunc            func(ctx context.Context, urn string) (*types.Group, error)
+	listOwnedEntitiesFunc   func(ctx context.Context, ownerURN string, opts ...client.BrowseOption) (*types.BrowseResult, error)
+	listGlossaryFunc        func(ctx context.Context, parentURN string, opts ...client.BrowseOption) (*types.GlossaryListing, error)
+	traceColumnFunc         func(ctx context.Context, datasetURN, column string, opts ...client.LineageOption) (*types.ColumnTrace, error)
+	getDatasetFreshnessFunc func(ctx context.Context, urn string) (*types.Freshness, error)
+	pingFunc                func(ctx context.Context) error
+	updateDescriptionFunc   func(ctx context.Context, urn, description string) error
+	addTagFunc              func(ctx context.Context, urn, tagURN string) error
+	removeTagFunc           func(ctx context.Context, urn, tagURN string) error
+	addGlossaryTermFunc     func(ctx context.Context, urn, termURN string) error
+	removeGlossaryTermFunc  func(ctx context.Context, urn, termURN string) error
+	addLinkFunc             func(ctx context.Context, urn, linkURL, description string) error
+	removeLinkFunc          func(ctx context.Context, urn, linkURL string) error
+	raiseIncidentFunc       func(ctx context.Context, input client.RaiseIncidentInput) (string, error)
+	resolveIncidentFunc     func(ctx context.Context, urn, message string) error
+	addLineageFunc          func(ctx context.Context, edges ...client.LineageEdgeInput) error
+	removeLineageFunc       func(ctx context.Context, edges ...client.LineageEdgeInput) error
 }
 
 func (m *mockClient) Search(ctx context.Context, query string, opts ...client.SearchOption) (*types.SearchResult, error) {
@@ -120,6 +143,132 @@ func (m *mockClient) GetDataProduct(ctx context.Context, urn string) (*types.Dat
 	return &types.DataProduct{URN: urn}, nil
 }
 
+func (m *mockClient) GetDatasetProfile(ctx context.Context, urn string, opts ...client.ProfileOption) (*types.DatasetProfile, error) {
+	if m.getDatasetProfileFunc != nil {
+		return m.getDatasetProfileFunc(ctx, urn, opts...)
+	}
+	return &types.DatasetProfile{URN: urn}, nil
+}
+
+func (m *mockClient) GetUsageStats(ctx context.Context, urn string, opts ...client.UsageOption) (*types.UsageStats, error) {
+	if m.getUsageStatsFunc != nil {
+		return m.getUsageStatsFunc(ctx, urn, opts...)
+	}
+	return &types.UsageStats{URN: urn}, nil
+}
+
+func (m *mockClient) GetAssertions(ctx context.Context, urn string, opts ...client.AssertionOption) ([]types.Assertion, error) {
+	if m.getAssertionsFunc != nil {
+		return m.getAssertionsFunc(ctx, urn, opts...)
+	}
+	return []types.Assertion{}, nil
+}
+
+func (m *mockClient) ListIncidents(ctx context.Context, urn string, opts ...client.IncidentOption) (*types.IncidentList, error) {
+	if m.listIncidentsFunc != nil {
+		return m.listIncidentsFunc(ctx, urn, opts...)
+	}
+	return &types.IncidentList{URN: urn, Incidents: []types.Incident{}}, nil
+}
+
+func (m *mockClient) GetDataContract(ctx context.Context, urn string, opts ...client.AssertionOption) (*types.DataContract, error) {
+	if m.getDataContractFunc != nil {
+		return m.getDataContractFunc(ctx, urn, opts...)
+	}
+	return &types.DataContract{URN: "urn:li:dataContract:mock", EntityURN: urn, Result: types.DataContractResultUnknown}, nil
+}
+
+func (m *mockClient) GetContainer(ctx context.Context, urn string) (*types.Container, error) {
+	if m.getContainerFunc != nil {
+		return m.getContainerFunc(ctx, urn)
+	}
+	return &types.Container{URN: urn}, nil
+}
+
+func (m *mockClient) GetBrowsePath(ctx context.Context, urn string) ([]types.PathEntry, error) {
+	if m.getBrowsePathFunc != nil {
+		return m.getBrowsePathFunc(ctx, urn)
+	}
+	return []types.PathEntry{}, nil
+}
+
+func (m *mockClient) Browse(ctx context.Context, urn string, opts ...client.BrowseOption) (*types.BrowseResult, error) {
+	if m.browseFunc != nil {
+		return m.browseFunc(ctx, urn, opts...)
+	}
+	return &types.BrowseResult{URN: urn, Entries: []types.BrowseEntry{}}, nil
+}
+
+func (m *mockClient) GetDashboard(ctx context.Context, urn string) (*types.Dashboard, error) {
+	if m.getDashboardFunc != nil {
+		return m.getDashboardFunc(ctx, urn)
+	}
+	return &types.Dashboard{Entity: types.Entity{URN: urn}}, nil
+}
+
+func (m *mockClient) GetChart(ctx context.Context, urn string) (*types.Chart, error) {
+	if m.getChartFunc != nil {
+		return m.getChartFunc(ctx, urn)
+	}
+	return &types.Chart{Entity: types.Entity{URN: urn}}, nil
+}
+
+func (m *mockClient) GetDataJob(ctx context.Context, urn string, opts ...client.DataJobOption) (*types.Pipeline, error) {
+	if m.getDataJobFunc != nil {
+		return m.getDataJobFunc(ctx, urn, opts...)
+	}
+	return &types.Pipeline{Entity: types.Entity{URN: urn}}, nil
+}
+
+func (m *mockClient) GetDataFlow(ctx context.Context, urn string) (*types.DataFlow, error) {
+	if m.getDataFlowFunc != nil {
+		return m.getDataFlowFunc(ctx, urn)
+	}
+	return &types.DataFlow{Entity: types.Entity{URN: urn}}, nil
+}
+
+func (m *mockClient) GetUser(ctx context.Context, urn string) (*types.User, error) {
+	if m.getUserFunc != nil {
+		return m.getUserFunc(ctx, urn)
+	}
+	return &types.User{URN: urn}, nil
+}
+
+func (m *mockClient) GetGroup(ctx context.Context, urn string) (*types.Group, error) {
+	if m.getGroupFunc != nil {
+		return m.getGroupFunc(ctx, urn)
+	}
+	return &types.Group{URN: urn}, nil
+}
+
+func (m *mockClient) ListOwnedEntities(ctx context.Context, ownerURN string, opts ...client.BrowseOption) (*types.BrowseResult, error) {
+	if m.listOwnedEntitiesFunc != nil {
+		return m.listOwnedEntitiesFunc(ctx, ownerURN, opts...)
+	}
+	return &types.BrowseResult{URN: ownerURN, Entries: []types.BrowseEntry{}}, nil
+}
+
+func (m *mockClient) ListGlossary(ctx context.Context, parentURN string, opts ...client.BrowseOption) (*types.GlossaryListing, error) {
+	if m.listGlossaryFunc != nil {
+		return m.listGlossaryFunc(ctx, parentURN, opts...)
+	}
+	return &types.GlossaryListing{Parent: parentURN, Entries: []types.GlossaryEntry{}}, nil
+}
+
+func (m *mockClient) TraceColumn(ctx context.Context, datasetURN, column string, opts ...client.LineageOption) (*types.ColumnTrace, error) {
+	if m.traceColumnFunc != nil {
+		return m.traceColumnFunc(ctx, datasetURN, column, opts...)
+	}
+	return &types.ColumnTrace{DatasetURN: datasetURN, Column: column, Hops: []types.ColumnHop{}, Endpoints: []types.ColumnRef{}}, nil
+}
+
+func (m *mockClient) GetDatasetFreshness(ctx context.Context, urn string) (*types.Freshness, error) {
+	if m.getDatasetFreshnessFunc != nil {
+		return m.getDatasetFreshnessFunc(ctx, urn)
+	}
+	return &types.Freshness{URN: urn}, nil
+}
+
 func (m *mockClient) Ping(ctx context.Context) error {
 	if m.pingFunc != nil {
 		return m.pingFunc(ctx)
@@ -180,6 +329,45 @@ func (m *mockClient) RemoveLink(ctx context.Context, urn, linkURL string) error
 	return nil
 }
 
+func (m *mockClient) RaiseIncident(ctx context.Context, input client.RaiseIncidentInput) (string, error) {
+	if m.raiseIncidentFunc != nil {
+		return m.raiseIncidentFunc(ctx, input)
+	}
+	return "urn:li:incident:mock", nil
+}
+
+func (m *mockClient) ResolveIncident(ctx context.Context, urn, message string) error {
+	if m.resolveIncidentFunc != nil {
+		return m.resolveIncidentFunc(ctx, urn, message)
+	}
+	return nil
+}
+
+func (m *mockClient) AddLineage(ctx context.Context, edges ...client.LineageEdgeInput) error {
+	if m.addLineageFunc != nil {
+		return m.addLineageFunc(ctx, edges...)
+	}
+	return nil
+}
+
+func (m *mockClient) RemoveLineage(ctx context.Context, edges ...client.LineageEdgeInput) error {
+	if m.removeLineageFunc != nil {
+		return m.removeLineageFunc(ctx, edges...)
+	}
+	return nil
+}
+
+// resultText returns the text of the first content item of a tool result.
+func resultText(result *mcp.CallToolResult) string {
+	if result == nil || len(result.Content) == 0 {
+		return ""
+	}
+	if tc, ok := result.Content[0].(*mcp.TextContent); ok {
+		return tc.Text
+	}
+	return ""
+}
+
 func TestNewToolkit(t *testing.T) {
 	mock := &mockClient{}
 	cfg := DefaultConfig()
@@ -793,6 +981,40 @@ func TestToolkitRegisterAll_MultiServer(t *testing.T) {
 	}
 }
 
+func TestToolkitRegisterAll_WriteConnectionAddedLater(t *testing.T) {
+	mgr := multiserver.NewManager(multiserver.Config{
+		Default: "prod",
+		Primary: client.Config{URL: "https://prod.datahub.example.com", Token: "prod-token"},
+	})
+	defer func() {
+		_ = mgr.Close()
+	}()
+
+	toolkit := NewToolkitWithManager(mgr, DefaultConfig())
+	server := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "1.0.0"}, nil)
+	toolkit.RegisterAll(server)
+
+	enabled := true
+	tenant := multiserver.ConnectionConfig{URL: "https://tenant-a.datahub.example.com", WriteEnabled: &enabled}
+
+	// No write tools were registered, so a write-enabled connection is rejected.
+	if err := mgr.AddConnection("tenant-a", tenant); !errors.Is(err, multiserver.ErrWriteToolsUnavailable) {
+		t.Fatalf("AddConnection() error = %v, want ErrWriteToolsUnavailable", err)
+	}
+
+	// Registering the write tools explicitly allows it, gated per connection at call time.
+	toolkit.Register(server, WriteTools()...)
+	if err := mgr.AddConnection("tenant-a", tenant); err != nil {
+		t.Fatalf("AddConnection() after registering write tools: %v", err)
+	}
+	if _, err := toolkit.getWriteClient("tenant-a"); err != nil {
+		t.Errorf("getWriteClient(tenant-a) unexpected error: %v", err)
+	}
+	if _, err := toolkit.getWriteClient(""); !errors.Is(err, client.ErrWriteDisabled) {
+		t.Errorf("getWriteClient(default) error = %v, want ErrWriteDisabled", err)
+	}
+}
+
 func TestToolkitRegisterAll_WriteEnabled(t *testing.T) {
 	mock := &mockClient{}
 	toolkit := NewToolkit(mock, Config{WriteEnabled: true})
@@ -842,10 +1064,54 @@ func TestToolkitGetWriteClient_Enabled(t *testing.T) {
 	}
 }
 
+func newWriteGatedManager(t *testing.T) *multiserver.Manager {
+	t.Helper()
+	enabled := true
+	mgr := multiserver.NewManager(multiserver.Config{
+		Default: "prod",
+		Primary: client.Config{URL: "https://prod.example.com", Token: "token"},
+		Connections: map[string]multiserver.ConnectionConfig{
+			"staging": {URL: "https://staging.example.com", WriteEnabled: &enabled},
+		},
+	})
+	t.Cleanup(func() { _ = mgr.Close() })
+	return mgr
+}
+
+func TestToolkitGetWriteClient_PerConnection(t *testing.T) {
+	toolkit := NewToolkitWithManager(newWriteGatedManager(t), DefaultConfig())
+
+	if _, err := toolkit.getWriteClient("staging"); err != nil {
+		t.Errorf("expected writes allowed on staging, got: %v", err)
+	}
+
+	_, err := toolkit.getWriteClient("")
+	if !errors.Is(err, client.ErrWriteDisabled) {
+		t.Fatalf("expected ErrWriteDisabled for default connection, got: %v", err)
+	}
+	if !strings.Contains(err.Error(), `"prod"`) {
+		t.Errorf("error should name the connection, got: %v", err)
+	}
+}
+
+func TestToolkitRegisterAll_PerConnectionWriteEnabled(t *testing.T) {
+	toolkit := NewToolkitWithManager(newWriteGatedManager(t), DefaultConfig())
+
+	impl := &mcp.Implementation{Name: "test", Version: "1.0.0"}
+	server := mcp.NewServer(impl, nil)
+	toolkit.RegisterAll(server)
+
+	for _, name := range WriteTools() {
+		if !toolkit.registeredTools[name] {
+			t.Errorf("RegisterAll() should register write tool %s when a connection enables writes", name)
+		}
+	}
+}
+
 func TestWriteTools(t *testing.T) {
 	wt := WriteTools()
-	if len(wt) != 7 {
-		t.Errorf("expected 7 write tools, got %d", len(wt))
+	if len(wt) != 11 {
+		t.Errorf("expected 11 write tools, got %d", len(wt))
 	}
 
 	expected := map[ToolName]bool{
@@ -856,6 +1122,10 @@ func TestWriteTools(t *testing.T) {
 		ToolRemoveGlossaryTerm: true,
 		ToolAddLink:            true,
 		ToolRemoveLink:         true,
+		ToolRaiseIncident:      true,
+		ToolResolveIncident:    true,
+		ToolAddLineage:         true,
+		ToolRemoveLineage:      true,
 	}
 	for _, name := range wt {
 		if !expected[name] {
@@ -866,8 +1136,8 @@ func TestWriteTools(t *testing.T) {
 
 func TestAllToolsUnchanged(t *testing.T) {
 	at := AllTools()
-	if len(at) != 12 {
-		t.Errorf("AllTools() should return 12 tools (backward compat), got %d", len(at))
+	if len(at) != 32 {
+		t.Errorf("AllTools() should return 32 tools (backward compat), got %d", len(at))
 	}
 
 	// Verify no write tools in AllTools
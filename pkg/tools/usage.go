@@ -0,0 +1,91 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+)
+
+// usageRanges are the accepted values of GetUsageStatsInput.Range.
+var usageRanges = []string{
+	client.UsageRangeDay,
+	client.UsageRangeWeek,
+	client.UsageRangeMonth,
+	client.UsageRangeQuarter,
+	client.UsageRangeYear,
+	client.UsageRangeAll,
+}
+
+// GetUsageStatsInput is the input for the get_usage_stats tool.
+type GetUsageStatsInput struct {
+	URN      string `json:"urn" jsonschema_description:"The DataHub URN of the dataset"`
+	Range    string `json:"range,omitempty" jsonschema_description:"Usage window: DAY, WEEK, MONTH, QUARTER, YEAR or ALL (default: MONTH)"`
+	TopUsers int    `json:"top_users,omitempty" jsonschema_description:"Maximum number of top users to return (default: 10)"`
+	// Connection is the named connection to use. Empty uses the default connection.
+	Connection string `json:"connection,omitempty" jsonschema_description:"Named connection to use (see datahub_list_connections)"`
+}
+
+func (t *Toolkit) registerGetUsageStatsTool(server *mcp.Server, cfg *toolConfig) {
+	baseHandler := func(ctx context.Context, req *mcp.CallToolRequest, input any) (*mcp.CallToolResult, any, error) {
+		usageInput, ok := input.(GetUsageStatsInput)
+		if !ok {
+			return ErrorResult("internal error: invalid input type"), nil, nil
+		}
+		return t.handleGetUsageStats(ctx, req, usageInput)
+	}
+
+	wrappedHandler := t.wrapHandler(ToolGetUsageStats, baseHandler, cfg)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:         string(ToolGetUsageStats),
+		Description:  t.getDescription(ToolGetUsageStats, cfg),
+		Annotations:  t.getAnnotations(ToolGetUsageStats, cfg),
+		Icons:        t.getIcons(ToolGetUsageStats, cfg),
+		Title:        t.getTitle(ToolGetUsageStats, cfg),
+		OutputSchema: t.getOutputSchema(ToolGetUsageStats, cfg),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, input GetUsageStatsInput) (*mcp.CallToolResult, any, error) {
+		return wrappedHandler(ctx, req, input)
+	})
+}
+
+func (t *Toolkit) handleGetUsageStats(
+	ctx context.Context,
+	_ *mcp.CallToolRequest,
+	input GetUsageStatsInput,
+) (*mcp.CallToolResult, any, error) {
+	if input.URN == "" {
+		return ErrorResult("urn parameter is required"), nil, nil
+	}
+
+	var opts []client.UsageOption
+	if input.Range != "" {
+		timeRange := strings.ToUpper(input.Range)
+		if !slices.Contains(usageRanges, timeRange) {
+			return ErrorResult("range must be one of " + strings.Join(usageRanges, ", ")), nil, nil
+		}
+		opts = append(opts, client.WithUsageRange(timeRange))
+	}
+	if input.TopUsers > 0 {
+		opts = append(opts, client.WithTopUsers(input.TopUsers))
+	}
+
+	datahubClient, err := t.getClient(input.Connection)
+	if err != nil {
+		return ErrorResult("Connection error: " + err.Error()), nil, nil
+	}
+
+	stats, err := datahubClient.GetUsageStats(ctx, input.URN, opts...)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return ErrorResult("Dataset not found: " + input.URN), nil, nil
+		}
+		return ErrorResult(err.Error()), nil, nil
+	}
+
+	return formatJSONResult(stats)
+}
@@ -0,0 +1,95 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+func TestHandleGetUsageStats(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      GetUsageStatsInput
+		mockErr    error
+		wantErr    bool
+		wantErrMsg string
+		wantOpts   int
+	}{
+		{
+			name:  "default window",
+			input: GetUsageStatsInput{URN: "urn:li:dataset:test"},
+		},
+		{
+			name:     "range and top users",
+			input:    GetUsageStatsInput{URN: "urn:li:dataset:test", Range: "week", TopUsers: 5},
+			wantOpts: 2,
+		},
+		{
+			name:       "empty URN",
+			input:      GetUsageStatsInput{},
+			wantErr:    true,
+			wantErrMsg: "urn parameter is required",
+		},
+		{
+			name:       "invalid range",
+			input:      GetUsageStatsInput{URN: "urn:li:dataset:test", Range: "FORTNIGHT"},
+			wantErr:    true,
+			wantErrMsg: "range must be one of",
+		},
+		{
+			name:       "dataset not found",
+			input:      GetUsageStatsInput{URN: "urn:li:dataset:missing"},
+			mockErr:    fmt.Errorf("GetUsageStats: %w", client.ErrNotFound),
+			wantErr:    true,
+			wantErrMsg: "Dataset not found",
+		},
+		{
+			name:    "client error",
+			input:   GetUsageStatsInput{URN: "urn:li:dataset:test"},
+			mockErr: errors.New("usage not configured"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotOpts int
+			mock := &mockClient{
+				getUsageStatsFunc: func(_ context.Context, urn string, opts ...client.UsageOption) (*types.UsageStats, error) {
+					gotOpts = len(opts)
+					if tt.mockErr != nil {
+						return nil, tt.mockErr
+					}
+					return &types.UsageStats{URN: urn, TotalQueries: 7}, nil
+				},
+			}
+			toolkit := NewToolkit(mock, DefaultConfig())
+
+			result, out, err := toolkit.handleGetUsageStats(context.Background(), nil, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.IsError != tt.wantErr {
+				t.Fatalf("IsError = %v, want %v (%s)", result.IsError, tt.wantErr, resultText(result))
+			}
+			if tt.wantErr {
+				if tt.wantErrMsg != "" && !strings.Contains(resultText(result), tt.wantErrMsg) {
+					t.Errorf("error %q does not mention %q", resultText(result), tt.wantErrMsg)
+				}
+				return
+			}
+			if gotOpts != tt.wantOpts {
+				t.Errorf("passed %d options, want %d", gotOpts, tt.wantOpts)
+			}
+			stats, ok := out.(*types.UsageStats)
+			if !ok || stats.TotalQueries != 7 {
+				t.Errorf("unexpected output: %#v", out)
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+)
+
+// GetUserInput is the input for the get_user tool.
+type GetUserInput struct {
+	URN string `json:"urn" jsonschema_description:"The user URN (urn:li:corpuser:jdoe) or username"`
+	// Connection is the named connection to use. Empty uses the default connection.
+	Connection string `json:"connection,omitempty" jsonschema_description:"Named connection to use (see datahub_list_connections)"`
+}
+
+// GetGroupInput is the input for the get_group tool.
+type GetGroupInput struct {
+	URN string `json:"urn" jsonschema_description:"The group URN (urn:li:corpGroup:data-eng) or group name"`
+	// Connection is the named connection to use. Empty uses the default connection.
+	Connection string `json:"connection,omitempty" jsonschema_description:"Named connection to use (see datahub_list_connections)"`
+}
+
+// ListOwnedEntitiesInput is the input for the list_owned_entities tool.
+type ListOwnedEntitiesInput struct {
+	URN    string `json:"urn" jsonschema_description:"The URN of the owning user (urn:li:corpuser:...) or group (urn:li:corpGroup:...)"`
+	Limit  int    `json:"limit,omitempty" jsonschema_description:"Maximum number of entities (default: 10, max: 100)"`
+	Offset int    `json:"offset,omitempty" jsonschema_description:"Result offset for pagination"`
+	// Connection is the named connection to use. Empty uses the default connection.
+	Connection string `json:"connection,omitempty" jsonschema_description:"Named connection to use (see datahub_list_connections)"`
+}
+
+func (t *Toolkit) registerGetUserTool(server *mcp.Server, cfg *toolConfig) {
+	baseHandler := func(ctx context.Context, req *mcp.CallToolRequest, input any) (*mcp.CallToolResult, any, error) {
+		userInput, ok := input.(GetUserInput)
+		if !ok {
+			return ErrorResult("internal error: invalid input type"), nil, nil
+		}
+		return t.handleGetUser(ctx, req, userInput)
+	}
+
+	wrappedHandler := t.wrapHandler(ToolGetUser, baseHandler, cfg)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:         string(ToolGetUser),
+		Description:  t.getDescription(ToolGetUser, cfg),
+		Annotations:  t.getAnnotations(ToolGetUser, cfg),
+		Icons:        t.getIcons(ToolGetUser, cfg),
+		Title:        t.getTitle(ToolGetUser, cfg),
+		OutputSchema: t.getOutputSchema(ToolGetUser, cfg),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, input GetUserInput) (*mcp.CallToolResult, any, error) {
+		return wrappedHandler(ctx, req, input)
+	})
+}
+
+func (t *Toolkit) registerGetGroupTool(server *mcp.Server, cfg *toolConfig) {
+	baseHandler := func(ctx context.Context, req *mcp.CallToolRequest, input any) (*mcp.CallToolResult, any, error) {
+		groupInput, ok := input.(GetGroupInput)
+		if !ok {
+			return ErrorResult("internal error: invalid input type"), nil, nil
+		}
+		return t.handleGetGroup(ctx, req, groupInput)
+	}
+
+	wrappedHandler := t.wrapHandler(ToolGetGroup, baseHandler, cfg)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:         string(ToolGetGroup),
+		Description:  t.getDescription(ToolGetGroup, cfg),
+		Annotations:  t.getAnnotations(ToolGetGroup, cfg),
+		Icons:        t.getIcons(ToolGetGroup, cfg),
+		Title:        t.getTitle(ToolGetGroup, cfg),
+		OutputSchema: t.getOutputSchema(ToolGetGroup, cfg),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, input GetGroupInput) (*mcp.CallToolResult, any, error) {
+		return wrappedHandler(ctx, req, input)
+	})
+}
+
+func (t *Toolkit) registerListOwnedEntitiesTool(server *mcp.Server, cfg *toolConfig) {
+	baseHandler := func(ctx context.Context, req *mcp.CallToolRequest, input any) (*mcp.CallToolResult, any, error) {
+		ownedInput, ok := input.(ListOwnedEntitiesInput)
+		if !ok {
+			return ErrorResult("internal error: invalid input type"), nil, nil
+		}
+		return t.handleListOwnedEntities(ctx, req, ownedInput)
+	}
+
+	wrappedHandler := t.wrapHandler(ToolListOwnedEntities, baseHandler, cfg)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:         string(ToolListOwnedEntities),
+		Description:  t.getDescription(ToolListOwnedEntities, cfg),
+		Annotations:  t.getAnnotations(ToolListOwnedEntities, cfg),
+		Icons:        t.getIcons(ToolListOwnedEntities, cfg),
+		Title:        t.getTitle(ToolListOwnedEntities, cfg),
+		OutputSchema: t.getOutputSchema(ToolListOwnedEntities, cfg),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, input ListOwnedEntitiesInput) (*mcp.CallToolResult, any, error) {
+		return wrappedHandler(ctx, req, input)
+	})
+}
+
+func (t *Toolkit) handleGetUser(ctx context.Context, _ *mcp.CallToolRequest, input GetUserInput) (*mcp.CallToolResult, any, error) {
+	if input.URN == "" {
+		return ErrorResult("urn parameter is required"), nil, nil
+	}
+	urn := input.URN
+	if !strings.HasPrefix(urn, "urn:") {
+		urn = client.BuildUserURN(urn)
+	}
+
+	datahubClient, err := t.getClient(input.Connection)
+	if err != nil {
+		return ErrorResult("Connection error: " + err.Error()), nil, nil
+	}
+
+	user, err := datahubClient.GetUser(ctx, urn)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return ErrorResult("User not found: " + urn), nil, nil
+		}
+		return ErrorResult(err.Error()), nil, nil
+	}
+
+	owned, err := datahubClient.ListOwnedEntities(ctx, urn)
+	if err != nil {
+		return ErrorResult(err.Error()), nil, nil
+	}
+
+	return formatJSONResult(GetUserOutput{User: *user, Owned: owned})
+}
+
+func (t *Toolkit) handleGetGroup(ctx context.Context, _ *mcp.CallToolRequest, input GetGroupInput) (*mcp.CallToolResult, any, error) {
+	if input.URN == "" {
+		return ErrorResult("urn parameter is required"), nil, nil
+	}
+	urn := input.URN
+	if !strings.HasPrefix(urn, "urn:") {
+		urn = client.BuildGroupURN(urn)
+	}
+
+	datahubClient, err := t.getClient(input.Connection)
+	if err != nil {
+		return ErrorResult("Connection error: " + err.Error()), nil, nil
+	}
+
+	group, err := datahubClient.GetGroup(ctx, urn)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return ErrorResult("Group not found: " + urn), nil, nil
+		}
+		return ErrorResult(err.Error()), nil, nil
+	}
+
+	owned, err := datahubClient.ListOwnedEntities(ctx, urn)
+	if err != nil {
+		return ErrorResult(err.Error()), nil, nil
+	}
+
+	return formatJSONResult(GetGroupOutput{Group: *group, Owned: owned})
+}
+
+func (t *Toolkit) handleListOwnedEntities(
+	ctx context.Context,
+	_ *mcp.CallToolRequest,
+	input ListOwnedEntitiesInput,
+) (*mcp.CallToolResult, any, error) {
+	if input.URN == "" {
+		return ErrorResult("urn parameter is required"), nil, nil
+	}
+
+	var opts []client.BrowseOption
+	if input.Limit > 0 {
+		opts = append(opts, client.WithBrowseLimit(input.Limit))
+	}
+	if input.Offset > 0 {
+		opts = append(opts, client.WithBrowseOffset(input.Offset))
+	}
+
+	datahubClient, err := t.getClient(input.Connection)
+	if err != nil {
+		return ErrorResult("Connection error: " + err.Error()), nil, nil
+	}
+
+	result, err := datahubClient.ListOwnedEntities(ctx, input.URN, opts...)
+	if err != nil {
+		return ErrorResult(err.Error()), nil, nil
+	}
+
+	return formatJSONResult(result)
+}
@@ -0,0 +1,182 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+func TestHandleGetUser(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      GetUserInput
+		wantURN    string
+		mockErr    error
+		ownedErr   error
+		wantErr    bool
+		wantErrMsg string
+	}{
+		{name: "by URN", input: GetUserInput{URN: "urn:li:corpuser:jdoe"}, wantURN: "urn:li:corpuser:jdoe"},
+		{name: "by username", input: GetUserInput{URN: "jdoe"}, wantURN: "urn:li:corpuser:jdoe"},
+		{name: "empty URN", input: GetUserInput{}, wantErr: true, wantErrMsg: "urn parameter is required"},
+		{
+			name:       "not found",
+			input:      GetUserInput{URN: "missing"},
+			mockErr:    fmt.Errorf("GetUser: %w", client.ErrNotFound),
+			wantErr:    true,
+			wantErrMsg: "User not found: urn:li:corpuser:missing",
+		},
+		{name: "owned entities error", input: GetUserInput{URN: "jdoe"}, ownedErr: errors.New("search failed"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotURN, ownedURN string
+			mock := &mockClient{
+				getUserFunc: func(_ context.Context, urn string) (*types.User, error) {
+					gotURN = urn
+					if tt.mockErr != nil {
+						return nil, tt.mockErr
+					}
+					return &types.User{URN: urn, DisplayName: "Jane Doe"}, nil
+				},
+				listOwnedEntitiesFunc: func(_ context.Context, ownerURN string, _ ...client.BrowseOption) (*types.BrowseResult, error) {
+					ownedURN = ownerURN
+					if tt.ownedErr != nil {
+						return nil, tt.ownedErr
+					}
+					return &types.BrowseResult{URN: ownerURN, Total: 1, Entries: []types.BrowseEntry{{URN: "urn:li:dataset:x"}}}, nil
+				},
+			}
+			toolkit := NewToolkit(mock, DefaultConfig())
+
+			result, out, err := toolkit.handleGetUser(context.Background(), nil, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.IsError != tt.wantErr {
+				t.Fatalf("IsError = %v, want %v (%s)", result.IsError, tt.wantErr, resultText(result))
+			}
+			if tt.wantErr {
+				if tt.wantErrMsg != "" && !strings.Contains(resultText(result), tt.wantErrMsg) {
+					t.Errorf("error %q does not mention %q", resultText(result), tt.wantErrMsg)
+				}
+				return
+			}
+			if gotURN != tt.wantURN || ownedURN != tt.wantURN {
+				t.Errorf("looked up %q and %q, want %q", gotURN, ownedURN, tt.wantURN)
+			}
+			output, ok := out.(GetUserOutput)
+			if !ok || output.DisplayName != "Jane Doe" || output.Owned == nil || output.Owned.Total != 1 {
+				t.Errorf("unexpected output: %#v", out)
+			}
+		})
+	}
+}
+
+func TestHandleGetGroup(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      GetGroupInput
+		wantURN    string
+		mockErr    error
+		wantErr    bool
+		wantErrMsg string
+	}{
+		{name: "by URN", input: GetGroupInput{URN: "urn:li:corpGroup:data-eng"}, wantURN: "urn:li:corpGroup:data-eng"},
+		{name: "by name", input: GetGroupInput{URN: "data-eng"}, wantURN: "urn:li:corpGroup:data-eng"},
+		{name: "empty URN", input: GetGroupInput{}, wantErr: true, wantErrMsg: "urn parameter is required"},
+		{
+			name:       "not found",
+			input:      GetGroupInput{URN: "missing"},
+			mockErr:    fmt.Errorf("GetGroup: %w", client.ErrNotFound),
+			wantErr:    true,
+			wantErrMsg: "Group not found",
+		},
+		{name: "client error", input: GetGroupInput{URN: "data-eng"}, mockErr: errors.New("boom"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotURN string
+			mock := &mockClient{
+				getGroupFunc: func(_ context.Context, urn string) (*types.Group, error) {
+					gotURN = urn
+					if tt.mockErr != nil {
+						return nil, tt.mockErr
+					}
+					return &types.Group{URN: urn, TotalMembers: 1, Members: []types.User{{URN: "urn:li:corpuser:jdoe"}}}, nil
+				},
+			}
+			toolkit := NewToolkit(mock, DefaultConfig())
+
+			result, out, err := toolkit.handleGetGroup(context.Background(), nil, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.IsError != tt.wantErr {
+				t.Fatalf("IsError = %v, want %v (%s)", result.IsError, tt.wantErr, resultText(result))
+			}
+			if tt.wantErr {
+				if tt.wantErrMsg != "" && !strings.Contains(resultText(result), tt.wantErrMsg) {
+					t.Errorf("error %q does not mention %q", resultText(result), tt.wantErrMsg)
+				}
+				return
+			}
+			if gotURN != tt.wantURN {
+				t.Errorf("looked up %q, want %q", gotURN, tt.wantURN)
+			}
+			output, ok := out.(GetGroupOutput)
+			if !ok || len(output.Members) != 1 || output.Owned == nil {
+				t.Errorf("unexpected output: %#v", out)
+			}
+		})
+	}
+}
+
+func TestHandleListOwnedEntities(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    ListOwnedEntitiesInput
+		mockErr  error
+		wantErr  bool
+		wantOpts int
+	}{
+		{name: "defaults", input: ListOwnedEntitiesInput{URN: "urn:li:corpuser:jdoe"}},
+		{name: "paging", input: ListOwnedEntitiesInput{URN: "urn:li:corpuser:jdoe", Limit: 20, Offset: 20}, wantOpts: 2},
+		{name: "empty URN", input: ListOwnedEntitiesInput{}, wantErr: true},
+		{name: "client error", input: ListOwnedEntitiesInput{URN: "urn:li:corpuser:jdoe"}, mockErr: errors.New("boom"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotOpts int
+			mock := &mockClient{
+				listOwnedEntitiesFunc: func(_ context.Context, ownerURN string, opts ...client.BrowseOption) (*types.BrowseResult, error) {
+					gotOpts = len(opts)
+					if tt.mockErr != nil {
+						return nil, tt.mockErr
+					}
+					return &types.BrowseResult{URN: ownerURN, Entries: []types.BrowseEntry{}}, nil
+				},
+			}
+			toolkit := NewToolkit(mock, DefaultConfig())
+
+			result, _, err := toolkit.handleListOwnedEntities(context.Background(), nil, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.IsError != tt.wantErr {
+				t.Fatalf("IsError = %v, want %v (%s)", result.IsError, tt.wantErr, resultText(result))
+			}
+			if !tt.wantErr && gotOpts != tt.wantOpts {
+				t.Errorf("passed %d options, want %d", gotOpts, tt.wantOpts)
+			}
+		})
+	}
+}
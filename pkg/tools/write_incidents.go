@@ -0,0 +1,180 @@
+package tools
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+	"github.com/txn2/mcp-datahub/pkg/types"
+)
+
+// incidentTypes are the accepted values of RaiseIncidentInput.Type.
+var incidentTypes = []string{"OPERATIONAL", "FRESHNESS", "VOLUME", "FIELD", "SQL", "DATA_SCHEMA", "CUSTOM"}
+
+// incidentPriorities are the accepted values of RaiseIncidentInput.Priority.
+var incidentPriorities = []string{"CRITICAL", "HIGH", "MEDIUM", "LOW"}
+
+// RaiseIncidentInput is the input for the raise_incident tool.
+type RaiseIncidentInput struct {
+	URN         string `json:"urn" jsonschema_description:"The DataHub URN of the entity the incident affects"`
+	Title       string `json:"title" jsonschema_description:"Short summary of the incident"`
+	Description string `json:"description,omitempty" jsonschema_description:"Details of what is broken and its impact"`
+	// Type defaults to OPERATIONAL.
+	Type       string   `json:"type,omitempty" jsonschema_description:"OPERATIONAL, FRESHNESS, VOLUME, FIELD, SQL, DATA_SCHEMA or CUSTOM"`
+	CustomType string   `json:"custom_type,omitempty" jsonschema_description:"Name of the incident type when type is CUSTOM"`
+	Priority   string   `json:"priority,omitempty" jsonschema_description:"Priority: CRITICAL, HIGH, MEDIUM or LOW"`
+	Assignees  []string `json:"assignees,omitempty" jsonschema_description:"User or group URNs to assign the incident to"`
+	Connection string   `json:"connection,omitempty" jsonschema_description:"Named connection to use (see datahub_list_connections)"`
+}
+
+// ResolveIncidentInput is the input for the resolve_incident tool.
+type ResolveIncidentInput struct {
+	IncidentURN string `json:"incident_urn" jsonschema_description:"The URN of the incident to resolve"`
+	Message     string `json:"message,omitempty" jsonschema_description:"Resolution note describing the fix"`
+	Connection  string `json:"connection,omitempty" jsonschema_description:"Named connection to use (see datahub_list_connections)"`
+}
+
+func (t *Toolkit) registerRaiseIncidentTool(server *mcp.Server, cfg *toolConfig) {
+	baseHandler := func(ctx context.Context, req *mcp.CallToolRequest, input any) (*mcp.CallToolResult, any, error) {
+		incidentInput, ok := input.(RaiseIncidentInput)
+		if !ok {
+			return ErrorResult("internal error: invalid input type"), nil, nil
+		}
+		return t.handleRaiseIncident(ctx, req, incidentInput)
+	}
+
+	wrappedHandler := t.wrapHandler(ToolRaiseIncident, baseHandler, cfg)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:         string(ToolRaiseIncident),
+		Description:  t.getDescription(ToolRaiseIncident, cfg),
+		Annotations:  t.getAnnotations(ToolRaiseIncident, cfg),
+		Icons:        t.getIcons(ToolRaiseIncident, cfg),
+		Title:        t.getTitle(ToolRaiseIncident, cfg),
+		OutputSchema: t.getOutputSchema(ToolRaiseIncident, cfg),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, input RaiseIncidentInput) (*mcp.CallToolResult, *RaiseIncidentOutput, error) {
+		result, out, err := wrappedHandler(ctx, req, input)
+		if typed, ok := out.(*RaiseIncidentOutput); ok {
+			return result, typed, err
+		}
+		return result, nil, err
+	})
+}
+
+func (t *Toolkit) registerResolveIncidentTool(server *mcp.Server, cfg *toolConfig) {
+	baseHandler := func(ctx context.Context, req *mcp.CallToolRequest, input any) (*mcp.CallToolResult, any, error) {
+		incidentInput, ok := input.(ResolveIncidentInput)
+		if !ok {
+			return ErrorResult("internal error: invalid input type"), nil, nil
+		}
+		return t.handleResolveIncident(ctx, req, incidentInput)
+	}
+
+	wrappedHandler := t.wrapHandler(ToolResolveIncident, baseHandler, cfg)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:         string(ToolResolveIncident),
+		Description:  t.getDescription(ToolResolveIncident, cfg),
+		Annotations:  t.getAnnotations(ToolResolveIncident, cfg),
+		Icons:        t.getIcons(ToolResolveIncident, cfg),
+		Title:        t.getTitle(ToolResolveIncident, cfg),
+		OutputSchema: t.getOutputSchema(ToolResolveIncident, cfg),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, input ResolveIncidentInput) (*mcp.CallToolResult, *ResolveIncidentOutput, error) {
+		result, out, err := wrappedHandler(ctx, req, input)
+		if typed, ok := out.(*ResolveIncidentOutput); ok {
+			return result, typed, err
+		}
+		return result, nil, err
+	})
+}
+
+func (t *Toolkit) handleRaiseIncident(
+	ctx context.Context,
+	_ *mcp.CallToolRequest,
+	input RaiseIncidentInput,
+) (*mcp.CallToolResult, any, error) {
+	if input.URN == "" {
+		return ErrorResult("urn parameter is required"), nil, nil
+	}
+	if input.Title == "" {
+		return ErrorResult("title parameter is required"), nil, nil
+	}
+
+	incidentType := strings.ToUpper(input.Type)
+	if incidentType != "" && !slices.Contains(incidentTypes, incidentType) {
+		return ErrorResult("type must be one of " + strings.Join(incidentTypes, ", ")), nil, nil
+	}
+	if incidentType == "CUSTOM" && input.CustomType == "" {
+		return ErrorResult("custom_type parameter is required when type is CUSTOM"), nil, nil
+	}
+	priority := strings.ToUpper(input.Priority)
+	if priority != "" && !slices.Contains(incidentPriorities, priority) {
+		return ErrorResult("priority must be one of " + strings.Join(incidentPriorities, ", ")), nil, nil
+	}
+
+	datahubClient, err := t.getWriteClient(input.Connection)
+	if err != nil {
+		return ErrorResult("Write error: " + err.Error()), nil, nil
+	}
+
+	incidentURN, err := datahubClient.RaiseIncident(ctx, client.RaiseIncidentInput{
+		ResourceURN:  input.URN,
+		Type:         incidentType,
+		CustomType:   input.CustomType,
+		Title:        input.Title,
+		Description:  input.Description,
+		Priority:     priority,
+		AssigneeURNs: input.Assignees,
+	})
+	if err != nil {
+		return ErrorResult("RaiseIncident failed: " + err.Error()), nil, nil
+	}
+
+	output := RaiseIncidentOutput{
+		URN:      input.URN,
+		Incident: incidentURN,
+		State:    types.IncidentStateActive,
+		Action:   "raised",
+	}
+
+	jsonResult, err := JSONResult(output)
+	if err != nil {
+		return ErrorResult("failed to format result: " + err.Error()), nil, nil
+	}
+	return jsonResult, &output, nil
+}
+
+func (t *Toolkit) handleResolveIncident(
+	ctx context.Context,
+	_ *mcp.CallToolRequest,
+	input ResolveIncidentInput,
+) (*mcp.CallToolResult, any, error) {
+	if input.IncidentURN == "" {
+		return ErrorResult("incident_urn parameter is required"), nil, nil
+	}
+
+	datahubClient, err := t.getWriteClient(input.Connection)
+	if err != nil {
+		return ErrorResult("Write error: " + err.Error()), nil, nil
+	}
+
+	err = datahubClient.ResolveIncident(ctx, input.IncidentURN, input.Message)
+	if err != nil {
+		return ErrorResult("ResolveIncident failed: " + err.Error()), nil, nil
+	}
+
+	output := ResolveIncidentOutput{
+		Incident: input.IncidentURN,
+		State:    types.IncidentStateResolved,
+		Action:   "resolved",
+	}
+
+	jsonResult, err := JSONResult(output)
+	if err != nil {
+		return ErrorResult("failed to format result: " + err.Error()), nil, nil
+	}
+	return jsonResult, &output, nil
+}
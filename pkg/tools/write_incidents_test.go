@@ -0,0 +1,167 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+)
+
+func TestHandleRaiseIncident(t *testing.T) {
+	var captured client.RaiseIncidentInput
+	mock := &mockClient{
+		raiseIncidentFunc: func(_ context.Context, input client.RaiseIncidentInput) (string, error) {
+			captured = input
+			return "urn:li:incident:abc", nil
+		},
+	}
+
+	toolkit := NewToolkit(mock, Config{WriteEnabled: true})
+
+	result, out, _ := toolkit.handleRaiseIncident(context.Background(), nil, RaiseIncidentInput{
+		URN:       "urn:li:dataset:(urn:li:dataPlatform:hive,db.table,PROD)",
+		Title:     "Table is empty",
+		Type:      "volume",
+		Priority:  "high",
+		Assignees: []string{"urn:li:corpuser:ann"},
+	})
+
+	if result.IsError {
+		t.Fatalf("expected success, got error: %s", resultText(result))
+	}
+	if captured.ResourceURN != "urn:li:dataset:(urn:li:dataPlatform:hive,db.table,PROD)" || captured.Title != "Table is empty" {
+		t.Errorf("unexpected input: %+v", captured)
+	}
+	if captured.Type != "VOLUME" || captured.Priority != "HIGH" || len(captured.AssigneeURNs) != 1 {
+		t.Errorf("type and priority should be normalized: %+v", captured)
+	}
+	output, ok := out.(*RaiseIncidentOutput)
+	if !ok || output.Incident != "urn:li:incident:abc" || output.Action != "raised" {
+		t.Errorf("unexpected output: %#v", out)
+	}
+}
+
+func TestHandleRaiseIncident_Validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   RaiseIncidentInput
+		wantMsg string
+	}{
+		{"empty URN", RaiseIncidentInput{Title: "x"}, "urn parameter is required"},
+		{"empty title", RaiseIncidentInput{URN: "urn:li:dataset:x"}, "title parameter is required"},
+		{"invalid type", RaiseIncidentInput{URN: "urn:li:dataset:x", Title: "x", Type: "OUTAGE"}, "type must be one of"},
+		{"custom without name", RaiseIncidentInput{URN: "urn:li:dataset:x", Title: "x", Type: "CUSTOM"}, "custom_type"},
+		{"invalid priority", RaiseIncidentInput{URN: "urn:li:dataset:x", Title: "x", Priority: "P1"}, "priority must be one of"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			toolkit := NewToolkit(&mockClient{}, Config{WriteEnabled: true})
+
+			result, _, _ := toolkit.handleRaiseIncident(context.Background(), nil, tt.input)
+			if !result.IsError || !strings.Contains(resultText(result), tt.wantMsg) {
+				t.Errorf("expected error mentioning %q, got %s", tt.wantMsg, resultText(result))
+			}
+		})
+	}
+}
+
+func TestHandleRaiseIncident_WriteDisabled(t *testing.T) {
+	toolkit := NewToolkit(&mockClient{}, DefaultConfig())
+
+	result, _, _ := toolkit.handleRaiseIncident(context.Background(), nil, RaiseIncidentInput{
+		URN:   "urn:li:dataset:(urn:li:dataPlatform:hive,db.table,PROD)",
+		Title: "Table is empty",
+	})
+
+	if !result.IsError {
+		t.Error("expected error when write is disabled")
+	}
+}
+
+func TestHandleRaiseIncident_ClientError(t *testing.T) {
+	mock := &mockClient{
+		raiseIncidentFunc: func(_ context.Context, _ client.RaiseIncidentInput) (string, error) {
+			return "", errors.New("api error")
+		},
+	}
+	toolkit := NewToolkit(mock, Config{WriteEnabled: true})
+
+	result, _, _ := toolkit.handleRaiseIncident(context.Background(), nil, RaiseIncidentInput{
+		URN:   "urn:li:dataset:(urn:li:dataPlatform:hive,db.table,PROD)",
+		Title: "Table is empty",
+	})
+
+	if !result.IsError {
+		t.Error("expected error on client failure")
+	}
+}
+
+func TestHandleResolveIncident(t *testing.T) {
+	var capturedURN, capturedMessage string
+	mock := &mockClient{
+		resolveIncidentFunc: func(_ context.Context, urn, message string) error {
+			capturedURN = urn
+			capturedMessage = message
+			return nil
+		},
+	}
+
+	toolkit := NewToolkit(mock, Config{WriteEnabled: true})
+
+	result, out, _ := toolkit.handleResolveIncident(context.Background(), nil, ResolveIncidentInput{
+		IncidentURN: "urn:li:incident:abc",
+		Message:     "Backfilled",
+	})
+
+	if result.IsError {
+		t.Fatalf("expected success, got error: %s", resultText(result))
+	}
+	if capturedURN != "urn:li:incident:abc" || capturedMessage != "Backfilled" {
+		t.Errorf("unexpected arguments: %s, %s", capturedURN, capturedMessage)
+	}
+	if output, ok := out.(*ResolveIncidentOutput); !ok || output.State != "RESOLVED" {
+		t.Errorf("unexpected output: %#v", out)
+	}
+}
+
+func TestHandleResolveIncident_EmptyURN(t *testing.T) {
+	toolkit := NewToolkit(&mockClient{}, Config{WriteEnabled: true})
+
+	result, _, _ := toolkit.handleResolveIncident(context.Background(), nil, ResolveIncidentInput{})
+
+	if !result.IsError {
+		t.Error("expected error for empty incident URN")
+	}
+}
+
+func TestHandleResolveIncident_WriteDisabled(t *testing.T) {
+	toolkit := NewToolkit(&mockClient{}, DefaultConfig())
+
+	result, _, _ := toolkit.handleResolveIncident(context.Background(), nil, ResolveIncidentInput{
+		IncidentURN: "urn:li:incident:abc",
+	})
+
+	if !result.IsError {
+		t.Error("expected error when write is disabled")
+	}
+}
+
+func TestRegisterIncidentTools(t *testing.T) {
+	toolkit := NewToolkit(&mockClient{}, Config{WriteEnabled: true})
+
+	impl := &mcp.Implementation{Name: "test", Version: "1.0.0"}
+	server := mcp.NewServer(impl, nil)
+	toolkit.Register(server, ToolRaiseIncident, ToolResolveIncident)
+
+	if !toolkit.registeredTools[ToolRaiseIncident] {
+		t.Error("ToolRaiseIncident should be registered")
+	}
+	if !toolkit.registeredTools[ToolResolveIncident] {
+		t.Error("ToolResolveIncident should be registered")
+	}
+}
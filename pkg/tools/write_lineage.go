@@ -0,0 +1,183 @@
+package tools
+
+import (
+	"context"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+)
+
+// AddLineageInput is the input for the add_lineage tool.
+type AddLineageInput struct {
+	Upstream   string `json:"upstream" jsonschema_description:"URN of the dataset or data job data flows from"`
+	Downstream string `json:"downstream" jsonschema_description:"URN of the dataset, data job, chart or dashboard data flows to"`
+	// UpstreamColumn and DownstreamColumn together make the edge column-level.
+	UpstreamColumn   string `json:"upstream_column,omitempty" jsonschema_description:"Upstream dataset column, for column-level lineage"`
+	DownstreamColumn string `json:"downstream_column,omitempty" jsonschema_description:"Downstream dataset column fed by upstream_column"`
+	Connection       string `json:"connection,omitempty" jsonschema_description:"Named connection to use (see datahub_list_connections)"`
+}
+
+// RemoveLineageInput is the input for the remove_lineage tool.
+type RemoveLineageInput struct {
+	Upstream   string `json:"upstream" jsonschema_description:"URN of the dataset or data job data flows from"`
+	Downstream string `json:"downstream" jsonschema_description:"URN of the dataset, data job, chart or dashboard data flows to"`
+	// UpstreamColumn and DownstreamColumn together make the edge column-level.
+	UpstreamColumn   string `json:"upstream_column,omitempty" jsonschema_description:"Upstream dataset column, for column-level lineage"`
+	DownstreamColumn string `json:"downstream_column,omitempty" jsonschema_description:"Downstream dataset column fed by upstream_column"`
+	Connection       string `json:"connection,omitempty" jsonschema_description:"Named connection to use (see datahub_list_connections)"`
+}
+
+func (t *Toolkit) registerAddLineageTool(server *mcp.Server, cfg *toolConfig) {
+	baseHandler := func(ctx context.Context, req *mcp.CallToolRequest, input any) (*mcp.CallToolResult, any, error) {
+		lineageInput, ok := input.(AddLineageInput)
+		if !ok {
+			return ErrorResult("internal error: invalid input type"), nil, nil
+		}
+		return t.handleAddLineage(ctx, req, lineageInput)
+	}
+
+	wrappedHandler := t.wrapHandler(ToolAddLineage, baseHandler, cfg)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:         string(ToolAddLineage),
+		Description:  t.getDescription(ToolAddLineage, cfg),
+		Annotations:  t.getAnnotations(ToolAddLineage, cfg),
+		Icons:        t.getIcons(ToolAddLineage, cfg),
+		Title:        t.getTitle(ToolAddLineage, cfg),
+		OutputSchema: t.getOutputSchema(ToolAddLineage, cfg),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, input AddLineageInput) (*mcp.CallToolResult, *AddLineageOutput, error) {
+		result, out, err := wrappedHandler(ctx, req, input)
+		if typed, ok := out.(*AddLineageOutput); ok {
+			return result, typed, err
+		}
+		return result, nil, err
+	})
+}
+
+func (t *Toolkit) registerRemoveLineageTool(server *mcp.Server, cfg *toolConfig) {
+	baseHandler := func(ctx context.Context, req *mcp.CallToolRequest, input any) (*mcp.CallToolResult, any, error) {
+		lineageInput, ok := input.(RemoveLineageInput)
+		if !ok {
+			return ErrorResult("internal error: invalid input type"), nil, nil
+		}
+		return t.handleRemoveLineage(ctx, req, lineageInput)
+	}
+
+	wrappedHandler := t.wrapHandler(ToolRemoveLineage, baseHandler, cfg)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:         string(ToolRemoveLineage),
+		Description:  t.getDescription(ToolRemoveLineage, cfg),
+		Annotations:  t.getAnnotations(ToolRemoveLineage, cfg),
+		Icons:        t.getIcons(ToolRemoveLineage, cfg),
+		Title:        t.getTitle(ToolRemoveLineage, cfg),
+		OutputSchema: t.getOutputSchema(ToolRemoveLineage, cfg),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, input RemoveLineageInput) (*mcp.CallToolResult, *RemoveLineageOutput, error) {
+		result, out, err := wrappedHandler(ctx, req, input)
+		if typed, ok := out.(*RemoveLineageOutput); ok {
+			return result, typed, err
+		}
+		return result, nil, err
+	})
+}
+
+func (t *Toolkit) handleAddLineage(
+	ctx context.Context,
+	_ *mcp.CallToolRequest,
+	input AddLineageInput,
+) (*mcp.CallToolResult, any, error) {
+	edge, errMsg := lineageEdge(input.Upstream, input.Downstream, input.UpstreamColumn, input.DownstreamColumn)
+	if errMsg != "" {
+		return ErrorResult(errMsg), nil, nil
+	}
+
+	datahubClient, err := t.getWriteClient(input.Connection)
+	if err != nil {
+		return ErrorResult("Write error: " + err.Error()), nil, nil
+	}
+
+	err = datahubClient.AddLineage(ctx, edge)
+	if err != nil {
+		return ErrorResult("AddLineage failed: " + err.Error()), nil, nil
+	}
+
+	output := AddLineageOutput{
+		Upstream:         input.Upstream,
+		Downstream:       input.Downstream,
+		UpstreamColumn:   input.UpstreamColumn,
+		DownstreamColumn: input.DownstreamColumn,
+		Action:           "added",
+	}
+
+	jsonResult, err := JSONResult(output)
+	if err != nil {
+		return ErrorResult("failed to format result: " + err.Error()), nil, nil
+	}
+	return jsonResult, &output, nil
+}
+
+func (t *Toolkit) handleRemoveLineage(
+	ctx context.Context,
+	_ *mcp.CallToolRequest,
+	input RemoveLineageInput,
+) (*mcp.CallToolResult, any, error) {
+	edge, errMsg := lineageEdge(input.Upstream, input.Downstream, input.UpstreamColumn, input.DownstreamColumn)
+	if errMsg != "" {
+		return ErrorResult(errMsg), nil, nil
+	}
+
+	datahubClient, err := t.getWriteClient(input.Connection)
+	if err != nil {
+		return ErrorResult("Write error: " + err.Error()), nil, nil
+	}
+
+	err = datahubClient.RemoveLineage(ctx, edge)
+	if err != nil {
+		return ErrorResult("RemoveLineage failed: " + err.Error()), nil, nil
+	}
+
+	output := RemoveLineageOutput{
+		Upstream:         input.Upstream,
+		Downstream:       input.Downstream,
+		UpstreamColumn:   input.UpstreamColumn,
+		DownstreamColumn: input.DownstreamColumn,
+		Action:           "removed",
+	}
+
+	jsonResult, err := JSONResult(output)
+	if err != nil {
+		return ErrorResult("failed to format result: " + err.Error()), nil, nil
+	}
+	return jsonResult, &output, nil
+}
+
+// lineageEdge validates the parameters of a lineage write tool and builds the
+// edge, using schema field URNs when columns are given. It returns an error
+// message for invalid input.
+func lineageEdge(upstream, downstream, upstreamColumn, downstreamColumn string) (client.LineageEdgeInput, string) {
+	if upstream == "" {
+		return client.LineageEdgeInput{}, "upstream parameter is required"
+	}
+	if downstream == "" {
+		return client.LineageEdgeInput{}, "downstream parameter is required"
+	}
+	if upstream == downstream && upstreamColumn == downstreamColumn {
+		return client.LineageEdgeInput{}, "upstream and downstream must be different"
+	}
+
+	if upstreamColumn == "" && downstreamColumn == "" {
+		return client.LineageEdgeInput{UpstreamURN: upstream, DownstreamURN: downstream}, ""
+	}
+	if upstreamColumn == "" || downstreamColumn == "" {
+		return client.LineageEdgeInput{}, "upstream_column and downstream_column must be set together"
+	}
+	if !strings.HasPrefix(upstream, "urn:li:dataset:") || !strings.HasPrefix(downstream, "urn:li:dataset:") {
+		return client.LineageEdgeInput{}, "column-level lineage requires dataset URNs for upstream and downstream"
+	}
+	return client.LineageEdgeInput{
+		UpstreamURN:   client.BuildSchemaFieldURN(upstream, upstreamColumn),
+		DownstreamURN: client.BuildSchemaFieldURN(downstream, downstreamColumn),
+	}, ""
+}
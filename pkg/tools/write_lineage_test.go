@@ -0,0 +1,230 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/txn2/mcp-datahub/pkg/client"
+)
+
+const (
+	lineageRawURN   = "urn:li:dataset:(urn:li:dataPlatform:hive,db.raw,PROD)"
+	lineageTableURN = "urn:li:dataset:(urn:li:dataPlatform:hive,db.table,PROD)"
+)
+
+func TestHandleAddLineage(t *testing.T) {
+	var captured []client.LineageEdgeInput
+	mock := &mockClient{
+		addLineageFunc: func(_ context.Context, edges ...client.LineageEdgeInput) error {
+			captured = edges
+			return nil
+		},
+	}
+	toolkit := NewToolkit(mock, Config{WriteEnabled: true})
+
+	result, out, _ := toolkit.handleAddLineage(context.Background(), nil, AddLineageInput{
+		Upstream:   lineageRawURN,
+		Downstream: "urn:li:dataJob:(urn:li:dataFlow:(airflow,etl,prod),load)",
+	})
+
+	if result.IsError {
+		t.Fatalf("expected success, got error: %s", resultText(result))
+	}
+	if len(captured) != 1 || captured[0].UpstreamURN != lineageRawURN || !strings.HasPrefix(captured[0].DownstreamURN, "urn:li:dataJob:") {
+		t.Errorf("unexpected edges: %+v", captured)
+	}
+	if output, ok := out.(*AddLineageOutput); !ok || output.Action != "added" || output.UpstreamColumn != "" {
+		t.Errorf("unexpected output: %#v", out)
+	}
+}
+
+func TestHandleAddLineage_Column(t *testing.T) {
+	var captured []client.LineageEdgeInput
+	mock := &mockClient{
+		addLineageFunc: func(_ context.Context, edges ...client.LineageEdgeInput) error {
+			captured = edges
+			return nil
+		},
+	}
+	toolkit := NewToolkit(mock, Config{WriteEnabled: true})
+
+	result, out, _ := toolkit.handleAddLineage(context.Background(), nil, AddLineageInput{
+		Upstream:         lineageRawURN,
+		Downstream:       lineageTableURN,
+		UpstreamColumn:   "amount_cents",
+		DownstreamColumn: "revenue_usd",
+	})
+
+	if result.IsError {
+		t.Fatalf("expected success, got error: %s", resultText(result))
+	}
+	want := client.LineageEdgeInput{
+		UpstreamURN:   "urn:li:schemaField:(" + lineageRawURN + ",amount_cents)",
+		DownstreamURN: "urn:li:schemaField:(" + lineageTableURN + ",revenue_usd)",
+	}
+	if len(captured) != 1 || captured[0] != want {
+		t.Errorf("edges = %+v, want %+v", captured, want)
+	}
+	if output, ok := out.(*AddLineageOutput); !ok || output.DownstreamColumn != "revenue_usd" {
+		t.Errorf("unexpected output: %#v", out)
+	}
+}
+
+func TestHandleAddLineage_Validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   AddLineageInput
+		wantMsg string
+	}{
+		{name: "empty upstream", input: AddLineageInput{Downstream: lineageTableURN}, wantMsg: "upstream parameter is required"},
+		{name: "empty downstream", input: AddLineageInput{Upstream: lineageRawURN}, wantMsg: "downstream parameter is required"},
+		{name: "self edge", input: AddLineageInput{Upstream: lineageRawURN, Downstream: lineageRawURN}, wantMsg: "must be different"},
+		{
+			name:    "one column",
+			input:   AddLineageInput{Upstream: lineageRawURN, Downstream: lineageTableURN, UpstreamColumn: "id"},
+			wantMsg: "must be set together",
+		},
+		{
+			name: "column on a job",
+			input: AddLineageInput{
+				Upstream:         lineageRawURN,
+				Downstream:       "urn:li:dataJob:(urn:li:dataFlow:(airflow,etl,prod),load)",
+				UpstreamColumn:   "id",
+				DownstreamColumn: "id",
+			},
+			wantMsg: "requires dataset URNs",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			toolkit := NewToolkit(&mockClient{}, Config{WriteEnabled: true})
+
+			result, _, _ := toolkit.handleAddLineage(context.Background(), nil, tt.input)
+			if !result.IsError || !strings.Contains(resultText(result), tt.wantMsg) {
+				t.Errorf("expected error mentioning %q, got %s", tt.wantMsg, resultText(result))
+			}
+		})
+	}
+}
+
+func TestHandleAddLineage_SameDatasetColumns(t *testing.T) {
+	toolkit := NewToolkit(&mockClient{}, Config{WriteEnabled: true})
+
+	result, _, _ := toolkit.handleAddLineage(context.Background(), nil, AddLineageInput{
+		Upstream:         lineageTableURN,
+		Downstream:       lineageTableURN,
+		UpstreamColumn:   "amount_cents",
+		DownstreamColumn: "amount_usd",
+	})
+
+	if result.IsError {
+		t.Errorf("expected a column edge within one dataset to be allowed, got %s", resultText(result))
+	}
+}
+
+func TestHandleAddLineage_WriteDisabled(t *testing.T) {
+	toolkit := NewToolkit(&mockClient{}, DefaultConfig())
+
+	result, _, _ := toolkit.handleAddLineage(context.Background(), nil, AddLineageInput{
+		Upstream:   lineageRawURN,
+		Downstream: lineageTableURN,
+	})
+
+	if !result.IsError {
+		t.Error("expected error when write is disabled")
+	}
+}
+
+func TestHandleAddLineage_ClientError(t *testing.T) {
+	mock := &mockClient{
+		addLineageFunc: func(_ context.Context, _ ...client.LineageEdgeInput) error {
+			return errors.New("api error")
+		},
+	}
+	toolkit := NewToolkit(mock, Config{WriteEnabled: true})
+
+	result, _, _ := toolkit.handleAddLineage(context.Background(), nil, AddLineageInput{
+		Upstream:   lineageRawURN,
+		Downstream: lineageTableURN,
+	})
+
+	if !result.IsError || !strings.Contains(resultText(result), "AddLineage failed: api error") {
+		t.Errorf("expected client error, got %s", resultText(result))
+	}
+}
+
+func TestHandleRemoveLineage(t *testing.T) {
+	var captured []client.LineageEdgeInput
+	mock := &mockClient{
+		removeLineageFunc: func(_ context.Context, edges ...client.LineageEdgeInput) error {
+			captured = edges
+			return nil
+		},
+	}
+	toolkit := NewToolkit(mock, Config{WriteEnabled: true})
+
+	result, out, _ := toolkit.handleRemoveLineage(context.Background(), nil, RemoveLineageInput{
+		Upstream:   lineageRawURN,
+		Downstream: lineageTableURN,
+	})
+
+	if result.IsError {
+		t.Fatalf("expected success, got error: %s", resultText(result))
+	}
+	if len(captured) != 1 || captured[0].DownstreamURN != lineageTableURN {
+		t.Errorf("unexpected edges: %+v", captured)
+	}
+	if output, ok := out.(*RemoveLineageOutput); !ok || output.Action != "removed" {
+		t.Errorf("unexpected output: %#v", out)
+	}
+}
+
+func TestHandleRemoveLineage_Errors(t *testing.T) {
+	mock := &mockClient{
+		removeLineageFunc: func(_ context.Context, _ ...client.LineageEdgeInput) error {
+			return errors.New("api error")
+		},
+	}
+	toolkit := NewToolkit(mock, Config{WriteEnabled: true})
+
+	result, _, _ := toolkit.handleRemoveLineage(context.Background(), nil, RemoveLineageInput{Upstream: lineageRawURN})
+	if !result.IsError || !strings.Contains(resultText(result), "downstream parameter is required") {
+		t.Errorf("expected validation error, got %s", resultText(result))
+	}
+
+	result, _, _ = toolkit.handleRemoveLineage(context.Background(), nil, RemoveLineageInput{
+		Upstream:   lineageRawURN,
+		Downstream: lineageTableURN,
+	})
+	if !result.IsError || !strings.Contains(resultText(result), "RemoveLineage failed") {
+		t.Errorf("expected client error, got %s", resultText(result))
+	}
+
+	readOnly := NewToolkit(mock, DefaultConfig())
+	result, _, _ = readOnly.handleRemoveLineage(context.Background(), nil, RemoveLineageInput{
+		Upstream:   lineageRawURN,
+		Downstream: lineageTableURN,
+	})
+	if !result.IsError {
+		t.Error("expected error when write is disabled")
+	}
+}
+
+func TestRegisterLineageWriteTools(t *testing.T) {
+	toolkit := NewToolkit(&mockClient{}, Config{WriteEnabled: true})
+
+	impl := &mcp.Implementation{Name: "test", Version: "1.0.0"}
+	server := mcp.NewServer(impl, nil)
+	toolkit.Register(server, ToolAddLineage, ToolRemoveLineage)
+
+	if !toolkit.registeredTools[ToolAddLineage] {
+		t.Error("ToolAddLineage should be registered")
+	}
+	if !toolkit.registeredTools[ToolRemoveLineage] {
+		t.Error("ToolRemoveLineage should be registered")
+	}
+}
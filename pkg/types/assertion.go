@@ -0,0 +1,106 @@
+package types
+
+// Assertion result types reported by DataHub assertion runs.
+const (
+	AssertionResultSuccess = "SUCCESS"
+	AssertionResultFailure = "FAILURE"
+	AssertionResultError   = "ERROR"
+	AssertionResultInit    = "INIT"
+)
+
+// Assertion represents a DataHub data quality assertion on a dataset.
+type Assertion struct {
+	// URN is the assertion URN.
+	URN string `json:"urn"`
+
+	// Type is the assertion type (FRESHNESS, VOLUME, SQL, FIELD, DATA_SCHEMA, DATASET, CUSTOM).
+	Type string `json:"type"`
+
+	// Description is the assertion description.
+	Description string `json:"description,omitempty"`
+
+	// Source is how the assertion was created (NATIVE, EXTERNAL, INFERRED).
+	Source string `json:"source,omitempty"`
+
+	// ExternalURL links to the assertion in the tool that evaluates it.
+	ExternalURL string `json:"external_url,omitempty"`
+
+	// Definition describes what the assertion checks.
+	Definition AssertionDefinition `json:"definition"`
+
+	// LatestRun is the most recent completed run, if any.
+	LatestRun *AssertionRun `json:"latest_run,omitempty"`
+
+	// Runs are recent completed runs, newest first.
+	Runs []AssertionRun `json:"runs,omitempty"`
+
+	// TotalRuns is the number of completed runs recorded.
+	TotalRuns int `json:"total_runs"`
+
+	// FailedRuns is the number of recorded runs that failed.
+	FailedRuns int `json:"failed_runs"`
+
+	// SucceededRuns is the number of recorded runs that succeeded.
+	SucceededRuns int `json:"succeeded_runs"`
+}
+
+// AssertionDefinition holds the type-specific details of an assertion.
+// Only the fields relevant to the assertion's type are set.
+type AssertionDefinition struct {
+	// SubType is the type-specific kind (e.g., ROW_COUNT_TOTAL, DATASET_CHANGE, METRIC).
+	SubType string `json:"sub_type,omitempty"`
+
+	// Scope is what a dataset assertion applies to (DATASET_COLUMN, DATASET_ROWS, DATASET_SCHEMA).
+	Scope string `json:"scope,omitempty"`
+
+	// Fields are the columns the assertion checks.
+	Fields []string `json:"fields,omitempty"`
+
+	// Aggregation is the aggregation applied before comparison (e.g., ROW_COUNT, MAX).
+	Aggregation string `json:"aggregation,omitempty"`
+
+	// Operator is the comparison operator (e.g., BETWEEN, GREATER_THAN, NOT_NULL).
+	Operator string `json:"operator,omitempty"`
+
+	// Parameters are the operator's parameters (e.g., value, min_value, max_value).
+	Parameters map[string]string `json:"parameters,omitempty"`
+
+	// Statement is the SQL statement of a SQL assertion.
+	Statement string `json:"statement,omitempty"`
+
+	// Schedule is the freshness window (a cron expression or fixed interval).
+	Schedule string `json:"schedule,omitempty"`
+
+	// Compatibility is the schema compatibility level of a schema assertion.
+	Compatibility string `json:"compatibility,omitempty"`
+
+	// NativeType is the assertion type in the evaluating tool (e.g., a Great Expectations expectation).
+	NativeType string `json:"native_type,omitempty"`
+}
+
+// AssertionRun represents the outcome of a single assertion evaluation.
+type AssertionRun struct {
+	// Timestamp is when the run happened (milliseconds since epoch).
+	Timestamp int64 `json:"timestamp"`
+
+	// RunID identifies the run.
+	RunID string `json:"run_id,omitempty"`
+
+	// Result is SUCCESS, FAILURE, ERROR or INIT.
+	Result string `json:"result"`
+
+	// ActualValue is the observed aggregate value, when reported.
+	ActualValue *float64 `json:"actual_value,omitempty"`
+
+	// RowCount is the number of rows evaluated, when reported.
+	RowCount *int64 `json:"row_count,omitempty"`
+
+	// UnexpectedCount is the number of rows that violated the assertion, when reported.
+	UnexpectedCount *int64 `json:"unexpected_count,omitempty"`
+
+	// ExternalURL links to the run in the tool that evaluated it.
+	ExternalURL string `json:"external_url,omitempty"`
+
+	// NativeResults are tool-specific result details.
+	NativeResults map[string]string `json:"native_results,omitempty"`
+}
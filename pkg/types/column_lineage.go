@@ -33,3 +33,67 @@ type ColumnLineageMapping struct {
 	// Note: This field is not available in DataHub v1.3.x and will be zero.
 	ConfidenceScore float64 `json:"confidence_score,omitempty"`
 }
+
+// ColumnTrace is the multi-hop column-level lineage of a single column.
+type ColumnTrace struct {
+	// DatasetURN is the URN of the dataset that holds the traced column.
+	DatasetURN string `json:"dataset_urn"`
+
+	// Column is the field path of the traced column.
+	Column string `json:"column"`
+
+	// Direction is UPSTREAM (where the column comes from) or DOWNSTREAM (what depends on it).
+	Direction string `json:"direction"`
+
+	// Depth is the maximum number of hops traced.
+	Depth int `json:"depth"`
+
+	// Hops are the column-to-column steps, ordered by level.
+	Hops []ColumnHop `json:"hops"`
+
+	// Endpoints are the ends of the chain: for UPSTREAM the ultimate source columns,
+	// for DOWNSTREAM the dependent columns that nothing further depends on.
+	Endpoints []ColumnRef `json:"endpoints"`
+
+	// Truncated reports that the trace stopped at Depth or at the hop limit
+	// while lineage continued.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// ColumnRef identifies a column of a dataset, or a field used by a chart or dashboard.
+type ColumnRef struct {
+	// URN is the dataset, chart or dashboard URN.
+	URN string `json:"urn"`
+
+	// Type is the entity type (DATASET, CHART or DASHBOARD).
+	Type string `json:"type"`
+
+	// Field is the column path.
+	Field string `json:"field"`
+}
+
+// ColumnHop is one step of a column trace, from an upstream column to the column
+// derived from it.
+type ColumnHop struct {
+	// Level is the number of hops from the traced column.
+	Level int `json:"level"`
+
+	// Upstream is the column data flows from.
+	Upstream ColumnRef `json:"upstream"`
+
+	// Downstream is the column data flows to.
+	Downstream ColumnRef `json:"downstream"`
+
+	// Transform is the transformation operation, when known (e.g. IDENTITY, AGGREGATE).
+	Transform string `json:"transform,omitempty"`
+
+	// Query is the URN of the query that produces the downstream column, when known.
+	Query string `json:"query,omitempty"`
+
+	// SQL is the statement of Query, when DataHub stores it.
+	SQL string `json:"sql,omitempty"`
+
+	// Job is the URN of the data job that produces the downstream dataset, when there
+	// is exactly one.
+	Job string `json:"job,omitempty"`
+}
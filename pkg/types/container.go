@@ -0,0 +1,86 @@
+package types
+
+// Container represents a DataHub container such as a database, schema or project.
+type Container struct {
+	// URN is the container URN.
+	URN string `json:"urn"`
+
+	// Name is the container name.
+	Name string `json:"name"`
+
+	// Description is the container description.
+	Description string `json:"description,omitempty"`
+
+	// Platform is the data platform the container belongs to.
+	Platform string `json:"platform,omitempty"`
+
+	// PlatformInstance is the platform instance URN, if any.
+	PlatformInstance string `json:"platform_instance,omitempty"`
+
+	// SubTypes classify the container (e.g., "Database", "Schema", "Project").
+	SubTypes []string `json:"sub_types,omitempty"`
+
+	// ExternalURL links to the container in its source system.
+	ExternalURL string `json:"external_url,omitempty"`
+
+	// Children is the number of entities directly inside the container.
+	Children int `json:"children"`
+
+	// Path is the browse path of the container's ancestors, root first.
+	Path []PathEntry `json:"path,omitempty"`
+}
+
+// PathEntry is one level of an entity's browse path.
+type PathEntry struct {
+	// Name is the display name of this level.
+	Name string `json:"name"`
+
+	// URN is the entity at this level, when the level is backed by an entity.
+	URN string `json:"urn,omitempty"`
+
+	// Type is the entity type at this level (e.g., CONTAINER, DATA_PLATFORM_INSTANCE).
+	Type string `json:"type,omitempty"`
+
+	// SubTypes classify the entity at this level (e.g., "Database", "Schema").
+	SubTypes []string `json:"sub_types,omitempty"`
+}
+
+// BrowseResult is a page of entities, such as the direct children of a container, platform
+// or platform instance.
+type BrowseResult struct {
+	// URN is the parent being browsed, or the owner whose entities are listed.
+	URN string `json:"urn"`
+
+	// Total is the total number of children.
+	Total int `json:"total"`
+
+	// Offset is the result offset.
+	Offset int `json:"offset"`
+
+	// Limit is the result limit.
+	Limit int `json:"limit"`
+
+	// Entries are the children on this page.
+	Entries []BrowseEntry `json:"entries"`
+}
+
+// BrowseEntry is a child entity returned by a browse.
+type BrowseEntry struct {
+	// URN is the entity URN.
+	URN string `json:"urn"`
+
+	// Type is the entity type (CONTAINER, DATASET, DASHBOARD, etc.).
+	Type string `json:"type"`
+
+	// Name is the display name.
+	Name string `json:"name"`
+
+	// Description is the entity description.
+	Description string `json:"description,omitempty"`
+
+	// Platform is the data platform.
+	Platform string `json:"platform,omitempty"`
+
+	// SubTypes classify the entity (e.g., "Schema", "Table", "View").
+	SubTypes []string `json:"sub_types,omitempty"`
+}
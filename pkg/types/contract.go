@@ -0,0 +1,33 @@
+package types
+
+// Data contract results, derived from the latest run of each contract assertion.
+const (
+	DataContractResultPassing = "PASSING"
+	DataContractResultFailing = "FAILING"
+	DataContractResultUnknown = "UNKNOWN"
+)
+
+// DataContract represents a DataHub data contract on a dataset.
+type DataContract struct {
+	// URN is the data contract URN.
+	URN string `json:"urn"`
+
+	// EntityURN is the URN of the dataset the contract covers.
+	EntityURN string `json:"entity_urn"`
+
+	// State is the contract lifecycle state (ACTIVE, PENDING).
+	State string `json:"state,omitempty"`
+
+	// Result is PASSING when every assertion's latest run succeeded, FAILING when any
+	// failed or errored, and UNKNOWN otherwise.
+	Result string `json:"result"`
+
+	// Freshness are the freshness guarantees of the contract.
+	Freshness []Assertion `json:"freshness,omitempty"`
+
+	// Schema are the schema guarantees of the contract.
+	Schema []Assertion `json:"schema,omitempty"`
+
+	// DataQuality are the data quality guarantees of the contract.
+	DataQuality []Assertion `json:"data_quality,omitempty"`
+}
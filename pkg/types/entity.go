@@ -78,20 +78,173 @@ type Dashboard struct {
 	// DashboardURL is the URL to the dashboard.
 	DashboardURL string `json:"dashboard_url,omitempty"`
 
+	// ExternalURL links to the dashboard in its source system.
+	ExternalURL string `json:"external_url,omitempty"`
+
+	// LastRefreshed is when the dashboard was last refreshed, in epoch milliseconds.
+	LastRefreshed int64 `json:"last_refreshed,omitempty"`
+
 	// Charts lists the charts in this dashboard.
-	Charts []string `json:"charts,omitempty"`
+	Charts []Chart `json:"charts,omitempty"`
+
+	// TotalCharts is the number of charts in the dashboard; Charts may be truncated.
+	TotalCharts int `json:"total_charts"`
+
+	// InputDatasets are the datasets the dashboard reads directly, outside of its charts.
+	InputDatasets []EntityRef `json:"input_datasets,omitempty"`
+
+	// InputFields are the dataset fields the dashboard reads directly.
+	InputFields []InputField `json:"input_fields,omitempty"`
+}
+
+// Chart represents a DataHub chart entity.
+type Chart struct {
+	Entity
+
+	// ChartURL is the URL to the chart.
+	ChartURL string `json:"chart_url,omitempty"`
+
+	// ExternalURL links to the chart in its source system.
+	ExternalURL string `json:"external_url,omitempty"`
+
+	// ChartType is the visualization type (BAR, LINE, TABLE, etc.).
+	ChartType string `json:"chart_type,omitempty"`
+
+	// LastRefreshed is when the chart was last refreshed, in epoch milliseconds.
+	LastRefreshed int64 `json:"last_refreshed,omitempty"`
+
+	// Query is the query backing the chart, if known.
+	Query *ChartQuery `json:"query,omitempty"`
+
+	// InputDatasets are the datasets the chart reads.
+	InputDatasets []EntityRef `json:"input_datasets,omitempty"`
+
+	// InputFields are the dataset fields the chart reads.
+	InputFields []InputField `json:"input_fields,omitempty"`
+
+	// Dashboards are the dashboards containing the chart.
+	Dashboards []EntityRef `json:"dashboards,omitempty"`
+}
+
+// ChartQuery is the query behind a chart.
+type ChartQuery struct {
+	// Type is the query language (SQL, LOOKML).
+	Type string `json:"type"`
+
+	// RawQuery is the query text.
+	RawQuery string `json:"raw_query"`
+}
+
+// EntityRef is a lightweight reference to a related entity.
+type EntityRef struct {
+	// URN is the entity URN.
+	URN string `json:"urn"`
+
+	// Type is the entity type (DATASET, DASHBOARD, etc.).
+	Type string `json:"type"`
+
+	// Name is the display name.
+	Name string `json:"name,omitempty"`
+
+	// Platform is the data platform.
+	Platform string `json:"platform,omitempty"`
 }
 
-// Pipeline represents a DataHub data pipeline entity.
+// InputField is a dataset field read by a chart or dashboard.
+type InputField struct {
+	// DatasetURN is the dataset the field belongs to.
+	DatasetURN string `json:"dataset_urn"`
+
+	// FieldPath is the field path within the dataset.
+	FieldPath string `json:"field_path"`
+
+	// Type is the DataHub field type (STRING, NUMBER, etc.).
+	Type string `json:"type,omitempty"`
+
+	// NativeType is the field type in the source system.
+	NativeType string `json:"native_type,omitempty"`
+}
+
+// Pipeline represents a DataHub data job, one task of a data pipeline.
 type Pipeline struct {
 	Entity
 
 	// DataFlow is the parent data flow URN.
 	DataFlow string `json:"data_flow,omitempty"`
 
-	// Inputs are the input dataset URNs.
-	Inputs []string `json:"inputs,omitempty"`
+	// Orchestrator is the orchestrator running the job (airflow, dagster, etc.).
+	Orchestrator string `json:"orchestrator,omitempty"`
+
+	// ExternalURL links to the job in the orchestrator.
+	ExternalURL string `json:"external_url,omitempty"`
+
+	// Inputs are the datasets the job reads.
+	Inputs []EntityRef `json:"inputs,omitempty"`
+
+	// Outputs are the datasets the job writes.
+	Outputs []EntityRef `json:"outputs,omitempty"`
+
+	// Runs are the most recent runs of the job, newest first.
+	Runs []PipelineRun `json:"runs,omitempty"`
+
+	// TotalRuns is the total number of recorded runs.
+	TotalRuns int `json:"total_runs"`
+}
+
+// Run results.
+const (
+	RunResultSuccess    = "SUCCESS"
+	RunResultFailure    = "FAILURE"
+	RunResultSkipped    = "SKIPPED"
+	RunResultUpForRetry = "UP_FOR_RETRY"
+)
+
+// PipelineRun is one execution (data process instance) of a data job.
+type PipelineRun struct {
+	// URN is the data process instance URN.
+	URN string `json:"urn"`
+
+	// Name is the run name, typically the orchestrator's run ID.
+	Name string `json:"name,omitempty"`
+
+	// Status is STARTED while running and COMPLETE once finished.
+	Status string `json:"status,omitempty"`
+
+	// Result is SUCCESS, FAILURE, SKIPPED or UP_FOR_RETRY once the run has completed.
+	Result string `json:"result,omitempty"`
+
+	// NativeResult is the result as reported by the orchestrator.
+	NativeResult string `json:"native_result,omitempty"`
+
+	// Attempt is the attempt number of the latest run event.
+	Attempt int `json:"attempt,omitempty"`
+
+	// StartTime is when the run was created, in epoch milliseconds.
+	StartTime int64 `json:"start_time,omitempty"`
+
+	// DurationMillis is the run duration in milliseconds, once completed.
+	DurationMillis int64 `json:"duration_millis,omitempty"`
+
+	// ExternalURL links to the run in the orchestrator.
+	ExternalURL string `json:"external_url,omitempty"`
+}
+
+// DataFlow represents a DataHub data flow: a pipeline (e.g., an Airflow DAG) made of data jobs.
+type DataFlow struct {
+	Entity
+
+	// Orchestrator is the orchestrator running the flow (airflow, dagster, etc.).
+	Orchestrator string `json:"orchestrator,omitempty"`
+
+	// Cluster is the environment or cluster the flow runs in.
+	Cluster string `json:"cluster,omitempty"`
+
+	// ExternalURL links to the flow in the orchestrator.
+	ExternalURL string `json:"external_url,omitempty"`
+
+	// Jobs are the data jobs in the flow, each with its latest run.
+	Jobs []Pipeline `json:"jobs,omitempty"`
 
-	// Outputs are the output dataset URNs.
-	Outputs []string `json:"outputs,omitempty"`
+	// TotalJobs is the number of jobs in the flow; Jobs may be truncated.
+	TotalJobs int `json:"total_jobs"`
 }
@@ -0,0 +1,38 @@
+package types
+
+// Freshness records when an entity's data last changed.
+type Freshness struct {
+	// URN is the entity URN.
+	URN string `json:"urn"`
+
+	// LastUpdated is when the data last changed, in epoch milliseconds, or zero
+	// when DataHub has no record of it.
+	LastUpdated int64 `json:"last_updated,omitempty"`
+
+	// Source is where LastUpdated came from; see the FreshnessSource constants.
+	Source string `json:"source,omitempty"`
+
+	// Operation is the kind of change (INSERT, UPDATE, ...) for operation sources.
+	Operation string `json:"operation,omitempty"`
+
+	// Actor is the URN of who made the change, when known.
+	Actor string `json:"actor,omitempty"`
+}
+
+// Freshness sources.
+const (
+	// FreshnessSourceOperation is an operation reported to DataHub, such as a load.
+	FreshnessSourceOperation = "OPERATION"
+
+	// FreshnessSourceLastModified is the last-modified time reported by the source system.
+	FreshnessSourceLastModified = "LAST_MODIFIED"
+
+	// FreshnessSourceQueryEngine is the table's last update reported by the query engine.
+	FreshnessSourceQueryEngine = "QUERY_ENGINE"
+
+	// FreshnessSourceRun is the end of a data job's latest successful run.
+	FreshnessSourceRun = "RUN"
+
+	// FreshnessSourceRefresh is the last refresh of a chart or dashboard.
+	FreshnessSourceRefresh = "REFRESH"
+)
@@ -1,5 +1,11 @@
 package types
 
+// Glossary entity types.
+const (
+	GlossaryEntryNode = "GLOSSARY_NODE"
+	GlossaryEntryTerm = "GLOSSARY_TERM"
+)
+
 // GlossaryTerm represents a business glossary term.
 type GlossaryTerm struct {
 	// URN is the unique identifier.
@@ -14,12 +20,18 @@ type GlossaryTerm struct {
 	// ParentNode is the parent glossary node URN.
 	ParentNode string `json:"parent_node,omitempty"`
 
+	// ParentNodes are the glossary nodes above the term, root first.
+	ParentNodes []EntityRef `json:"parent_nodes,omitempty"`
+
 	// Owners are the term owners.
 	Owners []Owner `json:"owners,omitempty"`
 
 	// RelatedTerms are related glossary terms.
 	RelatedTerms []GlossaryTermRelation `json:"related_terms,omitempty"`
 
+	// EntityCount is the number of entities the term is applied to.
+	EntityCount int `json:"entity_count"`
+
 	// Properties contains custom properties.
 	Properties map[string]string `json:"properties,omitempty"`
 }
@@ -32,6 +44,53 @@ type GlossaryTermRelation struct {
 	// Name is the related term name.
 	Name string `json:"name"`
 
-	// RelationType is the type of relationship.
+	// RelationType is the type of relationship (IsA, HasA, HasValue, IsRelatedTo).
 	RelationType string `json:"relation_type"`
+
+	// Direction is OUTGOING when this term declares the relationship (e.g., this term
+	// IsA the related term) and INCOMING when the related term declares it.
+	Direction string `json:"direction,omitempty"`
+}
+
+// GlossaryListing is one level of the business glossary: the nodes and terms directly
+// under a glossary node, or at the root of the glossary.
+type GlossaryListing struct {
+	// Parent is the glossary node listed; empty for the root of the glossary.
+	Parent string `json:"parent,omitempty"`
+
+	// Path is the glossary nodes from the root down to and including Parent.
+	Path []EntityRef `json:"path,omitempty"`
+
+	// Total is the total number of children.
+	Total int `json:"total"`
+
+	// Offset is the result offset.
+	Offset int `json:"offset"`
+
+	// Limit is the result limit.
+	Limit int `json:"limit"`
+
+	// Entries are the children on this page, nodes before terms at the root.
+	Entries []GlossaryEntry `json:"entries"`
+}
+
+// GlossaryEntry is a glossary node or term in a GlossaryListing.
+type GlossaryEntry struct {
+	// URN is the node or term URN.
+	URN string `json:"urn"`
+
+	// Type is GLOSSARY_NODE or GLOSSARY_TERM.
+	Type string `json:"type"`
+
+	// Name is the display name.
+	Name string `json:"name"`
+
+	// Description is the node or term definition.
+	Description string `json:"description,omitempty"`
+
+	// ChildNodes is the number of nodes directly under a node.
+	ChildNodes int `json:"child_nodes,omitempty"`
+
+	// ChildTerms is the number of terms directly under a node.
+	ChildTerms int `json:"child_terms,omitempty"`
 }
@@ -0,0 +1,70 @@
+package types
+
+// Incident states.
+const (
+	IncidentStateActive   = "ACTIVE"
+	IncidentStateResolved = "RESOLVED"
+)
+
+// Incident represents a DataHub incident raised against an entity.
+type Incident struct {
+	// URN is the incident URN.
+	URN string `json:"urn"`
+
+	// EntityURN is the URN of the entity the incident is raised on.
+	EntityURN string `json:"entity_urn,omitempty"`
+
+	// Type is the incident type (OPERATIONAL, FRESHNESS, VOLUME, FIELD, SQL, DATA_SCHEMA, CUSTOM).
+	Type string `json:"type"`
+
+	// CustomType is the user-defined type when Type is CUSTOM.
+	CustomType string `json:"custom_type,omitempty"`
+
+	// Title is the incident title.
+	Title string `json:"title,omitempty"`
+
+	// Description is the incident description.
+	Description string `json:"description,omitempty"`
+
+	// Priority is the incident priority (CRITICAL, HIGH, MEDIUM, LOW).
+	Priority string `json:"priority,omitempty"`
+
+	// State is ACTIVE or RESOLVED.
+	State string `json:"state"`
+
+	// Stage is the triage stage (TRIAGE, INVESTIGATION, WORK_IN_PROGRESS, FIXED, NO_ACTION_REQUIRED).
+	Stage string `json:"stage,omitempty"`
+
+	// Message is the latest status message, e.g. the resolution note.
+	Message string `json:"message,omitempty"`
+
+	// Source is how the incident was raised (MANUAL, ASSERTION_FAILURE).
+	Source string `json:"source,omitempty"`
+
+	// Assignees are the URNs of users and groups assigned to the incident.
+	Assignees []string `json:"assignees,omitempty"`
+
+	// Created is the creation timestamp in epoch milliseconds.
+	Created int64 `json:"created,omitempty"`
+
+	// CreatedBy is the URN of the actor that raised the incident.
+	CreatedBy string `json:"created_by,omitempty"`
+
+	// LastUpdated is the timestamp of the latest status change in epoch milliseconds.
+	LastUpdated int64 `json:"last_updated,omitempty"`
+
+	// LastUpdatedBy is the URN of the actor that last changed the status.
+	LastUpdatedBy string `json:"last_updated_by,omitempty"`
+}
+
+// IncidentList is a page of incidents on an entity.
+type IncidentList struct {
+	// URN is the entity the incidents belong to.
+	URN string `json:"urn"`
+
+	// Total is the total number of matching incidents.
+	Total int `json:"total"`
+
+	// Incidents are the incidents on this page, newest first.
+	Incidents []Incident `json:"incidents"`
+}
@@ -11,11 +11,26 @@ type LineageResult struct {
 	// Edges are the relationships between nodes.
 	Edges []LineageEdge `json:"edges"`
 
-	// Direction is the lineage direction (UPSTREAM or DOWNSTREAM).
+	// Direction is the lineage direction (UPSTREAM, DOWNSTREAM or BOTH).
 	Direction string `json:"direction"`
 
 	// Depth is the depth of the lineage traversal.
 	Depth int `json:"depth"`
+
+	// AsOf is the point in time, in milliseconds since the epoch, the lineage was
+	// queried at, or zero for current lineage.
+	AsOf int64 `json:"as_of,omitempty"`
+
+	// Total is the number of lineage results DataHub matched for the filters. For depths
+	// of 3 or more it may count deeper entities, which DataHub groups as "3+".
+	Total int `json:"total,omitempty"`
+
+	// Fetched is the number of lineage results DataHub returned for this page, before
+	// results deeper than Depth were dropped client-side.
+	Fetched int `json:"fetched,omitempty"`
+
+	// Truncated reports that more results matched than were returned.
+	Truncated bool `json:"truncated,omitempty"`
 }
 
 // LineageNode represents an entity in the lineage graph.
@@ -35,7 +50,8 @@ type LineageNode struct {
 	// Description is the entity description.
 	Description string `json:"description,omitempty"`
 
-	// Level is the distance from the start node.
+	// Level is the distance from the start node. It is negative for upstream
+	// nodes when both directions were traversed.
 	Level int `json:"level"`
 }
 
@@ -56,6 +72,36 @@ type LineageEdge struct {
 	// UpdatedBy is who created/updated the relationship.
 	UpdatedBy string `json:"updated_by,omitempty"`
 
+	// Updated is when the relationship was last updated.
+	Updated int64 `json:"updated,omitempty"`
+
+	// Via is the URN of the data job that produces the relationship, when known.
+	Via string `json:"via,omitempty"`
+
+	// Origin records whether the edge was added by hand (MANUAL) or by ingestion (INGESTED).
+	Origin string `json:"origin,omitempty"`
+
+	// Change flags an edge ADDED or REMOVED within the queried time window.
+	Change string `json:"change,omitempty"`
+
 	// Properties contains additional edge properties.
 	Properties map[string]any `json:"properties,omitempty"`
 }
+
+// Lineage edge origins.
+const (
+	// LineageEdgeManual marks an edge added in the DataHub UI or API.
+	LineageEdgeManual = "MANUAL"
+
+	// LineageEdgeIngested marks an edge emitted by an ingestion source.
+	LineageEdgeIngested = "INGESTED"
+)
+
+// Lineage edge changes within a time window.
+const (
+	// LineageEdgeAdded marks an edge created within the window.
+	LineageEdgeAdded = "ADDED"
+
+	// LineageEdgeRemoved marks an ingested edge seen within the window but not after it.
+	LineageEdgeRemoved = "REMOVED"
+)
@@ -0,0 +1,81 @@
+package types
+
+// DatasetProfile represents a statistical profile of a dataset at a point in time.
+type DatasetProfile struct {
+	// URN is the dataset URN.
+	URN string `json:"urn"`
+
+	// Timestamp is when the profile was taken (milliseconds since epoch).
+	Timestamp int64 `json:"timestamp"`
+
+	// RowCount is the number of rows.
+	RowCount int64 `json:"row_count"`
+
+	// ColumnCount is the number of columns.
+	ColumnCount int64 `json:"column_count"`
+
+	// SizeInBytes is the storage size of the dataset.
+	SizeInBytes int64 `json:"size_in_bytes,omitempty"`
+
+	// Partition identifies the profiled partition, if the profile is partition-scoped.
+	Partition string `json:"partition,omitempty"`
+
+	// Fields are the per-column statistics.
+	Fields []FieldProfile `json:"fields,omitempty"`
+
+	// History holds earlier profiles in the requested time range, newest first.
+	// Only table-level counts are included.
+	History []ProfileSnapshot `json:"history,omitempty"`
+}
+
+// FieldProfile holds statistics for a single column.
+// Pointer fields are nil when the profiler did not compute the statistic.
+type FieldProfile struct {
+	// FieldPath is the column path.
+	FieldPath string `json:"field_path"`
+
+	// UniqueCount is the number of distinct values.
+	UniqueCount *int64 `json:"unique_count,omitempty"`
+
+	// UniqueProportion is the ratio of distinct values to rows.
+	UniqueProportion *float64 `json:"unique_proportion,omitempty"`
+
+	// NullCount is the number of null values.
+	NullCount *int64 `json:"null_count,omitempty"`
+
+	// NullProportion is the ratio of null values to rows.
+	NullProportion *float64 `json:"null_proportion,omitempty"`
+
+	// Min is the minimum value.
+	Min string `json:"min,omitempty"`
+
+	// Max is the maximum value.
+	Max string `json:"max,omitempty"`
+
+	// Mean is the mean value.
+	Mean string `json:"mean,omitempty"`
+
+	// Median is the median value.
+	Median string `json:"median,omitempty"`
+
+	// Stdev is the standard deviation.
+	Stdev string `json:"stdev,omitempty"`
+
+	// SampleValues are example values from the column.
+	SampleValues []string `json:"sample_values,omitempty"`
+}
+
+// ProfileSnapshot holds the table-level counts of an earlier profile.
+type ProfileSnapshot struct {
+	// Timestamp is when the profile was taken (milliseconds since epoch).
+	Timestamp int64 `json:"timestamp"`
+
+	// RowCount is the number of rows.
+	RowCount int64 `json:"row_count"`
+
+	// ColumnCount is the number of columns.
+	ColumnCount int64 `json:"column_count"`
+
+	// SizeInBytes is the storage size of the dataset.
+	SizeInBytes int64 `json:"size_in_bytes,omitempty"`
+}
@@ -0,0 +1,64 @@
+package types
+
+// UsageStats represents aggregated usage of a dataset over a time window.
+type UsageStats struct {
+	// URN is the dataset URN.
+	URN string `json:"urn"`
+
+	// Range is the time window the statistics cover (e.g., MONTH).
+	Range string `json:"range"`
+
+	// TotalQueries is the number of SQL queries that touched the dataset.
+	TotalQueries int `json:"total_queries"`
+
+	// UniqueUsers is the number of distinct users that queried the dataset.
+	UniqueUsers int `json:"unique_users"`
+
+	// TopUsers are the heaviest users, ordered by query count.
+	TopUsers []UserUsage `json:"top_users,omitempty"`
+
+	// Fields are per-column usage counts, ordered by count.
+	Fields []FieldUsage `json:"fields,omitempty"`
+
+	// Buckets is the usage time series, oldest first. Buckets are typically daily.
+	Buckets []UsageBucket `json:"buckets,omitempty"`
+}
+
+// UserUsage holds the query count of a single user.
+type UserUsage struct {
+	// User is the user URN.
+	User string `json:"user"`
+
+	// Username is the user's login name.
+	Username string `json:"username,omitempty"`
+
+	// Email is the user's email address.
+	Email string `json:"email,omitempty"`
+
+	// Count is the number of queries issued by the user.
+	Count int `json:"count"`
+}
+
+// FieldUsage holds the usage count of a single column.
+type FieldUsage struct {
+	// Field is the column path.
+	Field string `json:"field"`
+
+	// Count is the number of queries that referenced the column.
+	Count int `json:"count"`
+}
+
+// UsageBucket holds usage for a single time bucket.
+type UsageBucket struct {
+	// Timestamp is the start of the bucket (milliseconds since epoch).
+	Timestamp int64 `json:"timestamp"`
+
+	// Duration is the bucket size (e.g., DAY).
+	Duration string `json:"duration,omitempty"`
+
+	// TotalQueries is the number of queries in the bucket.
+	TotalQueries int `json:"total_queries"`
+
+	// UniqueUsers is the number of distinct users in the bucket.
+	UniqueUsers int `json:"unique_users"`
+}
@@ -0,0 +1,70 @@
+package types
+
+// User represents a DataHub user (corpuser) and how to reach them.
+type User struct {
+	// URN is the user URN.
+	URN string `json:"urn"`
+
+	// Username is the user's login name.
+	Username string `json:"username,omitempty"`
+
+	// DisplayName is the user's display name.
+	DisplayName string `json:"display_name,omitempty"`
+
+	// Email is the user's email address.
+	Email string `json:"email,omitempty"`
+
+	// Title is the user's job title.
+	Title string `json:"title,omitempty"`
+
+	// Department is the user's department.
+	Department string `json:"department,omitempty"`
+
+	// Teams are the teams the user lists on their profile.
+	Teams []string `json:"teams,omitempty"`
+
+	// Slack is the user's Slack handle.
+	Slack string `json:"slack,omitempty"`
+
+	// Phone is the user's phone number.
+	Phone string `json:"phone,omitempty"`
+
+	// Manager is the URN of the user's manager.
+	Manager string `json:"manager,omitempty"`
+
+	// Active reports whether the account is active.
+	Active bool `json:"active"`
+
+	// Groups are the groups the user is a member of.
+	Groups []EntityRef `json:"groups,omitempty"`
+}
+
+// Group represents a DataHub group (corpGroup).
+type Group struct {
+	// URN is the group URN.
+	URN string `json:"urn"`
+
+	// Name is the group name.
+	Name string `json:"name,omitempty"`
+
+	// DisplayName is the group's display name.
+	DisplayName string `json:"display_name,omitempty"`
+
+	// Description is the group description.
+	Description string `json:"description,omitempty"`
+
+	// Email is the group's email address or mailing list.
+	Email string `json:"email,omitempty"`
+
+	// Slack is the group's Slack channel.
+	Slack string `json:"slack,omitempty"`
+
+	// Owners are the owners of the group.
+	Owners []Owner `json:"owners,omitempty"`
+
+	// Members are the users in the group.
+	Members []User `json:"members,omitempty"`
+
+	// TotalMembers is the number of members; Members may be truncated.
+	TotalMembers int `json:"total_members"`
+}